@@ -17,6 +17,7 @@ import (
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/config/data"
 	"github.com/derailed/k9s/internal/slogs"
+	"github.com/derailed/k9s/internal/snapshot"
 	"github.com/derailed/k9s/internal/view"
 	"github.com/lmittmann/tint"
 	"github.com/mattn/go-colorable"
@@ -107,11 +108,24 @@ func run(*cobra.Command, []string) error {
 		TimeFormat: time.RFC3339,
 	})))
 
+	var snap *snapshot.Store
+	if dir := *k9sFlags.Snapshot; dir != "" {
+		s, stop, err := initSnapshot(dir)
+		if err != nil {
+			return fmt.Errorf("snapshot %q load failed: %w", dir, err)
+		}
+		defer stop()
+		snap = s
+	}
+
 	cfg, err := loadConfiguration()
 	if err != nil {
 		slog.Warn("Fail to load global/context configuration", slogs.Error, err)
 	}
 	app := view.NewApp(cfg)
+	if snap != nil {
+		app.UseSnapshot(snap)
+	}
 	if app.Config.K9s.DefaultView != "" {
 		app.Config.SetActiveView(app.Config.K9s.DefaultView)
 	}
@@ -257,6 +271,12 @@ func initK9sFlags() {
 		"",
 		"Sets a path to a dir for a screen dumps",
 	)
+	rootCmd.Flags().StringVar(
+		k9sFlags.Snapshot,
+		"snapshot",
+		"",
+		"Browse a cluster dump directory offline in read-only mode",
+	)
 	rootCmd.Flags()
 }
 
@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package cmd
+
+import (
+	"log/slog"
+	"os"
+	"path/filepath"
+
+	"github.com/derailed/k9s/internal/slogs"
+	"github.com/derailed/k9s/internal/snapshot"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// initSnapshot serves a cluster dump locally and points the k8s flags at it.
+// Returns a func to stop the server and clean up.
+func initSnapshot(dir string) (*snapshot.Store, func(), error) {
+	s, err := snapshot.Load(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+	tmp, err := os.MkdirTemp("", "k9s-snapshot-")
+	if err != nil {
+		return nil, nil, err
+	}
+	srv := snapshot.NewServer(s)
+	stop := func() {
+		srv.Stop()
+		_ = os.RemoveAll(tmp)
+	}
+	url, err := srv.Start()
+	if err != nil {
+		stop()
+		return nil, nil, err
+	}
+	kubeConfig := filepath.Join(tmp, "config")
+	if err := clientcmd.WriteToFile(*srv.KubeConfig(url), kubeConfig); err != nil {
+		stop()
+		return nil, nil, err
+	}
+	// Keep the discovery cache of the throw away server out of the user's cache.
+	if err := os.Setenv("KUBECACHEDIR", filepath.Join(tmp, "cache")); err != nil {
+		stop()
+		return nil, nil, err
+	}
+
+	*k8sFlags.KubeConfig = kubeConfig
+	*k8sFlags.Context, *k8sFlags.ClusterName, *k8sFlags.AuthInfoName = "", "", ""
+	*k8sFlags.Impersonate, *k8sFlags.ImpersonateGroup = "", nil
+	*k9sFlags.ReadOnly, *k9sFlags.Write = true, false
+	slog.Info("📸 Browsing snapshot",
+		slogs.Path, dir,
+		slogs.Count, s.Count(),
+	)
+
+	return s, stop, nil
+}
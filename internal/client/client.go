@@ -52,6 +52,8 @@ type APIClient struct {
 	cache             *cache.LRUExpireCache
 	connOK            bool
 	log               *slog.Logger
+	asUser            string
+	asGroups          []string
 }
 
 // NewTestAPIClient for testing ONLY!!
@@ -71,6 +73,7 @@ func InitConnection(config *Config, log *slog.Logger) (*APIClient, error) {
 		connOK: true,
 		log:    log.With(slogs.Subsys, "client"),
 	}
+	a.asUser, a.asGroups = config.Impersonation()
 	err := a.supportsMetricsResources()
 	if err != nil {
 		slog.Warn("Fail to locate metrics-server", slogs.Error, err)
@@ -577,6 +580,29 @@ func (a *APIClient) SwitchContext(name string) error {
 	return a.invalidateCache()
 }
 
+// Impersonate switches the connection identity to the given user and groups.
+// A blank user reverts to the identity the session was started with.
+func (a *APIClient) Impersonate(user string, groups []string) error {
+	if user == "" {
+		user, groups = a.asUser, a.asGroups
+	}
+	if user == "" && len(groups) > 0 {
+		return errors.New("a user is required to impersonate groups")
+	}
+	slog.Debug("Switching identity", slogs.Subject, user)
+	prevUser, prevGroups := a.config.Impersonation()
+	a.config.SetImpersonation(user, groups)
+	a.reset()
+	ResetMetrics()
+	if !a.CheckConnectivity() {
+		a.config.SetImpersonation(prevUser, prevGroups)
+		a.reset()
+		return errors.New("unable to connect to api server with the impersonated identity")
+	}
+
+	return nil
+}
+
 func (a *APIClient) reset() {
 	a.config.reset()
 	a.cache = cache.NewLRUExpireCache(cacheSize)
@@ -0,0 +1,185 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"k8s.io/client-go/tools/clientcmd/api"
+)
+
+const (
+	// CredsExec denotes credentials provided by an exec plugin.
+	CredsExec = "exec"
+
+	// CredsAuthProvider denotes credentials provided by an auth provider.
+	CredsAuthProvider = "auth-provider"
+
+	// CredsToken denotes bearer token credentials.
+	CredsToken = "token"
+
+	// CredsCert denotes client certificate credentials.
+	CredsCert = "cert"
+
+	// CredsBasic denotes basic auth credentials.
+	CredsBasic = "basic"
+
+	// CredsNone denotes no credentials.
+	CredsNone = "none"
+)
+
+// CredentialStatus tracks a context credentials status.
+type CredentialStatus struct {
+	// Kind specifies the credentials kind ie exec, token, cert...
+	Kind string
+
+	// Detail provides additional information ie the exec command.
+	Detail string
+
+	// Expiry tracks the credentials expiration if known.
+	Expiry time.Time
+
+	// Issue reports a credentials problem if any.
+	Issue string
+}
+
+// IsExpired checks if the credentials have expired.
+func (s CredentialStatus) IsExpired(now time.Time) bool {
+	return !s.Expiry.IsZero() && now.After(s.Expiry)
+}
+
+// ContextCredentials checks a context credentials.
+func ContextCredentials(cfg *api.Config, n string, now time.Time) CredentialStatus {
+	ctx, ok := cfg.Contexts[n]
+	if !ok {
+		return CredentialStatus{Kind: CredsNone, Issue: fmt.Sprintf("context %q does not exist", n)}
+	}
+	if _, ok := cfg.Clusters[ctx.Cluster]; !ok {
+		return CredentialStatus{Kind: CredsNone, Issue: fmt.Sprintf("cluster %q does not exist", ctx.Cluster)}
+	}
+	u, ok := cfg.AuthInfos[ctx.AuthInfo]
+	if !ok {
+		return CredentialStatus{Kind: CredsNone, Issue: fmt.Sprintf("user %q does not exist", ctx.AuthInfo)}
+	}
+
+	st := AuthInfoCredentials(u)
+	if st.IsExpired(now) {
+		st.Issue = st.Kind + " expired"
+	}
+
+	return st
+}
+
+// AuthInfoCredentials checks a user credentials.
+func AuthInfoCredentials(u *api.AuthInfo) CredentialStatus {
+	switch {
+	case u.Exec != nil:
+		return CredentialStatus{Kind: CredsExec, Detail: filepath.Base(u.Exec.Command)}
+	case u.AuthProvider != nil:
+		return CredentialStatus{Kind: CredsAuthProvider, Detail: u.AuthProvider.Name}
+	case u.Token != "" || u.TokenFile != "":
+		st := CredentialStatus{Kind: CredsToken}
+		token := u.Token
+		if token == "" {
+			raw, err := os.ReadFile(u.TokenFile)
+			if err != nil {
+				st.Issue = err.Error()
+				return st
+			}
+			token = strings.TrimSpace(string(raw))
+		}
+		st.Expiry, _ = TokenExpiry(token)
+		return st
+	case len(u.ClientCertificateData) != 0 || u.ClientCertificate != "":
+		st := CredentialStatus{Kind: CredsCert}
+		raw := u.ClientCertificateData
+		if len(raw) == 0 {
+			var err error
+			if raw, err = os.ReadFile(u.ClientCertificate); err != nil {
+				st.Issue = err.Error()
+				return st
+			}
+		}
+		exp, err := CertExpiry(raw)
+		if err != nil {
+			st.Issue = err.Error()
+		}
+		st.Expiry = exp
+		return st
+	case u.Username != "":
+		return CredentialStatus{Kind: CredsBasic}
+	default:
+		return CredentialStatus{Kind: CredsNone}
+	}
+}
+
+// TokenExpiry returns a JWT token expiration if any.
+func TokenExpiry(token string) (time.Time, error) {
+	tokens := strings.Split(token, ".")
+	if len(tokens) != 3 {
+		return time.Time{}, errors.New("not a jwt token")
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(tokens[1], "="))
+	if err != nil {
+		return time.Time{}, err
+	}
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(raw, &claims); err != nil {
+		return time.Time{}, err
+	}
+	if claims.Exp == 0 {
+		return time.Time{}, nil
+	}
+
+	return time.Unix(claims.Exp, 0), nil
+}
+
+// CertExpiry returns a PEM encoded certificate expiration.
+func CertExpiry(raw []byte) (time.Time, error) {
+	b, _ := pem.Decode(raw)
+	if b == nil {
+		return time.Time{}, errors.New("invalid client certificate")
+	}
+	cert, err := x509.ParseCertificate(b.Bytes)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return cert.NotAfter, nil
+}
+
+// CheckReachable checks if an api server endpoint accepts connections.
+func CheckReachable(server string, timeout time.Duration) error {
+	u, err := url.Parse(server)
+	if err != nil {
+		return err
+	}
+	host := u.Host
+	if u.Port() == "" {
+		port := "443"
+		if u.Scheme == "http" {
+			port = "80"
+		}
+		host = net.JoinHostPort(u.Hostname(), port)
+	}
+	conn, err := net.DialTimeout("tcp", host, timeout)
+	if err != nil {
+		return err
+	}
+
+	return conn.Close()
+}
@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/tools/clientcmd/api"
+)
+
+func TestContextCredentials(t *testing.T) {
+	now := time.Now()
+	exp := now.Add(time.Hour).Truncate(time.Second)
+
+	uu := map[string]struct {
+		ctx string
+		u   *api.AuthInfo
+		e   client.CredentialStatus
+	}{
+		"exec": {
+			ctx: "fred",
+			u:   &api.AuthInfo{Exec: &api.ExecConfig{Command: "/usr/local/bin/aws"}},
+			e:   client.CredentialStatus{Kind: client.CredsExec, Detail: "aws"},
+		},
+		"token": {
+			ctx: "fred",
+			u:   &api.AuthInfo{Token: makeToken(exp)},
+			e:   client.CredentialStatus{Kind: client.CredsToken, Expiry: exp},
+		},
+		"token-expired": {
+			ctx: "fred",
+			u:   &api.AuthInfo{Token: makeToken(now.Add(-time.Hour).Truncate(time.Second))},
+			e:   client.CredentialStatus{Kind: client.CredsToken, Expiry: now.Add(-time.Hour).Truncate(time.Second), Issue: "token expired"},
+		},
+		"opaque-token": {
+			ctx: "fred",
+			u:   &api.AuthInfo{Token: "fred"},
+			e:   client.CredentialStatus{Kind: client.CredsToken},
+		},
+		"cert": {
+			ctx: "fred",
+			u:   &api.AuthInfo{ClientCertificateData: makeCert(t, exp)},
+			e:   client.CredentialStatus{Kind: client.CredsCert, Expiry: exp},
+		},
+		"bad-cert": {
+			ctx: "fred",
+			u:   &api.AuthInfo{ClientCertificateData: []byte("fred")},
+			e:   client.CredentialStatus{Kind: client.CredsCert, Issue: "invalid client certificate"},
+		},
+		"basic": {
+			ctx: "fred",
+			u:   &api.AuthInfo{Username: "fred"},
+			e:   client.CredentialStatus{Kind: client.CredsBasic},
+		},
+		"none": {
+			ctx: "fred",
+			u:   &api.AuthInfo{},
+			e:   client.CredentialStatus{Kind: client.CredsNone},
+		},
+		"no-context": {
+			ctx: "blee",
+			u:   &api.AuthInfo{},
+			e:   client.CredentialStatus{Kind: client.CredsNone, Issue: `context "blee" does not exist`},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cfg := api.Config{
+				Clusters:  map[string]*api.Cluster{"c1": {Server: "https://localhost:3000"}},
+				AuthInfos: map[string]*api.AuthInfo{"u1": u.u},
+				Contexts:  map[string]*api.Context{"fred": {Cluster: "c1", AuthInfo: "u1"}},
+			}
+			st := client.ContextCredentials(&cfg, u.ctx, now)
+			assert.Equal(t, u.e.Kind, st.Kind)
+			assert.Equal(t, u.e.Detail, st.Detail)
+			assert.Equal(t, u.e.Issue, st.Issue)
+			assert.True(t, u.e.Expiry.Equal(st.Expiry), "expected %v got %v", u.e.Expiry, st.Expiry)
+		})
+	}
+}
+
+func TestCheckReachable(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := l.Addr().String()
+
+	require.NoError(t, client.CheckReachable("https://"+addr, time.Second))
+	require.NoError(t, l.Close())
+	require.Error(t, client.CheckReachable("https://"+addr, time.Second))
+}
+
+// Helpers...
+
+func makeToken(exp time.Time) string {
+	enc := base64.RawURLEncoding
+	claims := `{"sub":"fred","exp":` + strconv.FormatInt(exp.Unix(), 10) + `}`
+
+	return enc.EncodeToString([]byte(`{"alg":"none"}`)) + "." + enc.EncodeToString([]byte(claims)) + ".sig"
+}
+
+func makeCert(t *testing.T, exp time.Time) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tpl := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "fred"},
+		NotBefore:    exp.Add(-24 * time.Hour),
+		NotAfter:     exp,
+	}
+	raw, err := x509.CreateCertificate(rand.Reader, &tpl, &tpl, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: raw})
+}
@@ -19,6 +19,8 @@ var (
 	NsGVR   = NewGVR("v1/namespaces")
 	NodeGVR = NewGVR("v1/nodes")
 	SvcGVR  = NewGVR("v1/services")
+	RqGVR   = NewGVR("v1/resourcequotas")
+	LrGVR   = NewGVR("v1/limitranges")
 
 	// Discovery...
 	EpsGVR = NewGVR("discovery.k8s.io/v1/endpointslices")
@@ -35,6 +37,9 @@ var (
 	PcGVR  = NewGVR("scheduling.k8s.io/v1/priorityclasses")
 	NpGVR  = NewGVR("networking.k8s.io/v1/networkpolicies")
 	ScGVR  = NewGVR("storage.k8s.io/v1/storageclasses")
+	VwcGVR = NewGVR("admissionregistration.k8s.io/v1/validatingwebhookconfigurations")
+	MwcGVR = NewGVR("admissionregistration.k8s.io/v1/mutatingwebhookconfigurations")
+	ApsGVR = NewGVR("apiregistration.k8s.io/v1/apiservices")
 
 	// Policy...
 	PdbGVR = NewGVR("policy/v1/poddisruptionbudgets")
@@ -55,11 +60,23 @@ var (
 	RefGVR = NewGVR("references")
 	PuGVR  = NewGVR("pulses")
 	ScnGVR = NewGVR("scans")
+	BomGVR = NewGVR("sboms")
 	DirGVR = NewGVR("dirs")
 	PfGVR  = NewGVR("portforwards")
 	SdGVR  = NewGVR("screendumps")
+	SdpGVR = NewGVR("dumppreviews")
 	BeGVR  = NewGVR("benchmarks")
 	AliGVR = NewGVR("aliases")
+	CapGVR = NewGVR("capacities")
+	CpoGVR = NewGVR("capacity-pods")
+	TlGVR  = NewGVR("timelines")
+	EvaGVR = NewGVR("eventarchives")
+	DkGVR  = NewGVR("datakeys")
+	CrtGVR = NewGVR("tlscerts")
+	SesGVR = NewGVR("sessions")
+	SseGVR = NewGVR("session-entries")
+	PinGVR = NewGVR("pins")
+	AlGVR  = NewGVR("alerts")
 	XGVR   = NewGVR("xrays")
 	HlpGVR = NewGVR("help")
 	QGVR   = NewGVR("quit")
@@ -67,6 +84,7 @@ var (
 	// Helm...
 	HmGVR  = NewGVR("helm")
 	HmhGVR = NewGVR("helm-history")
+	HmrGVR = NewGVR("helm-resources")
 
 	// RBAC...
 	RbacGVR = NewGVR("rbac")
@@ -0,0 +1,293 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client
+
+import (
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/clientcmd/api"
+)
+
+// ConfigImport tracks the outcome of a kubeconfig import.
+type ConfigImport struct {
+	// Contexts lists the imported contexts.
+	Contexts []string
+
+	// Skipped lists the contexts skipped due to name conflicts.
+	Skipped []string
+
+	// Renamed maps clusters or users renamed due to name conflicts.
+	Renamed map[string]string
+}
+
+// ImportConfig merges the contexts of a kubeconfig file into the active configuration.
+// Conflicting contexts are skipped unless overwrite is set. Conflicting clusters or users
+// that differ from the existing ones are renamed, unless overwrite is set and no other
+// context references them. Relative file paths are resolved against the imported file.
+func (c *Config) ImportConfig(path string, overwrite bool) (ConfigImport, error) {
+	res := ConfigImport{Renamed: make(map[string]string)}
+	src, err := clientcmd.LoadFromFile(path)
+	if err != nil {
+		return res, err
+	}
+	if err := clientcmd.ResolveLocalPaths(src); err != nil {
+		return res, err
+	}
+	cfg, err := c.RawConfig()
+	if err != nil {
+		return res, err
+	}
+
+	for _, n := range slices.Sorted(maps.Keys(src.Contexts)) {
+		if _, ok := cfg.Contexts[n]; ok && !overwrite {
+			res.Skipped = append(res.Skipped, n)
+			continue
+		}
+		ctx := src.Contexts[n].DeepCopy()
+		ctx.LocationOfOrigin = ""
+		if cl, ok := src.Clusters[ctx.Cluster]; ok {
+			replace := overwrite && !referenced(&cfg, n, func(c *api.Context) string { return c.Cluster }, ctx.Cluster)
+			ctx.Cluster = mergeEntry(cfg.Clusters, ctx.Cluster, cl.DeepCopy(), replace, res.Renamed)
+		}
+		if u, ok := src.AuthInfos[ctx.AuthInfo]; ok {
+			replace := overwrite && !referenced(&cfg, n, func(c *api.Context) string { return c.AuthInfo }, ctx.AuthInfo)
+			ctx.AuthInfo = mergeEntry(cfg.AuthInfos, ctx.AuthInfo, u.DeepCopy(), replace, res.Renamed)
+		}
+		cfg.Contexts[n] = ctx
+		res.Contexts = append(res.Contexts, n)
+	}
+	if len(res.Contexts) == 0 {
+		return res, nil
+	}
+
+	return res, c.modifyConfig(&cfg)
+}
+
+// OrphanedEntries returns the clusters and users not referenced by any context.
+func (c *Config) OrphanedEntries() (clusters, users []string, err error) {
+	cfg, err := c.RawConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+	clusters, users = orphans(&cfg)
+
+	return clusters, users, nil
+}
+
+// PruneConfig removes the clusters and users not referenced by any context.
+func (c *Config) PruneConfig() (clusters, users []string, err error) {
+	cfg, err := c.RawConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+	clusters, users = orphans(&cfg)
+	if len(clusters) == 0 && len(users) == 0 {
+		return nil, nil, nil
+	}
+	for _, n := range clusters {
+		delete(cfg.Clusters, n)
+	}
+	for _, n := range users {
+		delete(cfg.AuthInfos, n)
+	}
+
+	return clusters, users, c.modifyConfig(&cfg)
+}
+
+// UpdateContext updates a context namespace, cluster and user.
+func (c *Config) UpdateContext(n, ns, cluster, user string) error {
+	cfg, err := c.RawConfig()
+	if err != nil {
+		return err
+	}
+	ctx, ok := cfg.Contexts[n]
+	if !ok {
+		return fmt.Errorf("context %q does not exist", n)
+	}
+	if _, ok := cfg.Clusters[cluster]; !ok {
+		return fmt.Errorf("cluster %q does not exist", cluster)
+	}
+	if _, ok := cfg.AuthInfos[user]; !ok {
+		return fmt.Errorf("user %q does not exist", user)
+	}
+	ctx.Namespace, ctx.Cluster, ctx.AuthInfo = ns, cluster, user
+
+	return c.modifyConfig(&cfg)
+}
+
+// modifyConfig persists the configuration and reloads it.
+func (c *Config) modifyConfig(cfg *api.Config) error {
+	acc, err := c.ConfigAccess()
+	if err != nil {
+		return err
+	}
+	if err := clientcmd.ModifyConfig(acc, *cfg, true); err != nil {
+		return err
+	}
+	c.reload()
+
+	return nil
+}
+
+// reload discards the cached kubeconfig so changes on disk are picked up.
+func (c *Config) reload() {
+	flags := c.cloneFlags()
+
+	c.mx.Lock()
+	c.flags = flags
+	c.mx.Unlock()
+}
+
+// SetImpersonation sets the user and groups to impersonate. A blank user disables impersonation.
+func (c *Config) SetImpersonation(user string, groups []string) {
+	flags := c.cloneFlags()
+	if groups == nil {
+		groups = []string{}
+	}
+	flags.Impersonate, flags.ImpersonateGroup = &user, &groups
+
+	c.mx.Lock()
+	c.flags = flags
+	c.mx.Unlock()
+}
+
+// Impersonation returns the impersonated user and groups if any.
+func (c *Config) Impersonation() (user string, groups []string) {
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+
+	if isSet(c.flags.Impersonate) {
+		user = *c.flags.Impersonate
+	}
+	if areSet(c.flags.ImpersonateGroup) {
+		groups = *c.flags.ImpersonateGroup
+	}
+
+	return
+}
+
+// ImpersonationInfo returns a human readable impersonated identity if any.
+func (c *Config) ImpersonationInfo() string {
+	user, groups := c.Impersonation()
+	if user == "" || len(groups) == 0 {
+		return user
+	}
+
+	return user + " [" + strings.Join(groups, ",") + "]"
+}
+
+// cloneFlags returns a copy of the current flags with a fresh client config loader.
+func (c *Config) cloneFlags() *genericclioptions.ConfigFlags {
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+
+	flags := genericclioptions.NewConfigFlags(UsePersistentConfig)
+	flags.CacheDir, flags.KubeConfig = c.flags.CacheDir, c.flags.KubeConfig
+	flags.ClusterName, flags.AuthInfoName = c.flags.ClusterName, c.flags.AuthInfoName
+	flags.Context, flags.Namespace = c.flags.Context, c.flags.Namespace
+	flags.APIServer, flags.TLSServerName = c.flags.APIServer, c.flags.TLSServerName
+	flags.Insecure = c.flags.Insecure
+	flags.CertFile, flags.KeyFile, flags.CAFile = c.flags.CertFile, c.flags.KeyFile, c.flags.CAFile
+	flags.BearerToken = c.flags.BearerToken
+	flags.Impersonate, flags.ImpersonateUID = c.flags.Impersonate, c.flags.ImpersonateUID
+	flags.ImpersonateGroup = c.flags.ImpersonateGroup
+	flags.Username, flags.Password = c.flags.Username, c.flags.Password
+	flags.Timeout = c.flags.Timeout
+	flags.DisableCompression = c.flags.DisableCompression
+	flags.WrapConfigFn = c.flags.WrapConfigFn
+
+	return flags
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type configEntry interface {
+	*api.Cluster | *api.AuthInfo
+}
+
+// referenced checks if a cluster or user is used by a context other than the given one.
+func referenced(cfg *api.Config, skip string, ref func(*api.Context) string, n string) bool {
+	for cn, ctx := range cfg.Contexts {
+		if cn != skip && ref(ctx) == n {
+			return true
+		}
+	}
+
+	return false
+}
+
+// mergeEntry adds a cluster or user to the given entries. Returns the entry name
+// which might differ from the original one on conflicts.
+func mergeEntry[T configEntry](mm map[string]T, n string, e T, replace bool, renamed map[string]string) string {
+	clearOrigin(e)
+	curr, ok := mm[n]
+	if !ok || replace {
+		mm[n] = e
+		return n
+	}
+	if sameEntry(curr, e) {
+		return n
+	}
+	nn := n
+	for i := 1; ; i++ {
+		nn = fmt.Sprintf("%s-%d", n, i)
+		if curr, ok := mm[nn]; !ok || sameEntry(curr, e) {
+			break
+		}
+	}
+	mm[nn], renamed[n] = e, nn
+
+	return nn
+}
+
+func clearOrigin[T configEntry](e T) {
+	switch v := any(e).(type) {
+	case *api.Cluster:
+		v.LocationOfOrigin = ""
+	case *api.AuthInfo:
+		v.LocationOfOrigin = ""
+	}
+}
+
+// sameEntry checks if two entries are identical regardless of their origin.
+func sameEntry[T configEntry](a, b T) bool {
+	switch v := any(a).(type) {
+	case *api.Cluster:
+		c1, c2 := *v, *any(b).(*api.Cluster)
+		c1.LocationOfOrigin, c2.LocationOfOrigin = "", ""
+		return reflect.DeepEqual(c1, c2)
+	case *api.AuthInfo:
+		u1, u2 := *v, *any(b).(*api.AuthInfo)
+		u1.LocationOfOrigin, u2.LocationOfOrigin = "", ""
+		return reflect.DeepEqual(u1, u2)
+	default:
+		return false
+	}
+}
+
+func orphans(cfg *api.Config) (clusters, users []string) {
+	usedClusters, usedUsers := make(map[string]struct{}), make(map[string]struct{})
+	for _, ctx := range cfg.Contexts {
+		usedClusters[ctx.Cluster], usedUsers[ctx.AuthInfo] = struct{}{}, struct{}{}
+	}
+	for _, n := range slices.Sorted(maps.Keys(cfg.Clusters)) {
+		if _, ok := usedClusters[n]; !ok {
+			clusters = append(clusters, n)
+		}
+	}
+	for _, n := range slices.Sorted(maps.Keys(cfg.AuthInfos)) {
+		if _, ok := usedUsers[n]; !ok {
+			users = append(users, n)
+		}
+	}
+
+	return
+}
@@ -0,0 +1,199 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+const importConfig = `apiVersion: v1
+kind: Config
+clusters:
+  - cluster:
+      server: https://localhost:4000
+    name: fred
+  - cluster:
+      server: https://localhost:4001
+      certificate-authority: certs/ca.crt
+    name: bozo
+  - cluster:
+      server: https://localhost:4002
+    name: zorg
+contexts:
+  - context:
+      cluster: fred
+      user: bozo
+    name: bozo
+  - context:
+      cluster: bozo
+      user: bozo
+    name: blee
+  - context:
+      cluster: zorg
+      user: bozo
+    name: zorg
+users:
+  - name: bozo
+    user:
+      token: bozo
+`
+
+func TestConfigImport(t *testing.T) {
+	uu := map[string]struct {
+		overwrite        bool
+		imported, skip   []string
+		renamed          map[string]string
+		contexts         int
+		blee, bozoServer string
+	}{
+		"merge": {
+			imported:   []string{"bozo", "zorg"},
+			skip:       []string{"blee"},
+			renamed:    map[string]string{"fred": "fred-1", "zorg": "zorg-1"},
+			contexts:   5,
+			blee:       "blee",
+			bozoServer: "https://localhost:4000",
+		},
+		"overwrite": {
+			overwrite:  true,
+			imported:   []string{"blee", "bozo", "zorg"},
+			renamed:    map[string]string{"zorg": "zorg-1"},
+			contexts:   5,
+			blee:       "bozo",
+			bozoServer: "https://localhost:4000",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cfg, dir := tempConfig(t)
+			src := filepath.Join(dir, "imports", "config")
+			require.NoError(t, os.MkdirAll(filepath.Dir(src), 0o700))
+			require.NoError(t, os.WriteFile(src, []byte(importConfig), 0o600))
+
+			res, err := cfg.ImportConfig(src, u.overwrite)
+			require.NoError(t, err)
+			assert.Equal(t, u.imported, res.Contexts)
+			assert.Equal(t, u.skip, res.Skipped)
+			assert.Equal(t, u.renamed, res.Renamed)
+
+			raw, err := cfg.RawConfig()
+			require.NoError(t, err)
+			assert.Len(t, raw.Contexts, u.contexts)
+			assert.Equal(t, u.blee, raw.Contexts["blee"].Cluster)
+			bozo := raw.Contexts["bozo"]
+			assert.Equal(t, u.bozoServer, raw.Clusters[bozo.Cluster].Server)
+			assert.Equal(t, "bozo", raw.AuthInfos[bozo.AuthInfo].Token)
+			assert.Equal(t, "zorg", raw.Contexts["fred"].Cluster)
+			assert.Equal(t, "https://localhost:3002", raw.Clusters["zorg"].Server)
+			if u.overwrite {
+				assert.Equal(t, filepath.Join(dir, "imports", "certs", "ca.crt"), raw.Clusters["bozo"].CertificateAuthority)
+			}
+
+			bb, err := os.ReadFile(src)
+			require.NoError(t, err)
+			assert.Equal(t, importConfig, string(bb))
+		})
+	}
+}
+
+func TestConfigPrune(t *testing.T) {
+	cfg, _ := tempConfig(t)
+
+	cc, uu, err := cfg.OrphanedEntries()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"fred"}, cc)
+	assert.Empty(t, uu)
+
+	cc, uu, err = cfg.PruneConfig()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"fred"}, cc)
+	assert.Empty(t, uu)
+
+	raw, err := cfg.RawConfig()
+	require.NoError(t, err)
+	assert.Len(t, raw.Clusters, 2)
+	assert.Len(t, raw.Contexts, 3)
+
+	cc, uu, err = cfg.PruneConfig()
+	require.NoError(t, err)
+	assert.Empty(t, cc)
+	assert.Empty(t, uu)
+}
+
+func TestConfigUpdateContext(t *testing.T) {
+	cfg, _ := tempConfig(t)
+
+	require.NoError(t, cfg.UpdateContext("fred", "ns1", "blee", "blee"))
+	ctx, err := cfg.GetContext("fred")
+	require.NoError(t, err)
+	assert.Equal(t, "ns1", ctx.Namespace)
+	assert.Equal(t, "blee", ctx.Cluster)
+	assert.Equal(t, "blee", ctx.AuthInfo)
+
+	require.Error(t, cfg.UpdateContext("fred", "ns1", "bozo", "blee"))
+	require.Error(t, cfg.UpdateContext("fred", "ns1", "blee", "bozo"))
+	require.Error(t, cfg.UpdateContext("bozo", "ns1", "blee", "blee"))
+}
+
+func TestConfigImpersonation(t *testing.T) {
+	cfg, _ := tempConfig(t)
+
+	assert.Empty(t, cfg.ImpersonationInfo())
+
+	cfg.SetImpersonation("fred", []string{"devs", "ops"})
+	u, gg := cfg.Impersonation()
+	assert.Equal(t, "fred", u)
+	assert.Equal(t, []string{"devs", "ops"}, gg)
+	assert.Equal(t, "fred [devs,ops]", cfg.ImpersonationInfo())
+
+	cfg.SetImpersonation("system:serviceaccount:ns1:sa1", nil)
+	assert.Equal(t, "system:serviceaccount:ns1:sa1", cfg.ImpersonationInfo())
+
+	cfg.SetImpersonation("", nil)
+	assert.Empty(t, cfg.ImpersonationInfo())
+}
+
+func TestConfigImpersonationKeepsFlags(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config")
+	require.NoError(t, cp(kubeConfig, path))
+	ca, cert, key, server := "ca.crt", "client.crt", "client.key", "https://localhost:3000"
+	cfg := client.NewConfig(&genericclioptions.ConfigFlags{
+		KubeConfig: &path,
+		CAFile:     &ca,
+		CertFile:   &cert,
+		KeyFile:    &key,
+		APIServer:  &server,
+	})
+
+	cfg.SetImpersonation("fred", nil)
+	_, _, err := cfg.PruneConfig()
+	require.NoError(t, err)
+
+	flags := cfg.Flags()
+	assert.Equal(t, ca, *flags.CAFile)
+	assert.Equal(t, cert, *flags.CertFile)
+	assert.Equal(t, key, *flags.KeyFile)
+	assert.Equal(t, server, *flags.APIServer)
+	assert.Equal(t, "fred", *flags.Impersonate)
+}
+
+// Helpers...
+
+func tempConfig(t *testing.T) (*client.Config, string) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config")
+	require.NoError(t, cp(kubeConfig, path))
+
+	return client.NewConfig(&genericclioptions.ConfigFlags{KubeConfig: &path}), dir
+}
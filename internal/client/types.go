@@ -105,6 +105,9 @@ type Connection interface {
 	// SwitchContext switches cluster based on context.
 	SwitchContext(ctx string) error
 
+	// Impersonate switches the connection identity. A blank user reverts to the initial identity.
+	Impersonate(user string, groups []string) error
+
 	// CachedDiscovery connects to discovery client.
 	CachedDiscovery() (*disk.CachedDiscoveryClient, error)
 
@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"log/slog"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/slogs"
+)
+
+const (
+	// DefaultMaxAlerts tracks the default number of alerts kept around.
+	DefaultMaxAlerts = 100
+
+	// AlertInfo denotes an informational alert.
+	AlertInfo = "info"
+
+	// AlertWarn denotes a warning alert.
+	AlertWarn = "warn"
+
+	// AlertError denotes an error alert.
+	AlertError = "error"
+)
+
+// Alerts tracks resource alert rules and notification options.
+type Alerts struct {
+	Enable    bool        `json:"enable" yaml:"enable"`
+	Bell      bool        `json:"bell" yaml:"bell"`
+	Desktop   bool        `json:"desktop" yaml:"desktop"`
+	Webhook   string      `json:"webhook" yaml:"webhook,omitempty"`
+	MaxAlerts int         `json:"maxAlerts" yaml:"maxAlerts"`
+	Rules     []AlertRule `json:"rules" yaml:"rules,omitempty"`
+}
+
+// AlertRule describes a resource condition that raises an alert.
+type AlertRule struct {
+	// Name identifies the rule.
+	Name string `json:"name" yaml:"name"`
+
+	// Resource specifies the resource gvr or alias ie po, apps/v1/deployments.
+	Resource string `json:"resource" yaml:"resource"`
+
+	// Namespace limits the rule to a given namespace. Blank means all.
+	Namespace string `json:"namespace" yaml:"namespace,omitempty"`
+
+	// Column specifies the resource column to match against ie STATUS.
+	Column string `json:"column" yaml:"column,omitempty"`
+
+	// Match specifies a regex the column value must match.
+	Match string `json:"match" yaml:"match,omitempty"`
+
+	// NotReady matches resources with less ready than desired in their READY column.
+	NotReady bool `json:"notReady" yaml:"notReady,omitempty"`
+
+	// For specifies how long the condition must hold before alerting ie 2m.
+	For string `json:"for" yaml:"for,omitempty"`
+
+	// Severity specifies the alert severity: info, warn or error.
+	Severity string `json:"severity" yaml:"severity,omitempty"`
+}
+
+// NewAlerts returns a new instance.
+func NewAlerts() Alerts {
+	return Alerts{
+		Bell:      true,
+		MaxAlerts: DefaultMaxAlerts,
+	}
+}
+
+// Validate checks alerts options and drops invalid rules.
+func (a Alerts) Validate() Alerts {
+	if a.MaxAlerts <= 0 {
+		a.MaxAlerts = DefaultMaxAlerts
+	}
+	rr := make([]AlertRule, 0, len(a.Rules))
+	for _, r := range a.Rules {
+		if err := r.Validate(); err != "" {
+			slog.Warn("Skipping invalid alert rule", slogs.Name, r.Name, slogs.Error, err)
+			continue
+		}
+		if r.Severity == "" {
+			r.Severity = AlertWarn
+		}
+		rr = append(rr, r)
+	}
+	a.Rules = rr
+
+	return a
+}
+
+// Validate checks the rule is well formed. Returns the reason when it is not.
+func (r AlertRule) Validate() string {
+	switch {
+	case r.Name == "":
+		return "a name is required"
+	case r.Resource == "":
+		return "a resource is required"
+	case r.Match == "" && !r.NotReady:
+		return "either match or notReady is required"
+	case r.Match != "" && r.Column == "":
+		return "a column is required to match against"
+	}
+	if r.Match != "" {
+		if _, err := regexp.Compile(r.Match); err != nil {
+			return "invalid match regex: " + err.Error()
+		}
+	}
+	if r.For != "" {
+		if d, err := time.ParseDuration(r.For); err != nil || d < 0 {
+			return "invalid for duration: " + r.For
+		}
+	}
+	switch r.Severity {
+	case "", AlertInfo, AlertWarn, AlertError:
+	default:
+		return "invalid severity: " + r.Severity
+	}
+
+	return ""
+}
+
+// Duration returns how long the condition must hold before alerting.
+func (r AlertRule) Duration() time.Duration {
+	d, _ := time.ParseDuration(r.For)
+
+	return d
+}
+
+// ColumnName returns the normalized column name.
+func (r AlertRule) ColumnName() string {
+	return strings.ToUpper(r.Column)
+}
@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlertsValidate(t *testing.T) {
+	uu := map[string]struct {
+		a, e config.Alerts
+	}{
+		"default": {
+			a: config.NewAlerts(),
+			e: config.Alerts{Bell: true, MaxAlerts: 100, Rules: []config.AlertRule{}},
+		},
+		"empty": {
+			e: config.Alerts{MaxAlerts: 100, Rules: []config.AlertRule{}},
+		},
+		"rules": {
+			a: config.Alerts{
+				Enable:    true,
+				MaxAlerts: 10,
+				Rules: []config.AlertRule{
+					{Name: "crash", Resource: "po", Column: "STATUS", Match: "CrashLoop", Severity: config.AlertError},
+					{Name: "unavailable", Resource: "deploy", NotReady: true, For: "2m"},
+				},
+			},
+			e: config.Alerts{
+				Enable:    true,
+				MaxAlerts: 10,
+				Rules: []config.AlertRule{
+					{Name: "crash", Resource: "po", Column: "STATUS", Match: "CrashLoop", Severity: config.AlertError},
+					{Name: "unavailable", Resource: "deploy", NotReady: true, For: "2m", Severity: config.AlertWarn},
+				},
+			},
+		},
+		"toast": {
+			a: config.Alerts{
+				MaxAlerts: -1,
+				Rules: []config.AlertRule{
+					{Resource: "po", Column: "STATUS", Match: "x"},
+					{Name: "no-res", Column: "STATUS", Match: "x"},
+					{Name: "no-cond", Resource: "po"},
+					{Name: "no-col", Resource: "po", Match: "x"},
+					{Name: "bad-rx", Resource: "po", Column: "STATUS", Match: "("},
+					{Name: "bad-for", Resource: "po", NotReady: true, For: "bozo"},
+					{Name: "bad-sev", Resource: "po", NotReady: true, Severity: "fatal"},
+				},
+			},
+			e: config.Alerts{MaxAlerts: 100, Rules: []config.AlertRule{}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.a.Validate())
+		})
+	}
+}
+
+func TestAlertRuleDuration(t *testing.T) {
+	assert.Equal(t, 2*time.Minute, config.AlertRule{For: "2m"}.Duration())
+	assert.Equal(t, time.Duration(0), config.AlertRule{}.Duration())
+	assert.Equal(t, "STATUS", config.AlertRule{Column: "status"}.ColumnName())
+}
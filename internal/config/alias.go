@@ -182,6 +182,12 @@ func (a *Aliases) loadDefaultAliases() {
 	a.declare(client.PuGVR, "pulse", "pu", "hz")
 	a.declare(client.XGVR, "xray", "x")
 	a.declare(client.WkGVR, "workload", "wk")
+	a.declare(client.CapGVR, "capacity", "cap")
+	a.declare(client.EvaGVR, "eventarchive", "eva")
+	a.declare(client.CrtGVR, "tlscert", "tlsc")
+	a.declare(client.SesGVR, "history", "hist", "session")
+	a.declare(client.PinGVR, "pin")
+	a.declare(client.AlGVR, "alert")
 }
 
 // Save alias to disk.
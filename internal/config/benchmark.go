@@ -4,6 +4,7 @@
 package config
 
 import (
+	"fmt"
 	"net/http"
 	"os"
 
@@ -48,13 +49,24 @@ type (
 		Headers http.Header `yaml:"headers"`
 	}
 
+	// BenchStep represents a scenario step.
+	BenchStep struct {
+		Name    string      `yaml:"name"`
+		Method  string      `yaml:"method"`
+		Path    string      `yaml:"path"`
+		Body    string      `yaml:"body"`
+		Headers http.Header `yaml:"headers"`
+		Weight  int         `yaml:"weight"`
+	}
+
 	// BenchConfig represents a service benchmark.
 	BenchConfig struct {
-		Name string
-		C    int  `yaml:"concurrency"`
-		N    int  `yaml:"requests"`
-		Auth Auth `yaml:"auth"`
-		HTTP HTTP `yaml:"http"`
+		Name     string
+		C        int         `yaml:"concurrency"`
+		N        int         `yaml:"requests"`
+		Auth     Auth        `yaml:"auth"`
+		HTTP     HTTP        `yaml:"http"`
+		Scenario []BenchStep `yaml:"scenario"`
 	}
 )
 
@@ -79,6 +91,71 @@ func DefaultBenchSpec() BenchConfig {
 	}
 }
 
+// IsScenario checks if the benchmark spans multiple steps.
+func (b BenchConfig) IsScenario() bool {
+	return len(b.Scenario) > 0
+}
+
+// Steps returns the benchmark steps. Unset steps attributes are inherited from the
+// http section. A benchmark without a scenario yields a single step.
+func (b BenchConfig) Steps() []BenchStep {
+	if !b.IsScenario() {
+		return []BenchStep{b.stepFor(BenchStep{Weight: 1})}
+	}
+
+	ss := make([]BenchStep, 0, len(b.Scenario))
+	for i, s := range b.Scenario {
+		s = b.stepFor(s)
+		if s.Name == "" {
+			s.Name = fmt.Sprintf("step-%d", i+1)
+		}
+		ss = append(ss, s)
+	}
+
+	return ss
+}
+
+func (b BenchConfig) stepFor(s BenchStep) BenchStep {
+	if s.Method == "" {
+		s.Method = b.HTTP.Method
+	}
+	if s.Method == "" {
+		s.Method = DefaultMethod
+	}
+	if s.Path == "" {
+		s.Path = b.HTTP.Path
+	}
+	if s.Body == "" {
+		s.Body = b.HTTP.Body
+	}
+	if s.Headers == nil {
+		s.Headers = b.HTTP.Headers
+	}
+	if s.Weight <= 0 {
+		s.Weight = 1
+	}
+
+	return s
+}
+
+// Distribute splits the benchmark requests across steps based on their weights.
+// Each step issues at least as many requests as the benchmark concurrency.
+func (b BenchConfig) Distribute(ss []BenchStep) []int {
+	var total int
+	for _, s := range ss {
+		total += s.Weight
+	}
+	nn := make([]int, len(ss))
+	if total == 0 {
+		return nn
+	}
+	for i, s := range ss {
+		nn[i] = max(b.N*s.Weight/total, b.C, 1)
+	}
+
+	return nn
+}
+
 func newBenchmark() Benchmark {
 	return Benchmark{
 		C: DefaultC,
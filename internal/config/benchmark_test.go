@@ -192,3 +192,57 @@ func TestBenchContainerLoad(t *testing.T) {
 		})
 	}
 }
+
+func TestBenchScenarioSteps(t *testing.T) {
+	b, err := NewBench("testdata/benchmarks/b_scenario.yaml")
+	require.NoError(t, err)
+
+	cfg := b.Benchmarks.Services["default/nginx"]
+	assert.True(t, cfg.IsScenario())
+
+	ss := cfg.Steps()
+	require.Len(t, ss, 3)
+	hh := http.Header{"Accept": []string{"application/json"}}
+	assert.Equal(t, BenchStep{Name: "home", Method: "GET", Path: "/", Headers: hh, Weight: 3}, ss[0])
+	assert.Equal(t, BenchStep{Name: "login", Method: "POST", Path: "/login", Body: `{"user": "fred"}`, Headers: hh, Weight: 1}, ss[1])
+	assert.Equal(t, BenchStep{Name: "step-3", Method: "GET", Path: "/health", Headers: hh, Weight: 1}, ss[2])
+	assert.Equal(t, []int{60, 20, 20}, cfg.Distribute(ss))
+}
+
+func TestBenchSteps(t *testing.T) {
+	uu := map[string]struct {
+		cfg BenchConfig
+		e   []BenchStep
+		n   []int
+	}{
+		"default": {
+			cfg: DefaultBenchSpec(),
+			e:   []BenchStep{{Method: "GET", Path: "/", Weight: 1}},
+			n:   []int{200},
+		},
+		"min-concurrency": {
+			cfg: BenchConfig{
+				C: 5,
+				N: 10,
+				Scenario: []BenchStep{
+					{Name: "a", Path: "/a", Weight: 10},
+					{Name: "b", Path: "/b"},
+				},
+			},
+			e: []BenchStep{
+				{Name: "a", Method: "GET", Path: "/a", Weight: 10},
+				{Name: "b", Method: "GET", Path: "/b", Weight: 1},
+			},
+			n: []int{9, 5},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ss := u.cfg.Steps()
+			assert.Equal(t, u.e, ss)
+			assert.Equal(t, u.n, u.cfg.Distribute(ss))
+		})
+	}
+}
@@ -60,6 +60,16 @@ func (c *Config) ContextHotkeysPath() string {
 	return AppContextHotkeysFile(ct.ClusterName, c.K9s.activeContextName)
 }
 
+// ContextKeymapPath returns a context specific keymap file spec.
+func (c *Config) ContextKeymapPath() string {
+	ct, err := c.K9s.ActiveContext()
+	if err != nil {
+		return ""
+	}
+
+	return AppContextKeymapFile(ct.ClusterName, c.K9s.activeContextName)
+}
+
 // ContextAliasesPath returns a context specific aliases file spec.
 func (c *Config) ContextAliasesPath() string {
 	ct, err := c.K9s.ActiveContext()
@@ -80,6 +90,26 @@ func (c *Config) ContextPluginsPath() (string, error) {
 	return AppContextPluginsFile(ct.GetClusterName(), c.K9s.activeContextName), nil
 }
 
+// ContextEventsPath returns a context specific events archive file spec.
+func (c *Config) ContextEventsPath() (string, error) {
+	ct, err := c.K9s.ActiveContext()
+	if err != nil {
+		return "", err
+	}
+
+	return AppContextEventsFile(ct.GetClusterName(), c.K9s.activeContextName), nil
+}
+
+// ContextSessionsDir returns a context specific recorded sessions directory.
+func (c *Config) ContextSessionsDir() (string, error) {
+	ct, err := c.K9s.ActiveContext()
+	if err != nil {
+		return "", err
+	}
+
+	return AppContextSessionsDir(ct.GetClusterName(), c.K9s.activeContextName), nil
+}
+
 func setK8sTimeout(flags *genericclioptions.ConfigFlags, d time.Duration) {
 	v := d.String()
 	flags.Timeout = &v
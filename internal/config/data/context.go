@@ -13,13 +13,15 @@ import (
 
 // Context tracks K9s context configuration.
 type Context struct {
-	ClusterName  string       `yaml:"cluster,omitempty"`
-	ReadOnly     *bool        `yaml:"readOnly,omitempty"`
-	Skin         string       `yaml:"skin,omitempty"`
-	Namespace    *Namespace   `yaml:"namespace"`
-	View         *View        `yaml:"view"`
-	FeatureGates FeatureGates `yaml:"featureGates"`
-	Proxy        *Proxy       `yaml:"proxy"`
+	ClusterName  string           `yaml:"cluster,omitempty"`
+	ReadOnly     *bool            `yaml:"readOnly,omitempty"`
+	Skin         string           `yaml:"skin,omitempty"`
+	Namespace    *Namespace       `yaml:"namespace"`
+	View         *View            `yaml:"view"`
+	FeatureGates FeatureGates     `yaml:"featureGates"`
+	Proxy        *Proxy           `yaml:"proxy"`
+	Pins         []Pin            `yaml:"pins,omitempty"`
+	Splits       map[string]Split `yaml:"splits,omitempty"`
 	mx           sync.RWMutex
 }
 
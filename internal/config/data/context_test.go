@@ -30,3 +30,31 @@ func TestClusterValidateEmpty(t *testing.T) {
 	assert.Len(t, c.Namespace.Favorites, 1)
 	assert.Equal(t, []string{"default"}, c.Namespace.Favorites)
 }
+
+func TestContextPins(t *testing.T) {
+	c := data.NewContext()
+	assert.False(t, c.HasPin("apps/v1/deployments", "default/nginx"))
+
+	assert.True(t, c.TogglePin("apps/v1/deployments", "default/nginx"))
+	assert.True(t, c.TogglePin("v1/nodes", "n1"))
+	assert.True(t, c.HasPin("apps/v1/deployments", "default/nginx"))
+	assert.Equal(t, []data.Pin{
+		data.NewPin("apps/v1/deployments", "default/nginx"),
+		data.NewPin("v1/nodes", "n1"),
+	}, c.GetPins())
+
+	assert.False(t, c.TogglePin("apps/v1/deployments", "default/nginx"))
+	assert.False(t, c.HasPin("apps/v1/deployments", "default/nginx"))
+	assert.Equal(t, []data.Pin{data.NewPin("v1/nodes", "n1")}, c.GetPins())
+}
+
+func TestContextSplits(t *testing.T) {
+	c := data.NewContext()
+	_, ok := c.SplitFor("v1/pods")
+	assert.False(t, ok)
+
+	c.SetSplit("v1/pods", data.Split{Pane: "logs", Orientation: "vertical", Size: 30})
+	s, ok := c.SplitFor("v1/pods")
+	assert.True(t, ok)
+	assert.Equal(t, data.Split{Pane: "logs", Orientation: "vertical", Size: 30}, s)
+}
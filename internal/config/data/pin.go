@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package data
+
+import "slices"
+
+// Pin tracks a resource pinned to the context watch list.
+type Pin struct {
+	GVR  string `yaml:"gvr"`
+	Path string `yaml:"path"`
+}
+
+// NewPin returns a new pin.
+func NewPin(gvr, path string) Pin {
+	return Pin{GVR: gvr, Path: path}
+}
+
+// HasPin checks if a resource is pinned.
+func (c *Context) HasPin(gvr, path string) bool {
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+
+	return slices.Contains(c.Pins, NewPin(gvr, path))
+}
+
+// TogglePin pins or unpins a resource. Returns true if the resource is now pinned.
+func (c *Context) TogglePin(gvr, path string) bool {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	p := NewPin(gvr, path)
+	if idx := slices.Index(c.Pins, p); idx >= 0 {
+		c.Pins = slices.Delete(c.Pins, idx, idx+1)
+		return false
+	}
+	c.Pins = append(c.Pins, p)
+
+	return true
+}
+
+// GetPins returns the pinned resources.
+func (c *Context) GetPins() []Pin {
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+
+	return slices.Clone(c.Pins)
+}
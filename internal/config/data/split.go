@@ -0,0 +1,32 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package data
+
+// Split tracks a view split pane state.
+type Split struct {
+	Pane        string `yaml:"pane"`
+	Orientation string `yaml:"orientation"`
+	Size        int    `yaml:"size"`
+}
+
+// SplitFor returns a view split pane state if any.
+func (c *Context) SplitFor(gvr string) (Split, bool) {
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+
+	s, ok := c.Splits[gvr]
+
+	return s, ok
+}
+
+// SetSplit records a view split pane state.
+func (c *Context) SetSplit(gvr string, s Split) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	if c.Splits == nil {
+		c.Splits = make(map[string]Split)
+	}
+	c.Splits[gvr] = s
+}
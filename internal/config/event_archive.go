@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import "time"
+
+const (
+	// DefaultEventArchiveMaxAge tracks the default archived events retention.
+	DefaultEventArchiveMaxAge = "24h"
+
+	// DefaultEventArchiveMaxEvents tracks the default max number of archived events per context.
+	DefaultEventArchiveMaxEvents = 5_000
+)
+
+// EventArchive tracks event archive options.
+type EventArchive struct {
+	Enable    bool   `json:"enable" yaml:"enable"`
+	MaxAge    string `json:"maxAge" yaml:"maxAge"`
+	MaxEvents int    `json:"maxEvents" yaml:"maxEvents"`
+}
+
+// NewEventArchive returns a new instance.
+func NewEventArchive() EventArchive {
+	return EventArchive{
+		MaxAge:    DefaultEventArchiveMaxAge,
+		MaxEvents: DefaultEventArchiveMaxEvents,
+	}
+}
+
+// Validate checks retention limits and make sure we're cool. If not use defaults.
+func (e EventArchive) Validate() EventArchive {
+	if d, err := time.ParseDuration(e.MaxAge); err != nil || d <= 0 {
+		e.MaxAge = DefaultEventArchiveMaxAge
+	}
+	if e.MaxEvents <= 0 {
+		e.MaxEvents = DefaultEventArchiveMaxEvents
+	}
+
+	return e
+}
+
+// Retention returns how long archived events are kept around.
+func (e EventArchive) Retention() time.Duration {
+	if d, err := time.ParseDuration(e.MaxAge); err == nil && d > 0 {
+		return d
+	}
+	d, _ := time.ParseDuration(DefaultEventArchiveMaxAge)
+
+	return d
+}
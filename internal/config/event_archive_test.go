@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventArchiveValidate(t *testing.T) {
+	uu := map[string]struct {
+		e, ee config.EventArchive
+		r     time.Duration
+	}{
+		"default": {
+			e:  config.NewEventArchive(),
+			ee: config.EventArchive{MaxAge: "24h", MaxEvents: 5000},
+			r:  24 * time.Hour,
+		},
+		"empty": {
+			ee: config.EventArchive{MaxAge: "24h", MaxEvents: 5000},
+			r:  24 * time.Hour,
+		},
+		"custom": {
+			e:  config.EventArchive{Enable: true, MaxAge: "72h", MaxEvents: 100},
+			ee: config.EventArchive{Enable: true, MaxAge: "72h", MaxEvents: 100},
+			r:  72 * time.Hour,
+		},
+		"toast": {
+			e:  config.EventArchive{MaxAge: "bozo", MaxEvents: -1},
+			ee: config.EventArchive{MaxAge: "24h", MaxEvents: 5000},
+			r:  24 * time.Hour,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			e := u.e.Validate()
+			assert.Equal(t, u.ee, e)
+			assert.Equal(t, u.r, e.Retention())
+		})
+	}
+}
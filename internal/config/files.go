@@ -80,6 +80,9 @@ var (
 
 	// AppHotKeysFile tracks hotkeys config file.
 	AppHotKeysFile string
+
+	// AppKeymapFile tracks keymap config file.
+	AppKeymapFile string
 )
 
 // InitLogLoc initializes K9s logs location.
@@ -152,6 +155,7 @@ func initK9sEnvLocs() error {
 
 	AppConfigFile = filepath.Join(AppConfigDir, data.MainConfigFile)
 	AppHotKeysFile = filepath.Join(AppConfigDir, "hotkeys.yaml")
+	AppKeymapFile = filepath.Join(AppConfigDir, "keymap.yaml")
 	AppAliasesFile = filepath.Join(AppConfigDir, "aliases.yaml")
 	AppPluginsFile = filepath.Join(AppConfigDir, "plugins.yaml")
 	AppViewsFile = filepath.Join(AppConfigDir, "views.yaml")
@@ -173,6 +177,7 @@ func initXDGLocs() error {
 	}
 
 	AppHotKeysFile = filepath.Join(AppConfigDir, "hotkeys.yaml")
+	AppKeymapFile = filepath.Join(AppConfigDir, "keymap.yaml")
 	AppAliasesFile = filepath.Join(AppConfigDir, "aliases.yaml")
 	AppPluginsFile = filepath.Join(AppConfigDir, "plugins.yaml")
 	AppViewsFile = filepath.Join(AppConfigDir, "views.yaml")
@@ -230,6 +235,21 @@ func AppContextHotkeysFile(cluster, context string) string {
 	return filepath.Join(AppContextsDir, data.SanitizeContextSubpath(cluster, context), "hotkeys.yaml")
 }
 
+// AppContextKeymapFile generates a valid context specific keymap file path.
+func AppContextKeymapFile(cluster, context string) string {
+	return filepath.Join(AppContextsDir, data.SanitizeContextSubpath(cluster, context), "keymap.yaml")
+}
+
+// AppContextEventsFile generates a valid context specific events archive file path.
+func AppContextEventsFile(cluster, context string) string {
+	return filepath.Join(AppContextsDir, data.SanitizeContextSubpath(cluster, context), "events.json")
+}
+
+// AppContextSessionsDir generates a valid context specific recorded sessions directory.
+func AppContextSessionsDir(cluster, context string) string {
+	return filepath.Join(AppContextsDir, data.SanitizeContextSubpath(cluster, context), "sessions")
+}
+
 // AppContextConfig generates a valid context config file path.
 func AppContextConfig(cluster, context string) string {
 	return filepath.Join(AppContextDir(cluster, context), data.MainConfigFile)
@@ -28,6 +28,7 @@ type Flags struct {
 	Crumbsless    *bool
 	Splashless    *bool
 	ScreenDumpDir *string
+	Snapshot      *string
 }
 
 // NewFlags returns new configuration flags.
@@ -45,6 +46,7 @@ func NewFlags() *Flags {
 		Crumbsless:    boolPtr(false),
 		Splashless:    boolPtr(false),
 		ScreenDumpDir: strPtr(AppDumpsDir),
+		Snapshot:      strPtr(""),
 	}
 }
 
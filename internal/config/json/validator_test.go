@@ -222,6 +222,7 @@ func TestValidateViews(t *testing.T) {
 			err: `Additional property cols is not allowed
 Additional property sortCol is not allowed
 Invalid type. Expected: object, given: null
+Must validate at least one schema (anyOf)
 columns is required`,
 		},
 	}
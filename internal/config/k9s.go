@@ -32,23 +32,27 @@ var defaultGPUVendors = gpuVendors{
 
 // K9s tracks K9s configuration options.
 type K9s struct {
-	LiveViewAutoRefresh bool       `json:"liveViewAutoRefresh" yaml:"liveViewAutoRefresh"`
-	GPUVendors          gpuVendors `json:"gpuVendors" yaml:"gpuVendors"`
-	ScreenDumpDir       string     `json:"screenDumpDir" yaml:"screenDumpDir,omitempty"`
-	RefreshRate         int        `json:"refreshRate" yaml:"refreshRate"`
-	APIServerTimeout    string     `json:"apiServerTimeout" yaml:"apiServerTimeout"`
-	MaxConnRetry        int32      `json:"maxConnRetry" yaml:"maxConnRetry"`
-	ReadOnly            bool       `json:"readOnly" yaml:"readOnly"`
-	NoExitOnCtrlC       bool       `json:"noExitOnCtrlC" yaml:"noExitOnCtrlC"`
-	PortForwardAddress  string     `yaml:"portForwardAddress"`
-	UI                  UI         `json:"ui" yaml:"ui"`
-	SkipLatestRevCheck  bool       `json:"skipLatestRevCheck" yaml:"skipLatestRevCheck"`
-	DisablePodCounting  bool       `json:"disablePodCounting" yaml:"disablePodCounting"`
-	ShellPod            *ShellPod  `json:"shellPod" yaml:"shellPod"`
-	ImageScans          ImageScans `json:"imageScans" yaml:"imageScans"`
-	Logger              Logger     `json:"logger" yaml:"logger"`
-	Thresholds          Threshold  `json:"thresholds" yaml:"thresholds"`
-	DefaultView         string     `json:"defaultView" yaml:"defaultView"`
+	LiveViewAutoRefresh bool             `json:"liveViewAutoRefresh" yaml:"liveViewAutoRefresh"`
+	GPUVendors          gpuVendors       `json:"gpuVendors" yaml:"gpuVendors"`
+	ScreenDumpDir       string           `json:"screenDumpDir" yaml:"screenDumpDir,omitempty"`
+	ScreenDumpFormat    string           `json:"screenDumpFormat" yaml:"screenDumpFormat,omitempty"`
+	RefreshRate         int              `json:"refreshRate" yaml:"refreshRate"`
+	APIServerTimeout    string           `json:"apiServerTimeout" yaml:"apiServerTimeout"`
+	MaxConnRetry        int32            `json:"maxConnRetry" yaml:"maxConnRetry"`
+	ReadOnly            bool             `json:"readOnly" yaml:"readOnly"`
+	NoExitOnCtrlC       bool             `json:"noExitOnCtrlC" yaml:"noExitOnCtrlC"`
+	PortForwardAddress  string           `yaml:"portForwardAddress"`
+	UI                  UI               `json:"ui" yaml:"ui"`
+	SkipLatestRevCheck  bool             `json:"skipLatestRevCheck" yaml:"skipLatestRevCheck"`
+	DisablePodCounting  bool             `json:"disablePodCounting" yaml:"disablePodCounting"`
+	ShellPod            *ShellPod        `json:"shellPod" yaml:"shellPod"`
+	ImageScans          ImageScans       `json:"imageScans" yaml:"imageScans"`
+	EventArchive        EventArchive     `json:"eventArchive" yaml:"eventArchive"`
+	SessionRecording    SessionRecording `json:"sessionRecording" yaml:"sessionRecording"`
+	Alerts              Alerts           `json:"alerts" yaml:"alerts"`
+	Logger              Logger           `json:"logger" yaml:"logger"`
+	Thresholds          Threshold        `json:"thresholds" yaml:"thresholds"`
+	DefaultView         string           `json:"defaultView" yaml:"defaultView"`
 	manualRefreshRate   int
 	manualReadOnly      *bool
 	manualCommand       *string
@@ -75,6 +79,9 @@ func NewK9s(conn client.Connection, ks data.KubeSettings) *K9s {
 		PortForwardAddress: defaultPFAddress(),
 		ShellPod:           NewShellPod(),
 		ImageScans:         NewImageScans(),
+		EventArchive:       NewEventArchive(),
+		SessionRecording:   NewSessionRecording(),
+		Alerts:             NewAlerts(),
 		dir:                data.NewDir(AppContextsDir),
 		conn:               conn,
 		ks:                 ks,
@@ -135,6 +142,7 @@ func (k *K9s) Merge(k1 *K9s) {
 	k.LiveViewAutoRefresh = k1.LiveViewAutoRefresh
 	k.DefaultView = k1.DefaultView
 	k.ScreenDumpDir = k1.ScreenDumpDir
+	k.ScreenDumpFormat = k1.ScreenDumpFormat
 	k.RefreshRate = k1.RefreshRate
 	k.APIServerTimeout = k1.APIServerTimeout
 	k.MaxConnRetry = k1.MaxConnRetry
@@ -147,6 +155,9 @@ func (k *K9s) Merge(k1 *K9s) {
 	k.ShellPod = k1.ShellPod
 	k.Logger = k1.Logger
 	k.ImageScans = k1.ImageScans
+	k.EventArchive = k1.EventArchive
+	k.SessionRecording = k1.SessionRecording
+	k.Alerts = k1.Alerts
 	if k1.Thresholds != nil {
 		k.Thresholds = k1.Thresholds
 	}
@@ -166,6 +177,15 @@ func (k *K9s) AppScreenDumpDir() string {
 	return d
 }
 
+// DumpFormat returns the screen dumps output format. Defaults to csv.
+func (k *K9s) DumpFormat() string {
+	if !IsDumpFormat(k.ScreenDumpFormat) {
+		return DumpCSV
+	}
+
+	return k.ScreenDumpFormat
+}
+
 // ContextScreenDumpDir fetch context specific screen dumps dir.
 func (k *K9s) ContextScreenDumpDir() string {
 	return filepath.Join(k.AppScreenDumpDir(), k.contextPath())
@@ -413,6 +433,9 @@ func (k *K9s) Validate(c client.Connection, contextName, clusterName string) {
 		k.ShellPod.Validate()
 	}
 	k.Logger = k.Logger.Validate()
+	k.EventArchive = k.EventArchive.Validate()
+	k.SessionRecording = k.SessionRecording.Validate()
+	k.Alerts = k.Alerts.Validate()
 	k.Thresholds = k.Thresholds.Validate()
 
 	if cfg := k.getActiveConfig(); cfg != nil {
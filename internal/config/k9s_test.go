@@ -147,3 +147,22 @@ func TestAppScreenDumpDir(t *testing.T) {
 	require.NoError(t, cfg.Load("testdata/configs/k9s.yaml", true))
 	assert.Equal(t, "/tmp/k9s-test/screen-dumps", cfg.K9s.AppScreenDumpDir())
 }
+
+func TestDumpFormat(t *testing.T) {
+	uu := map[string]struct {
+		f, e string
+	}{
+		"default": {e: config.DumpCSV},
+		"json":    {f: "json", e: config.DumpJSON},
+		"html":    {f: "html", e: config.DumpHTML},
+		"toast":   {f: "docx", e: config.DumpCSV},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			k9s := config.K9s{ScreenDumpFormat: u.f}
+			assert.Equal(t, u.e, k9s.DumpFormat())
+		})
+	}
+}
@@ -0,0 +1,270 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/config/json"
+	"github.com/derailed/k9s/internal/slogs"
+	"github.com/derailed/tcell/v2"
+	"gopkg.in/yaml.v3"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	// KeymapPresetDefault tracks the stock k9s key bindings.
+	KeymapPresetDefault = "default"
+
+	// KeymapPresetVim tracks vim flavored key bindings.
+	KeymapPresetVim = "vim"
+
+	// KeymapPresetEmacs tracks emacs flavored key bindings.
+	KeymapPresetEmacs = "emacs"
+
+	// KeymapAllScope tracks bindings applying to all views.
+	KeymapAllScope = "all"
+)
+
+// keymapPresets tracks preset bindings per scope and action names.
+var keymapPresets = map[string]map[string]map[string]string{
+	KeymapPresetVim: {
+		KeymapAllScope: {
+			"Copy":     "Shift-Y",
+			"Delete":   "Shift-D",
+			"Describe": "Shift-K",
+			"Edit":     "i",
+			"Mark":     "v",
+		},
+	},
+	KeymapPresetEmacs: {
+		KeymapAllScope: {
+			"Filter Mode": "Ctrl-S",
+			"Prev Match":  "Ctrl-R",
+			"Save":        "Ctrl-X",
+		},
+	},
+}
+
+// KeymapPresets returns all available presets.
+func KeymapPresets() []string {
+	return []string{KeymapPresetDefault, KeymapPresetVim, KeymapPresetEmacs}
+}
+
+// KeyBinding represents an action key binding.
+type KeyBinding struct {
+	Scope  string
+	Action string
+	Key    string
+	Preset bool
+}
+
+func (b KeyBinding) String() string {
+	return fmt.Sprintf("%s:%s[%s]", b.Scope, b.Action, b.Key)
+}
+
+// KeyBindings represents a collection of bindings.
+type KeyBindings []KeyBinding
+
+// Keymap represents custom key bindings.
+type Keymap struct {
+	Preset   string                       `yaml:"preset"`
+	Bindings map[string]map[string]string `yaml:"bindings"`
+}
+
+// Keymaps represents a keymap configuration.
+type Keymaps struct {
+	Keymap Keymap `yaml:"keymap"`
+}
+
+// NewKeymap returns a new keymap.
+func NewKeymap() *Keymap {
+	return &Keymap{
+		Preset:   KeymapPresetDefault,
+		Bindings: make(map[string]map[string]string),
+	}
+}
+
+// Load loads the global keymap and the given context overrides.
+func (k *Keymap) Load(path string) error {
+	if err := k.LoadKeymap(AppKeymapFile); err != nil {
+		return err
+	}
+	if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+
+	return k.LoadKeymap(path)
+}
+
+// LoadKeymap loads a keymap from a given file.
+func (k *Keymap) LoadKeymap(path string) error {
+	if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	bb, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := data.JSONValidator.Validate(json.KeymapSchema, bb); err != nil {
+		slog.Warn("Validation failed. Please update your config and restart.",
+			slogs.Path, path,
+			slogs.Error, err,
+		)
+	}
+
+	var kk Keymaps
+	if err := yaml.Unmarshal(bb, &kk); err != nil {
+		return err
+	}
+	if kk.Keymap.Preset != "" {
+		k.Preset = kk.Keymap.Preset
+	}
+	for scope, bb := range kk.Keymap.Bindings {
+		if _, ok := k.Bindings[scope]; !ok {
+			k.Bindings[scope] = make(map[string]string, len(bb))
+		}
+		for action, key := range bb {
+			k.Bindings[scope][action] = key
+		}
+	}
+
+	return k.Validate()
+}
+
+// Validate checks the preset is legit.
+func (k *Keymap) Validate() error {
+	if _, ok := keymapPresets[k.Preset]; !ok && k.Preset != KeymapPresetDefault {
+		return fmt.Errorf("invalid keymap preset %q. Must be one of %s", k.Preset, strings.Join(KeymapPresets(), "|"))
+	}
+
+	return nil
+}
+
+// BindingsFor returns the effective bindings for a view with the given aliases.
+// Presets bindings are applied first then user bindings, each starting with the
+// all scope followed by the view scopes so the most specific binding wins.
+func (k *Keymap) BindingsFor(aliases sets.Set[string]) KeyBindings {
+	mm := make(map[string]KeyBinding)
+	for _, b := range k.all() {
+		if b.Scope != KeymapAllScope && !aliases.Has(b.Scope) {
+			continue
+		}
+		mm[strings.ToLower(b.Action)] = b
+	}
+
+	bb := make(KeyBindings, 0, len(mm))
+	for _, b := range mm {
+		bb = append(bb, b)
+	}
+	sort.Slice(bb, func(i, j int) bool {
+		return bb[i].Action < bb[j].Action
+	})
+
+	return bb
+}
+
+// Conflicts reports bindings that clash with hotkeys or plugins shortcuts.
+func (k *Keymap) Conflicts(hh HotKeys, pp Plugins) []string {
+	var cc []string
+	for _, b := range k.all() {
+		for n, h := range hh.HotKey {
+			if sameKey(h.ShortCut, b.Key) {
+				cc = append(cc, fmt.Sprintf("keymap %s conflicts with hotkey %q", b, n))
+			}
+		}
+		for n, p := range pp.Plugins {
+			if sameKey(p.ShortCut, b.Key) && scopesOverlap(b.Scope, p.Scopes) {
+				cc = append(cc, fmt.Sprintf("keymap %s conflicts with plugin %q", b, n))
+			}
+		}
+	}
+	sort.Strings(cc)
+
+	return cc
+}
+
+// all returns preset bindings followed by user bindings. Within each, the all scope
+// bindings come first followed by the other scopes in a stable order.
+func (k *Keymap) all() KeyBindings {
+	bb := scopedBindings(keymapPresets[k.Preset], true)
+
+	return append(bb, scopedBindings(k.Bindings, false)...)
+}
+
+// ParseKey returns the key matching a key name. Multi characters names such as
+// Ctrl-X are matched regardless of case.
+func ParseKey(name string) (tcell.Key, error) {
+	for k, v := range tcell.KeyNames {
+		if name == v {
+			return k, nil
+		}
+	}
+	if len(name) > 1 {
+		for k, v := range tcell.KeyNames {
+			if len(v) > 1 && strings.EqualFold(name, v) {
+				return k, nil
+			}
+		}
+	}
+
+	return 0, fmt.Errorf("invalid key specified: %q", name)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func sameKey(a, b string) bool {
+	ka, erra := ParseKey(a)
+	kb, errb := ParseKey(b)
+	if erra != nil || errb != nil {
+		return a == b
+	}
+
+	return ka == kb
+}
+
+func scopesOverlap(scope string, scopes []string) bool {
+	for _, s := range scopes {
+		if s == KeymapAllScope || scope == KeymapAllScope || s == scope {
+			return true
+		}
+	}
+
+	return false
+}
+
+func scopedBindings(mm map[string]map[string]string, preset bool) KeyBindings {
+	scopes := sortedKeys(mm)
+	sort.SliceStable(scopes, func(i, j int) bool {
+		return scopes[i] == KeymapAllScope && scopes[j] != KeymapAllScope
+	})
+
+	var bb KeyBindings
+	for _, scope := range scopes {
+		aa := mm[scope]
+		for _, action := range sortedKeys(aa) {
+			bb = append(bb, KeyBinding{Scope: scope, Action: action, Key: aa[action], Preset: preset})
+		}
+	}
+
+	return bb
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	kk := make([]string, 0, len(m))
+	for k := range m {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+
+	return kk
+}
@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+func TestKeymapLoad(t *testing.T) {
+	k := config.NewKeymap()
+	require.NoError(t, k.LoadKeymap("testdata/keymaps/keymap.yaml"))
+
+	assert.Equal(t, config.KeymapPresetVim, k.Preset)
+	assert.Len(t, k.Bindings, 2)
+	assert.Equal(t, "Shift-S", k.Bindings["pods"]["Shell"])
+}
+
+func TestKeymapLoadToast(t *testing.T) {
+	k := config.NewKeymap()
+	assert.ErrorContains(t, k.LoadKeymap("testdata/keymaps/toast.yaml"), `invalid keymap preset "bozo"`)
+}
+
+func TestKeymapBindingsFor(t *testing.T) {
+	k := config.NewKeymap()
+	require.NoError(t, k.LoadKeymap("testdata/keymaps/keymap.yaml"))
+
+	uu := map[string]struct {
+		aliases sets.Set[string]
+		e       config.KeyBindings
+	}{
+		"global": {
+			aliases: sets.New("dp", "deploy"),
+			e: config.KeyBindings{
+				{Scope: "all", Action: "Copy", Key: "Shift-Y", Preset: true},
+				{Scope: "all", Action: "Delete", Key: "Shift-D", Preset: true},
+				{Scope: "all", Action: "Describe", Key: "Shift-X"},
+				{Scope: "all", Action: "Edit", Key: "i", Preset: true},
+				{Scope: "all", Action: "Logs", Key: "Ctrl-L"},
+				{Scope: "all", Action: "Mark", Key: "v", Preset: true},
+			},
+		},
+		"scoped": {
+			aliases: sets.New("po", "pods"),
+			e: config.KeyBindings{
+				{Scope: "all", Action: "Copy", Key: "Shift-Y", Preset: true},
+				{Scope: "all", Action: "Delete", Key: "Shift-D", Preset: true},
+				{Scope: "all", Action: "Describe", Key: "Shift-X"},
+				{Scope: "all", Action: "Edit", Key: "i", Preset: true},
+				{Scope: "pods", Action: "Logs", Key: "Shift-L"},
+				{Scope: "all", Action: "Mark", Key: "v", Preset: true},
+				{Scope: "pods", Action: "Shell", Key: "Shift-S"},
+			},
+		},
+	}
+
+	for k1 := range uu {
+		u := uu[k1]
+		t.Run(k1, func(t *testing.T) {
+			assert.Equal(t, u.e, k.BindingsFor(u.aliases))
+		})
+	}
+}
+
+func TestKeymapBindingsForPrecedence(t *testing.T) {
+	k := config.NewKeymap()
+	k.Bindings = map[string]map[string]string{
+		"all": {"Logs": "Ctrl-L", "Shell": "Ctrl-S"},
+		"ab":  {"Logs": "Shift-L"},
+		"zz":  {"Shell": "Shift-S"},
+	}
+
+	assert.Equal(t, config.KeyBindings{
+		{Scope: "ab", Action: "Logs", Key: "Shift-L"},
+		{Scope: "zz", Action: "Shell", Key: "Shift-S"},
+	}, k.BindingsFor(sets.New("ab", "zz")))
+}
+
+func TestKeymapConflicts(t *testing.T) {
+	k := config.NewKeymap()
+	require.NoError(t, k.LoadKeymap("testdata/keymaps/keymap.yaml"))
+
+	hh := config.NewHotKeys()
+	hh.HotKey["pods"] = config.HotKey{ShortCut: "ctrl-l", Command: "pods"}
+	pp := config.NewPlugins()
+	pp.Plugins["stern"] = config.Plugin{ShortCut: "Shift-S", Scopes: []string{"pods"}}
+	pp.Plugins["dive"] = config.Plugin{ShortCut: "Shift-L", Scopes: []string{"containers"}}
+	pp.Plugins["edit"] = config.Plugin{ShortCut: "i", Scopes: []string{"all"}}
+
+	assert.Equal(t, []string{
+		`keymap all:Edit[i] conflicts with plugin "edit"`,
+		`keymap all:Logs[Ctrl-L] conflicts with hotkey "pods"`,
+		`keymap pods:Shell[Shift-S] conflicts with plugin "stern"`,
+	}, k.Conflicts(hh, pp))
+}
@@ -143,6 +143,10 @@ func (mockConnection) Dial() (kubernetes.Interface, error) {
 func (mockConnection) DialLogs() (kubernetes.Interface, error) {
 	return nil, nil
 }
+func (mockConnection) Impersonate(string, []string) error {
+	return nil
+}
+
 func (mockConnection) SwitchContext(string) error {
 	return nil
 }
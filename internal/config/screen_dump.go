@@ -0,0 +1,31 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import "slices"
+
+const (
+	// DumpCSV tracks csv screen dumps.
+	DumpCSV = "csv"
+
+	// DumpJSON tracks json screen dumps.
+	DumpJSON = "json"
+
+	// DumpYAML tracks yaml screen dumps.
+	DumpYAML = "yaml"
+
+	// DumpMarkdown tracks markdown table screen dumps.
+	DumpMarkdown = "md"
+
+	// DumpHTML tracks html screen dumps.
+	DumpHTML = "html"
+)
+
+// DumpFormats tracks all supported screen dumps formats.
+var DumpFormats = []string{DumpCSV, DumpJSON, DumpYAML, DumpMarkdown, DumpHTML}
+
+// IsDumpFormat checks if a screen dump format is supported.
+func IsDumpFormat(f string) bool {
+	return slices.Contains(DumpFormats, f)
+}
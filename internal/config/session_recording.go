@@ -0,0 +1,29 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+// DefaultSessionRecordingMaxSessions tracks the default max number of recorded sessions per context.
+const DefaultSessionRecordingMaxSessions = 50
+
+// SessionRecording tracks operator session recording options.
+type SessionRecording struct {
+	Enable      bool `json:"enable" yaml:"enable"`
+	MaxSessions int  `json:"maxSessions" yaml:"maxSessions"`
+}
+
+// NewSessionRecording returns a new instance.
+func NewSessionRecording() SessionRecording {
+	return SessionRecording{
+		MaxSessions: DefaultSessionRecordingMaxSessions,
+	}
+}
+
+// Validate checks retention limits and make sure we're cool. If not use defaults.
+func (s SessionRecording) Validate() SessionRecording {
+	if s.MaxSessions <= 0 {
+		s.MaxSessions = DefaultSessionRecordingMaxSessions
+	}
+
+	return s
+}
@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionRecordingValidate(t *testing.T) {
+	uu := map[string]struct {
+		s, e config.SessionRecording
+	}{
+		"default": {
+			s: config.NewSessionRecording(),
+			e: config.SessionRecording{MaxSessions: 50},
+		},
+		"empty": {
+			e: config.SessionRecording{MaxSessions: 50},
+		},
+		"custom": {
+			s: config.SessionRecording{Enable: true, MaxSessions: 10},
+			e: config.SessionRecording{Enable: true, MaxSessions: 10},
+		},
+		"toast": {
+			s: config.SessionRecording{Enable: true, MaxSessions: -1},
+			e: config.SessionRecording{Enable: true, MaxSessions: 50},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.s.Validate())
+		})
+	}
+}
@@ -55,14 +55,16 @@ type Threshold map[string]*Severity
 // NewThreshold returns a new threshold.
 func NewThreshold() Threshold {
 	return Threshold{
-		CPU: NewSeverity(),
-		MEM: NewSeverity(),
+		CPU:   NewSeverity(),
+		MEM:   NewSeverity(),
+		QUOTA: NewSeverity(),
+		CERT:  NewSeverity(),
 	}
 }
 
 // Validate a namespace is setup correctly.
 func (t Threshold) Validate() Threshold {
-	for _, k := range []string{CPU, MEM} {
+	for _, k := range []string{CPU, MEM, QUOTA, CERT} {
 		v, ok := t[k]
 		if !ok {
 			t[k] = NewSeverity()
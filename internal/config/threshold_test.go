@@ -82,6 +82,16 @@ func TestLevelFor(t *testing.T) {
 			v: 150,
 			e: config.SeverityLow,
 		},
+		"quota": {
+			k: config.QUOTA,
+			v: 90,
+			e: config.SeverityHigh,
+		},
+		"cert": {
+			k: config.CERT,
+			v: 75,
+			e: config.SeverityMedium,
+		},
 	}
 
 	o := config.NewThreshold()
@@ -12,6 +12,12 @@ const (
 
 	// MEM tracks memory usage.
 	MEM = "memory"
+
+	// QUOTA tracks resource quotas consumption.
+	QUOTA = "quota"
+
+	// CERT tracks certificates lifetime consumption.
+	CERT = "certificate"
 )
 
 // UI tracks ui specific configs.
@@ -47,6 +53,9 @@ type UI struct {
 	// UseFullGVRTitle toggles the display of full GVR (group/version/resource) vs R in views title.
 	UseFullGVRTitle bool `json:"useFullGVRTitle" yaml:"useFullGVRTitle"`
 
+	// ShowPins toggles the pinned resources panel display.
+	ShowPins bool `json:"showPins" yaml:"showPins"`
+
 	manualHeadless   *bool
 	manualLogoless   *bool
 	manualCrumbsless *bool
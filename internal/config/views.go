@@ -31,16 +31,134 @@ type ViewConfigListener interface {
 	GetNamespace() string
 }
 
+const (
+	// SplitPaneDescribe tracks a describe split pane.
+	SplitPaneDescribe = "describe"
+
+	// SplitPaneYAML tracks a yaml split pane.
+	SplitPaneYAML = "yaml"
+
+	// SplitPaneEvents tracks an events split pane.
+	SplitPaneEvents = "events"
+
+	// SplitPaneLogs tracks a logs split pane.
+	SplitPaneLogs = "logs"
+
+	// SplitHorizontal lays out the split pane to the right of the table.
+	SplitHorizontal = "horizontal"
+
+	// SplitVertical lays out the split pane below the table.
+	SplitVertical = "vertical"
+
+	// DefaultSplitSize tracks the default split pane size in percent.
+	DefaultSplitSize = 50
+
+	minSplitSize = 10
+	maxSplitSize = 90
+
+	// PrinterK9s renders resources using k9s renderers.
+	PrinterK9s = "k9s"
+
+	// PrinterServer renders resources using the api server table printers.
+	PrinterServer = "server"
+
+	// SeverityError flags a faulty resource.
+	SeverityError = "error"
+
+	// SeverityWarn flags a resource needing attention.
+	SeverityWarn = "warn"
+
+	// SeverityCompleted flags a resource that has run its course.
+	SeverityCompleted = "completed"
+
+	// SeverityOK flags a healthy resource.
+	SeverityOK = "ok"
+)
+
+// RowRule represents a custom row coloring rule. A rule matches either a CEL
+// expression, a JSONPath or a column value against a regular expression.
+type RowRule struct {
+	Expr     string `yaml:"expr,omitempty"`
+	Path     string `yaml:"path,omitempty"`
+	Column   string `yaml:"column,omitempty"`
+	Match    string `yaml:"match,omitempty"`
+	Severity string `yaml:"severity,omitempty"`
+	Color    string `yaml:"color,omitempty"`
+	Message  string `yaml:"message,omitempty"`
+}
+
+// IsFault checks if the rule flags a resource as unhealthy.
+func (r RowRule) IsFault() bool {
+	return r.Severity == SeverityError || r.Severity == SeverityWarn
+}
+
+// SplitPanes returns all available split panes in display order.
+func SplitPanes() []string {
+	return []string{SplitPaneDescribe, SplitPaneYAML, SplitPaneEvents, SplitPaneLogs}
+}
+
+// SplitSetting represents a view split pane configuration.
+type SplitSetting struct {
+	Pane        string `yaml:"pane"`
+	Orientation string `yaml:"orientation"`
+	Size        int    `yaml:"size"`
+}
+
+// NewSplitSetting returns a new split pane configuration.
+func NewSplitSetting() *SplitSetting {
+	return &SplitSetting{
+		Orientation: SplitHorizontal,
+		Size:        DefaultSplitSize,
+	}
+}
+
+// IsActive checks if the split pane is shown.
+func (s *SplitSetting) IsActive() bool {
+	return s != nil && s.Pane != ""
+}
+
+// IsVertical checks if the split pane is laid out below the table.
+func (s *SplitSetting) IsVertical() bool {
+	return s != nil && s.Orientation == SplitVertical
+}
+
+// Validate ensures the split pane configuration is legit.
+func (s *SplitSetting) Validate() {
+	if s.Orientation != SplitVertical {
+		s.Orientation = SplitHorizontal
+	}
+	if s.Size == 0 {
+		s.Size = DefaultSplitSize
+	}
+	s.Size = max(minSplitSize, min(s.Size, maxSplitSize))
+	if s.Pane != "" && !slices.Contains(SplitPanes(), s.Pane) {
+		s.Pane = ""
+	}
+}
+
 // ViewSetting represents a view configuration.
 type ViewSetting struct {
-	Columns    []string `yaml:"columns"`
-	SortColumn string   `yaml:"sortColumn"`
+	Columns    []string      `yaml:"columns"`
+	SortColumn string        `yaml:"sortColumn"`
+	Printer    string        `yaml:"printer,omitempty"`
+	Rules      []RowRule     `yaml:"rules,omitempty"`
+	Split      *SplitSetting `yaml:"split,omitempty"`
 }
 
 func (v *ViewSetting) HasCols() bool {
 	return len(v.Columns) > 0
 }
 
+// IsServerPrinted checks if resources are rendered by the api server table printers.
+func (v *ViewSetting) IsServerPrinted() bool {
+	return v != nil && v.Printer == PrinterServer
+}
+
+// HasRules checks if custom row rules are defined.
+func (v *ViewSetting) HasRules() bool {
+	return v != nil && len(v.Rules) > 0
+}
+
 func (v *ViewSetting) IsBlank() bool {
 	return v == nil || (len(v.Columns) == 0 && v.SortColumn == "")
 }
@@ -69,6 +187,12 @@ func (v *ViewSetting) Equals(vs *ViewSetting) bool {
 	if c := slices.Compare(v.Columns, vs.Columns); c != 0 {
 		return false
 	}
+	if v.Printer != vs.Printer {
+		return false
+	}
+	if !slices.Equal(v.Rules, vs.Rules) {
+		return false
+	}
 
 	return cmp.Compare(v.SortColumn, vs.SortColumn) == 0
 }
@@ -119,6 +243,36 @@ func (v *CustomView) Load(path string) error {
 	return nil
 }
 
+// Save persists view configurations to a given file.
+func (v *CustomView) Save(path string) error {
+	if err := data.EnsureDirPath(path, data.DefaultDirMod); err != nil {
+		return err
+	}
+
+	return data.SaveYAML(path, v)
+}
+
+// SplitFor returns a view default split pane configuration. Split changes made
+// in the UI are saved in the context config so views files are never rewritten.
+func (v *CustomView) SplitFor(gvr string) *SplitSetting {
+	s := NewSplitSetting()
+	if vs, ok := v.Views[gvr]; ok && vs.Split != nil {
+		*s = *vs.Split
+	}
+	s.Validate()
+
+	return s
+}
+
+// RulesFor returns the custom row rules for a given resource if any.
+func (v *CustomView) RulesFor(gvr, ns string) []RowRule {
+	if vs := v.getVS(gvr, ns); vs != nil {
+		return vs.Rules
+	}
+
+	return nil
+}
+
 // AddListeners registers a new listener for various commands.
 func (v *CustomView) AddListeners(l ViewConfigListener, cmds ...string) {
 	for _, cmd := range cmds {
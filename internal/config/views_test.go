@@ -49,6 +49,56 @@ func TestCustomViewLoad(t *testing.T) {
 	}
 }
 
+func TestCustomViewSplit(t *testing.T) {
+	cfg := config.NewCustomView()
+	require.NoError(t, cfg.Load("testdata/views/split.yaml"))
+
+	assert.Equal(t, &config.SplitSetting{
+		Pane:        config.SplitPaneEvents,
+		Orientation: config.SplitVertical,
+		Size:        90,
+	}, cfg.SplitFor(client.SvcGVR.String()))
+	assert.Equal(t, config.NewSplitSetting(), cfg.SplitFor(client.PodGVR.String()))
+	assert.False(t, cfg.SplitFor(client.PodGVR.String()).IsActive())
+}
+
+func TestCustomViewRules(t *testing.T) {
+	cfg := config.NewCustomView()
+	require.NoError(t, cfg.Load("testdata/views/rules.yaml"))
+
+	assert.Empty(t, cfg.RulesFor(client.PodGVR.String(), client.NamespaceAll))
+	assert.Equal(t, []config.RowRule{
+		{Path: ".status.phase", Match: "^Failed$", Severity: config.SeverityError, Message: "blee failed"},
+		{Expr: `object.status.phase == "Pending"`, Severity: config.SeverityWarn},
+		{Column: "STATUS", Match: "Done", Severity: config.SeverityCompleted, Color: "gray"},
+	}, cfg.RulesFor("fred.io/v1/blees", "default"))
+}
+
+func TestSplitSettingValidate(t *testing.T) {
+	uu := map[string]struct {
+		s, e config.SplitSetting
+	}{
+		"empty": {
+			e: config.SplitSetting{Orientation: config.SplitHorizontal, Size: config.DefaultSplitSize},
+		},
+		"clamp": {
+			s: config.SplitSetting{Pane: config.SplitPaneLogs, Orientation: config.SplitVertical, Size: 5},
+			e: config.SplitSetting{Pane: config.SplitPaneLogs, Orientation: config.SplitVertical, Size: 10},
+		},
+		"toast": {
+			s: config.SplitSetting{Pane: "bozo", Orientation: "diagonal", Size: 30},
+			e: config.SplitSetting{Orientation: config.SplitHorizontal, Size: 30},
+		},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			u.s.Validate()
+			assert.Equal(t, u.e, u.s)
+		})
+	}
+}
+
 func TestViewSettingEquals(t *testing.T) {
 	uu := map[string]struct {
 		v1, v2 *config.ViewSetting
@@ -112,6 +162,27 @@ func TestViewSettingEquals(t *testing.T) {
 				Columns: []string{"B"},
 			},
 		},
+
+		"printer": {
+			v1: &config.ViewSetting{
+				Columns: []string{"A"},
+				Printer: config.PrinterServer,
+			},
+			v2: &config.ViewSetting{
+				Columns: []string{"A"},
+			},
+		},
+
+		"rules": {
+			v1: &config.ViewSetting{
+				Columns: []string{"A"},
+				Rules:   []config.RowRule{{Column: "A", Match: "fred", Severity: config.SeverityError}},
+			},
+			v2: &config.ViewSetting{
+				Columns: []string{"A"},
+				Rules:   []config.RowRule{{Column: "A", Match: "fred", Severity: config.SeverityWarn}},
+			},
+		},
 	}
 
 	for k, u := range uu {
@@ -12,10 +12,22 @@ var accessors = Accessors{
 	client.CtGVR:  new(Context),
 	client.CoGVR:  new(Container),
 	client.ScnGVR: new(ImageScan),
+	client.BomGVR: new(SBOM),
 	client.SdGVR:  new(ScreenDump),
+	client.SdpGVR: new(DumpPreview),
 	client.BeGVR:  new(Benchmark),
 	client.PfGVR:  new(PortForward),
 	client.DirGVR: new(Dir),
+	client.CapGVR: new(Capacity),
+	client.CpoGVR: new(CapacityPod),
+	client.TlGVR:  new(Timeline),
+	client.DkGVR:  new(DataKey),
+	client.CrtGVR: new(Cert),
+	client.EvaGVR: new(EventArchive),
+	client.SesGVR: new(Session),
+	client.SseGVR: new(SessionEntry),
+	client.PinGVR: new(Pin),
+	client.AlGVR:  new(Alert),
 
 	client.SvcGVR:  new(Service),
 	client.PodGVR:  new(Pod),
@@ -23,6 +35,7 @@ var accessors = Accessors{
 	client.NsGVR:   new(Namespace),
 	client.CmGVR:   new(ConfigMap),
 	client.SecGVR:  new(Secret),
+	client.RqGVR:   new(ResourceQuota),
 
 	client.DpGVR:  new(Deployment),
 	client.DsGVR:  new(DaemonSet),
@@ -34,6 +47,7 @@ var accessors = Accessors{
 
 	client.HmGVR:  new(HelmChart),
 	client.HmhGVR: new(HelmHistory),
+	client.HmrGVR: new(HelmResource),
 
 	client.CrdGVR: new(CustomResourceDefinition),
 }
@@ -0,0 +1,35 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*Alert)(nil)
+
+// Alert represents the raised alerts model.
+type Alert struct {
+	NonResource
+}
+
+// List returns the raised alerts.
+func (*Alert) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	aa, ok := ctx.Value(internal.KeyAlerts).([]render.AlertRes)
+	if !ok {
+		return nil, errors.New("no alerts found in context")
+	}
+
+	oo := make([]runtime.Object, 0, len(aa))
+	for _, a := range aa {
+		oo = append(oo, a)
+	}
+
+	return oo, nil
+}
@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	_ Accessor = (*Capacity)(nil)
+	_ Accessor = (*CapacityPod)(nil)
+)
+
+// Capacity tracks nodes requests, limits and usage.
+type Capacity struct {
+	NonResource
+}
+
+// List returns a collection of node capacities.
+func (c *Capacity) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	nn, err := FetchNodes(ctx, c.getFactory(), "")
+	if err != nil {
+		return nil, err
+	}
+	pp, err := scheduledPods(c.getFactory())
+	if err != nil {
+		return nil, err
+	}
+
+	var nmx client.NodesMetricsMap
+	if withMx, ok := ctx.Value(internal.KeyWithMetrics).(bool); withMx || !ok {
+		nmx, _ = client.DialMetrics(c.Client()).FetchNodesMetricsMap(ctx)
+	}
+
+	oo := make([]runtime.Object, 0, len(nn.Items))
+	for i := range nn.Items {
+		no := &nn.Items[i]
+		oo = append(oo, &render.NodeCapacityRes{
+			Node: no,
+			Pods: pp[no.Name],
+			MX:   nmx[no.Name],
+		})
+	}
+
+	return oo, nil
+}
+
+// CapacityPod tracks pods resources footprint on a given node.
+type CapacityPod struct {
+	NonResource
+}
+
+// List returns a collection of pods scheduled on a node.
+func (c *CapacityPod) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, fmt.Errorf("expecting a node path in context")
+	}
+	no, err := FetchNode(ctx, c.getFactory(), path)
+	if err != nil {
+		return nil, err
+	}
+	pp, err := scheduledPods(c.getFactory())
+	if err != nil {
+		return nil, err
+	}
+
+	oo := make([]runtime.Object, 0, len(pp[no.Name]))
+	for _, po := range pp[no.Name] {
+		oo = append(oo, &render.PodCapacityRes{
+			Pod:         po,
+			Allocatable: no.Status.Allocatable,
+		})
+	}
+
+	return oo, nil
+}
+
+// scheduledPods returns all non terminated pods keyed by their node name.
+func scheduledPods(f Factory) (map[string][]*v1.Pod, error) {
+	oo, err := f.List(client.PodGVR, client.BlankNamespace, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	pp := make(map[string][]*v1.Pod)
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+		}
+		var po v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &po); err != nil {
+			return nil, err
+		}
+		if po.Spec.NodeName == "" || po.Status.Phase == v1.PodSucceeded || po.Status.Phase == v1.PodFailed {
+			continue
+		}
+		pp[po.Spec.NodeName] = append(pp[po.Spec.NodeName], &po)
+	}
+
+	return pp, nil
+}
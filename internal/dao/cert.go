@@ -0,0 +1,311 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/slogs"
+	admv1 "k8s.io/api/admissionregistration/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// CertChainValid tracks a verified chain.
+	CertChainValid = "valid"
+
+	// CertChainExpired tracks an expired certificate.
+	CertChainExpired = "expired"
+
+	// CertChainNotYetValid tracks a certificate not yet valid.
+	CertChainNotYetValid = "not-yet-valid"
+
+	// CertChainSelfSigned tracks a self signed certificate.
+	CertChainSelfSigned = "self-signed"
+
+	// CertChainUntrusted tracks a certificate that could not be verified.
+	CertChainUntrusted = "untrusted"
+
+	caCrtKey = "ca.crt"
+)
+
+var _ Accessor = (*Cert)(nil)
+
+// Cert represents TLS certificates found in secrets, webhooks, apiservices and kubeconfig.
+type Cert struct {
+	NonResource
+}
+
+// List returns a collection of certificates. Cluster scoped certificates are only
+// listed when viewing all namespaces.
+func (c *Cert) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	now := time.Now()
+	cc, err := c.secretCerts(ns, now)
+	if err != nil {
+		return nil, err
+	}
+	if client.IsAllNamespaces(ns) {
+		cc = append(cc, c.webhookCerts(client.VwcGVR, "ValidatingWebhookConfiguration", now)...)
+		cc = append(cc, c.webhookCerts(client.MwcGVR, "MutatingWebhookConfiguration", now)...)
+		cc = append(cc, c.apiServiceCerts(now)...)
+		cc = append(cc, c.kubeconfigCerts(now)...)
+	}
+
+	sev, _ := ctx.Value(internal.KeyExpiring).(*config.Severity)
+	oo := make([]runtime.Object, 0, len(cc))
+	for _, cert := range cc {
+		if sev != nil && !IsExpiring(cert.Cert, sev, now) {
+			continue
+		}
+		oo = append(oo, cert)
+	}
+
+	return oo, nil
+}
+
+func (c *Cert) secretCerts(ns string, now time.Time) ([]render.CertRes, error) {
+	oo, err := c.getFactory().List(client.SecGVR, ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var cc []render.CertRes
+	for _, o := range oo {
+		var s v1.Secret
+		if err := fromUnstructured(o, &s); err != nil {
+			return nil, err
+		}
+		if s.Type != v1.SecretTypeTLS {
+			continue
+		}
+		roots, _ := ParseCerts(s.Data[caCrtKey])
+		chain, err := ParseCerts(s.Data[v1.TLSCertKey])
+		if err != nil {
+			slog.Warn("Unable to parse TLS certificate",
+				slogs.FQN, client.FQN(s.Namespace, s.Name),
+				slogs.Error, err,
+			)
+		}
+		cc = append(cc, certsFor(s.Namespace, "Secret", s.Name, v1.TLSCertKey, chain, roots, now)...)
+		cc = append(cc, certsFor(s.Namespace, "Secret", s.Name, caCrtKey, roots, roots, now)...)
+	}
+
+	return cc, nil
+}
+
+func (c *Cert) webhookCerts(gvr *client.GVR, kind string, now time.Time) []render.CertRes {
+	oo, err := c.getFactory().List(gvr, client.BlankNamespace, true, labels.Everything())
+	if err != nil {
+		slog.Debug("Unable to list webhook configurations", slogs.GVR, gvr, slogs.Error, err)
+		return nil
+	}
+
+	var cc []render.CertRes
+	for _, o := range oo {
+		var (
+			name string
+			hh   []admv1.WebhookClientConfig
+			ids  []string
+		)
+		switch gvr {
+		case client.VwcGVR:
+			var w admv1.ValidatingWebhookConfiguration
+			if err := fromUnstructured(o, &w); err != nil {
+				continue
+			}
+			name = w.Name
+			for _, h := range w.Webhooks {
+				hh, ids = append(hh, h.ClientConfig), append(ids, h.Name)
+			}
+		default:
+			var w admv1.MutatingWebhookConfiguration
+			if err := fromUnstructured(o, &w); err != nil {
+				continue
+			}
+			name = w.Name
+			for _, h := range w.Webhooks {
+				hh, ids = append(hh, h.ClientConfig), append(ids, h.Name)
+			}
+		}
+		for i, h := range hh {
+			bundle, _ := ParseCerts(h.CABundle)
+			cc = append(cc, certsFor("", kind, name, ids[i], bundle, bundle, now)...)
+		}
+	}
+
+	return cc
+}
+
+func (c *Cert) apiServiceCerts(now time.Time) []render.CertRes {
+	oo, err := c.getFactory().List(client.ApsGVR, client.BlankNamespace, true, labels.Everything())
+	if err != nil {
+		slog.Debug("Unable to list apiservices", slogs.Error, err)
+		return nil
+	}
+
+	var cc []render.CertRes
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		raw, _, _ := unstructured.NestedString(u.Object, "spec", "caBundle")
+		bb, err := base64.StdEncoding.DecodeString(raw)
+		if err != nil || len(bb) == 0 {
+			continue
+		}
+		bundle, _ := ParseCerts(bb)
+		cc = append(cc, certsFor("", "APIService", u.GetName(), "caBundle", bundle, bundle, now)...)
+	}
+
+	return cc
+}
+
+func (c *Cert) kubeconfigCerts(now time.Time) []render.CertRes {
+	cfg := c.getFactory().Client().Config()
+	rc, err := cfg.RESTConfig()
+	if err != nil {
+		slog.Debug("Unable to load rest config", slogs.Error, err)
+		return nil
+	}
+	ct, _ := cfg.CurrentContextName()
+	certData, err := readCertData(rc.CertData, rc.CertFile)
+	if err != nil {
+		slog.Debug("Unable to read kubeconfig client certificate", slogs.Error, err)
+	}
+	caData, err := readCertData(rc.CAData, rc.CAFile)
+	if err != nil {
+		slog.Debug("Unable to read kubeconfig certificate authority", slogs.Error, err)
+	}
+	roots, _ := ParseCerts(caData)
+	chain, _ := ParseCerts(certData)
+
+	cc := certsFor("", "Kubeconfig", ct, "client-certificate", chain, roots, now)
+
+	return append(cc, certsFor("", "Kubeconfig", ct, "certificate-authority", roots, roots, now)...)
+}
+
+// ParseCerts decodes all PEM encoded certificates in a given bundle.
+func ParseCerts(bb []byte) ([]*x509.Certificate, error) {
+	var cc []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, bb = pem.Decode(bb)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		c, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return cc, err
+		}
+		cc = append(cc, c)
+	}
+
+	return cc, nil
+}
+
+// VerifyChain checks the validity of a certificate chain. The first certificate is
+// verified using the remaining ones as intermediates and the given roots or, absent
+// roots, the system roots. Roots bundled in the chain are never trusted.
+func VerifyChain(chain, roots []*x509.Certificate, now time.Time) string {
+	if len(chain) == 0 {
+		return render.NAValue
+	}
+	leaf := chain[0]
+	switch {
+	case now.After(leaf.NotAfter):
+		return CertChainExpired
+	case now.Before(leaf.NotBefore):
+		return CertChainNotYetValid
+	}
+
+	if len(roots) == 0 && isSelfSigned(leaf) {
+		return CertChainSelfSigned
+	}
+
+	rootPool, interPool := x509.NewCertPool(), x509.NewCertPool()
+	if len(roots) == 0 {
+		if sys, err := x509.SystemCertPool(); err == nil {
+			rootPool = sys
+		}
+	}
+	for _, r := range roots {
+		rootPool.AddCert(r)
+	}
+	for _, c := range chain[1:] {
+		interPool.AddCert(c)
+	}
+
+	_, err := leaf.Verify(x509.VerifyOptions{
+		Roots:         rootPool,
+		Intermediates: interPool,
+		CurrentTime:   now,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	if err != nil {
+		if isSelfSigned(leaf) {
+			return CertChainSelfSigned
+		}
+		return CertChainUntrusted
+	}
+
+	return CertChainValid
+}
+
+// IsExpiring checks if a certificate consumed its lifetime past the warn threshold.
+func IsExpiring(c *x509.Certificate, sev *config.Severity, now time.Time) bool {
+	if c == nil {
+		return false
+	}
+
+	return render.CertLifePerc(c, now) >= sev.Warn
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func certsFor(ns, source, name, field string, chain, roots []*x509.Certificate, now time.Time) []render.CertRes {
+	cc := make([]render.CertRes, 0, len(chain))
+	for i, c := range chain {
+		cc = append(cc, render.CertRes{
+			Namespace: ns,
+			Source:    source,
+			Name:      name,
+			Field:     fmt.Sprintf("%s#%d", field, i),
+			Cert:      c,
+			Chain:     VerifyChain(chain[i:], roots, now),
+		})
+	}
+
+	return cc
+}
+
+func isSelfSigned(c *x509.Certificate) bool {
+	return c.Subject.String() == c.Issuer.String() && c.CheckSignatureFrom(c) == nil
+}
+
+func readCertData(data []byte, file string) ([]byte, error) {
+	if len(data) > 0 || file == "" {
+		return data, nil
+	}
+
+	return os.ReadFile(file)
+}
@@ -51,6 +51,7 @@ func (*conn) Dial() (kubernetes.Interface, error)                      { return
 func (*conn) DialLogs() (kubernetes.Interface, error)                  { return nil, nil }
 func (*conn) ConnectionOK() bool                                       { return true }
 func (*conn) SwitchContext(string) error                               { return nil }
+func (*conn) Impersonate(string, []string) error                       { return nil }
 func (*conn) CachedDiscovery() (*disk.CachedDiscoveryClient, error)    { return nil, nil }
 func (*conn) RestConfig() (*restclient.Config, error)                  { return nil, nil }
 func (*conn) MXDial() (*versioned.Clientset, error)                    { return nil, nil }
@@ -6,6 +6,8 @@ package dao
 import (
 	"context"
 	"log/slog"
+	"sync"
+	"time"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/render"
@@ -38,13 +40,20 @@ func (c *Context) Get(_ context.Context, path string) (runtime.Object, error) {
 
 // List all Contexts on the current cluster.
 func (c *Context) List(context.Context, string) ([]runtime.Object, error) {
-	ctxs, err := c.config().Contexts()
+	cfg, err := c.config().RawConfig()
 	if err != nil {
 		return nil, err
 	}
-	cc := make([]runtime.Object, 0, len(ctxs))
-	for k, v := range ctxs {
-		cc = append(cc, render.NewNamedContext(c.config(), k, v))
+	now := time.Now()
+	cc := make([]runtime.Object, 0, len(cfg.Contexts))
+	for k, v := range cfg.Contexts {
+		nc := render.NewNamedContext(c.config(), k, v)
+		nc.Creds = client.ContextCredentials(&cfg, k, now)
+		if cl, ok := cfg.Clusters[v.Cluster]; ok {
+			nc.Server = cl.Server
+			nc.Reachable, nc.ReachIssue = serverReach.status(cl.Server, now)
+		}
+		cc = append(cc, nc)
 	}
 
 	return cc, nil
@@ -63,3 +72,60 @@ func (c *Context) MustCurrentContextName() string {
 func (c *Context) Switch(ctx string) error {
 	return c.getFactory().Client().SwitchContext(ctx)
 }
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+const (
+	reachTTL     = time.Minute
+	reachTimeout = 3 * time.Second
+)
+
+var serverReach = reachability{checks: make(map[string]reachCheck)}
+
+type reachCheck struct {
+	err     error
+	at      time.Time
+	pending bool
+}
+
+// reachability tracks api servers reachability checked in the background.
+type reachability struct {
+	checks map[string]reachCheck
+	mx     sync.Mutex
+}
+
+// status returns the last known server reachability and triggers a new check when stale.
+func (r *reachability) status(server string, now time.Time) (state, issue string) {
+	if server == "" {
+		return render.NAValue, ""
+	}
+
+	r.mx.Lock()
+	defer r.mx.Unlock()
+	c, ok := r.checks[server]
+	if !c.pending && (!ok || now.Sub(c.at) > reachTTL) {
+		c.pending = true
+		r.checks[server] = c
+		go r.check(server)
+	}
+	switch {
+	case !ok || c.at.IsZero():
+		return render.ContextChecking, ""
+	case c.err != nil:
+		return render.ContextUnreachable, c.err.Error()
+	default:
+		return render.ContextReachable, ""
+	}
+}
+
+func (r *reachability) check(server string) {
+	err := client.CheckReachable(server, reachTimeout)
+	if err != nil {
+		slog.Debug("Server unreachable", slogs.URL, server, slogs.Error, err)
+	}
+
+	r.mx.Lock()
+	defer r.mx.Unlock()
+	r.checks[server] = reachCheck{err: err, at: time.Now()}
+}
@@ -3,6 +3,15 @@
 
 package dao
 
+import (
+	"slices"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
 var (
 	_ Accessor = (*CustomResourceDefinition)(nil)
 	_ Nuker    = (*CustomResourceDefinition)(nil)
@@ -12,3 +21,58 @@ var (
 type CustomResourceDefinition struct {
 	Resource
 }
+
+// ConditionCols returns the readiness columns to add to a custom resource table.
+// Columns are only added when the CRD schema declares status conditions and the
+// CRD printer columns do not already include them.
+func ConditionCols(f Factory, gvr *client.GVR) []string {
+	m, err := MetaAccess.MetaFor(gvr)
+	if err != nil || !IsCRD(m) {
+		return nil
+	}
+	o, err := f.Get(client.CrdGVR, client.FQN(client.ClusterScope, m.Name+"."+m.Group), false, labels.Everything())
+	if err != nil {
+		return nil
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil
+	}
+
+	return CRDConditionCols(u.Object, gvr.V())
+}
+
+// CRDConditionCols returns the readiness columns missing from a CRD version.
+func CRDConditionCols(crd map[string]any, version string) []string {
+	vv, _, _ := unstructured.NestedSlice(crd, "spec", "versions")
+	for _, v := range vv {
+		m, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+		if n, _, _ := unstructured.NestedString(m, "name"); n != version {
+			continue
+		}
+		props, _, _ := unstructured.NestedMap(m, "schema", "openAPIV3Schema", "properties", "status", "properties")
+		if _, ok := props["conditions"]; !ok {
+			return nil
+		}
+		pcols, _, _ := unstructured.NestedSlice(m, "additionalPrinterColumns")
+		printed := make([]string, 0, len(pcols))
+		for _, c := range pcols {
+			if cm, ok := c.(map[string]any); ok {
+				n, _, _ := unstructured.NestedString(cm, "name")
+				printed = append(printed, strings.ToUpper(n))
+			}
+		}
+		cc := make([]string, 0, 2)
+		for _, n := range []string{"READY", "STATUS"} {
+			if !slices.Contains(printed, n) {
+				cc = append(cc, n)
+			}
+		}
+		return cc
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCRDConditionCols(t *testing.T) {
+	uu := map[string]struct {
+		version string
+		pcols   []any
+		e       []string
+	}{
+		"conditions": {
+			version: "v1",
+			e:       []string{"READY", "STATUS"},
+		},
+		"printed": {
+			version: "v1",
+			pcols: []any{
+				map[string]any{"name": "Ready", "jsonPath": ".status.conditions[?(@.type=='Ready')].status"},
+			},
+			e: []string{"STATUS"},
+		},
+		"no-conditions": {
+			version: "v1beta1",
+		},
+		"no-version": {
+			version: "v2",
+		},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			crd := map[string]any{
+				"spec": map[string]any{
+					"versions": []any{
+						map[string]any{
+							"name": "v1beta1",
+							"schema": map[string]any{"openAPIV3Schema": map[string]any{
+								"properties": map[string]any{"status": map[string]any{"type": "object"}},
+							}},
+						},
+						map[string]any{
+							"name":                     "v1",
+							"additionalPrinterColumns": u.pcols,
+							"schema": map[string]any{"openAPIV3Schema": map[string]any{
+								"properties": map[string]any{"status": map[string]any{
+									"properties": map[string]any{"conditions": map[string]any{"type": "array"}},
+								}},
+							}},
+						},
+					},
+				},
+			}
+			assert.Equal(t, u.e, dao.CRDConditionCols(crd, u.version))
+		})
+	}
+}
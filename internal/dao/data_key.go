@@ -0,0 +1,278 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+var _ Accessor = (*DataKey)(nil)
+
+// DataKeys represents a secret or configmap decoded data at a given revision.
+type DataKeys struct {
+	Version string
+	Data    map[string][]byte
+}
+
+// DataKey represents a secret or configmap data entry.
+type DataKey struct {
+	NonResource
+}
+
+// List returns the data keys of a given secret or configmap.
+func (d *DataKey) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	gvr, ok := ctx.Value(internal.KeyGVR).(*client.GVR)
+	if !ok {
+		return nil, errors.New("no context for gvr found")
+	}
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, errors.New("no context for path found")
+	}
+	kk, err := d.Load(ctx, gvr, path)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(kk.Data))
+	for k := range kk.Data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	oo := make([]runtime.Object, 0, len(keys))
+	for _, k := range keys {
+		oo = append(oo, render.DataKeyRes{
+			Key:    k,
+			Value:  kk.Data[k],
+			Binary: IsBinary(kk.Data[k]),
+			Secret: gvr == client.SecGVR,
+		})
+	}
+
+	return oo, nil
+}
+
+// Load fetches the decoded data of a given secret or configmap.
+func (d *DataKey) Load(ctx context.Context, gvr *client.GVR, path string) (*DataKeys, error) {
+	dial, err := d.getFactory().Client().Dial()
+	if err != nil {
+		return nil, err
+	}
+	ns, n := client.Namespaced(path)
+	switch gvr {
+	case client.SecGVR:
+		s, err := dial.CoreV1().Secrets(ns).Get(ctx, n, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		mm := make(map[string][]byte, len(s.Data))
+		for k, v := range s.Data {
+			mm[k] = v
+		}
+		return &DataKeys{Version: s.ResourceVersion, Data: mm}, nil
+	case client.CmGVR:
+		cm, err := dial.CoreV1().ConfigMaps(ns).Get(ctx, n, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		mm := make(map[string][]byte, len(cm.Data)+len(cm.BinaryData))
+		for k, v := range cm.Data {
+			mm[k] = []byte(v)
+		}
+		for k, v := range cm.BinaryData {
+			mm[k] = v
+		}
+		return &DataKeys{Version: cm.ResourceVersion, Data: mm}, nil
+	default:
+		return nil, fmt.Errorf("data keys are not supported for %s", gvr)
+	}
+}
+
+// SetKey adds or updates a key value.
+func (d *DataKey) SetKey(ctx context.Context, gvr *client.GVR, path, version, key string, val []byte) error {
+	if err := ValidateDataKey(key); err != nil {
+		return err
+	}
+
+	return d.Update(ctx, gvr, path, version, func(mm map[string][]byte, bin sets.Set[string]) error {
+		if _, ok := mm[key]; !ok && IsBinary(val) {
+			bin.Insert(key)
+		}
+		mm[key] = val
+		return nil
+	})
+}
+
+// DeleteKey removes a key.
+func (d *DataKey) DeleteKey(ctx context.Context, gvr *client.GVR, path, version, key string) error {
+	return d.Update(ctx, gvr, path, version, func(mm map[string][]byte, bin sets.Set[string]) error {
+		if _, ok := mm[key]; !ok {
+			return fmt.Errorf("no key %q found in %s", key, path)
+		}
+		delete(mm, key)
+		bin.Delete(key)
+		return nil
+	})
+}
+
+// RenameKey renames a key while preserving its value.
+func (d *DataKey) RenameKey(ctx context.Context, gvr *client.GVR, path, version, from, to string) error {
+	if err := ValidateDataKey(to); err != nil {
+		return err
+	}
+
+	return d.Update(ctx, gvr, path, version, func(mm map[string][]byte, bin sets.Set[string]) error {
+		v, ok := mm[from]
+		if !ok {
+			return fmt.Errorf("no key %q found in %s", from, path)
+		}
+		if _, ok := mm[to]; ok {
+			return fmt.Errorf("key %q already exists in %s", to, path)
+		}
+		delete(mm, from)
+		mm[to] = v
+		if bin.Has(from) {
+			bin.Delete(from)
+			bin.Insert(to)
+		}
+		return nil
+	})
+}
+
+// Update applies a data mutation and writes it back. The mutation is handed the decoded
+// data along with the keys stored as configmap binary data. The update is rejected if the
+// resource changed since the given version was loaded.
+func (d *DataKey) Update(ctx context.Context, gvr *client.GVR, path, version string, fn func(map[string][]byte, sets.Set[string]) error) error {
+	ns, n := client.Namespaced(path)
+	auth, err := d.getFactory().Client().CanI(ns, gvr, n, []string{client.GetVerb, client.UpdateVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to update %s", gvr)
+	}
+	dial, err := d.getFactory().Client().Dial()
+	if err != nil {
+		return err
+	}
+
+	switch gvr {
+	case client.SecGVR:
+		s, err := dial.CoreV1().Secrets(ns).Get(ctx, n, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if err := checkVersion(path, version, s.ResourceVersion); err != nil {
+			return err
+		}
+		if s.Data == nil {
+			s.Data = make(map[string][]byte)
+		}
+		for k, v := range s.StringData {
+			s.Data[k] = []byte(v)
+		}
+		s.StringData = nil
+		if err := fn(s.Data, sets.New[string]()); err != nil {
+			return err
+		}
+		_, err = dial.CoreV1().Secrets(ns).Update(ctx, s, metav1.UpdateOptions{})
+		return conflictErr(path, err)
+	case client.CmGVR:
+		cm, err := dial.CoreV1().ConfigMaps(ns).Get(ctx, n, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if err := checkVersion(path, version, cm.ResourceVersion); err != nil {
+			return err
+		}
+		mm := make(map[string][]byte, len(cm.Data)+len(cm.BinaryData))
+		for k, v := range cm.Data {
+			mm[k] = []byte(v)
+		}
+		for k, v := range cm.BinaryData {
+			mm[k] = v
+		}
+		bin := sets.KeySet(cm.BinaryData)
+		if err := fn(mm, bin); err != nil {
+			return err
+		}
+		cm.Data, cm.BinaryData = splitConfigMapData(mm, bin)
+		_, err = dial.CoreV1().ConfigMaps(ns).Update(ctx, cm, metav1.UpdateOptions{})
+		return conflictErr(path, err)
+	default:
+		return fmt.Errorf("data keys are not supported for %s", gvr)
+	}
+}
+
+// ValidateDataKey checks a key is a valid secret/configmap data key.
+func ValidateDataKey(key string) error {
+	if ee := validation.IsConfigMapKey(key); len(ee) > 0 {
+		return fmt.Errorf("invalid key %q: %s", key, strings.Join(ee, ", "))
+	}
+
+	return nil
+}
+
+// IsBinary checks if a value can not be safely displayed or edited as text.
+func IsBinary(bb []byte) bool {
+	return !utf8.Valid(bb) || bytes.IndexByte(bb, 0) >= 0
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func checkVersion(path, expected, actual string) error {
+	if expected == "" || expected == actual {
+		return nil
+	}
+
+	return fmt.Errorf("%s was modified since it was loaded (version %s -> %s). Reload and try again", path, expected, actual)
+}
+
+func conflictErr(path string, err error) error {
+	if apierrors.IsConflict(err) {
+		return fmt.Errorf("%s was modified concurrently. Reload and try again: %w", path, err)
+	}
+
+	return err
+}
+
+// splitConfigMapData keeps each key in its original field. Values that are not valid
+// UTF-8 can only be stored as binary data.
+func splitConfigMapData(mm map[string][]byte, binKeys sets.Set[string]) (map[string]string, map[string][]byte) {
+	var (
+		txt map[string]string
+		bin map[string][]byte
+	)
+	for k, v := range mm {
+		if binKeys.Has(k) || !utf8.Valid(v) {
+			if bin == nil {
+				bin = make(map[string][]byte)
+			}
+			bin[k] = v
+			continue
+		}
+		if txt == nil {
+			txt = make(map[string]string)
+		}
+		txt[k] = string(v)
+	}
+
+	return txt, bin
+}
@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+func TestIsBinary(t *testing.T) {
+	uu := map[string]struct {
+		bb []byte
+		e  bool
+	}{
+		"empty":   {},
+		"text":    {bb: []byte("hello\nworld")},
+		"utf8":    {bb: []byte("héllo")},
+		"nul":     {bb: []byte("a\x00b"), e: true},
+		"invalid": {bb: []byte{0xff, 0xfe, 0xfd}, e: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, IsBinary(u.bb))
+		})
+	}
+}
+
+func TestValidateDataKey(t *testing.T) {
+	require.NoError(t, ValidateDataKey("app.properties"))
+	require.NoError(t, ValidateDataKey("tls_crt-1"))
+	require.Error(t, ValidateDataKey(""))
+	require.Error(t, ValidateDataKey("a/b"))
+	require.Error(t, ValidateDataKey(".."))
+}
+
+func TestSplitConfigMapData(t *testing.T) {
+	txt, bin := splitConfigMapData(map[string][]byte{
+		"a":   []byte("fred"),
+		"b":   []byte("blee"),
+		"nul": {'a', 0x0},
+		"bin": {0xff, 0x1},
+	}, sets.New("b"))
+
+	assert.Equal(t, map[string]string{"a": "fred", "nul": "a\x00"}, txt)
+	assert.Equal(t, map[string][]byte{"b": []byte("blee"), "bin": {0xff, 0x1}}, bin)
+
+	txt, bin = splitConfigMapData(map[string][]byte{}, sets.New[string]())
+	assert.Nil(t, txt)
+	assert.Nil(t, bin)
+}
+
+func TestCheckVersion(t *testing.T) {
+	require.NoError(t, checkVersion("default/fred", "", "10"))
+	require.NoError(t, checkVersion("default/fred", "10", "10"))
+	require.EqualError(t, checkVersion("default/fred", "10", "11"),
+		"default/fred was modified since it was loaded (version 10 -> 11). Reload and try again")
+}
@@ -4,10 +4,16 @@
 package dao
 
 import (
+	"fmt"
 	"log/slog"
+	"strings"
+	"text/tabwriter"
+	"time"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/slogs"
+	"k8s.io/apimachinery/pkg/util/duration"
 	"k8s.io/kubectl/pkg/describe"
 )
 
@@ -56,3 +62,60 @@ func Describe(c client.Connection, gvr *client.GVR, path string) (string, error)
 
 	return d.Describe(ns, n, describe.DescriberSettings{ShowEvents: true})
 }
+
+// StatusSummary renders a resource readiness and status conditions summary.
+// Returns blank when the resource does not report any status.
+func StatusSummary(o map[string]any, now time.Time) string {
+	ready, status, ok := render.ObjectReadiness(o)
+	if !ok {
+		return ""
+	}
+
+	var b strings.Builder
+	w := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Status Summary:")
+	fmt.Fprintf(w, "  Status:\t%s\n", status)
+	fmt.Fprintf(w, "  Ready:\t%s\n", ready)
+	if observed, current, ok := render.ObservedGeneration(o); ok {
+		state := "up to date"
+		if observed < current {
+			state = fmt.Sprintf("stale, generation %d", current)
+		}
+		fmt.Fprintf(w, "  Observed Generation:\t%d (%s)\n", observed, state)
+	}
+	_ = w.Flush()
+
+	cc := render.ObjectConditions(o)
+	if len(cc) == 0 {
+		fmt.Fprintln(&b, "  Conditions:  <none>")
+		return b.String()
+	}
+	fmt.Fprintln(&b, "  Conditions:")
+	w = tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "    Type\tStatus\tReason\tLast Transition\tMessage")
+	fmt.Fprintln(w, "    ----\t------\t------\t---------------\t-------")
+	for _, c := range cc {
+		age := render.NAValue
+		if !c.LastTransition.IsZero() {
+			age = duration.HumanDuration(now.Sub(c.LastTransition))
+		}
+		fmt.Fprintf(w, "    %s\t%s\t%s\t%s\t%s\n",
+			c.Type,
+			c.Status,
+			blankAs(c.Reason, render.MissingValue),
+			age,
+			blankAs(strings.TrimSpace(c.Message), render.MissingValue),
+		)
+	}
+	_ = w.Flush()
+
+	return b.String()
+}
+
+func blankAs(s, dflt string) string {
+	if s == "" {
+		return dflt
+	}
+
+	return s
+}
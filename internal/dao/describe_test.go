@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatusSummary(t *testing.T) {
+	now := time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC)
+	uu := map[string]struct {
+		o map[string]any
+		e string
+	}{
+		"no-status": {
+			o: map[string]any{"spec": map[string]any{}},
+		},
+		"phase": {
+			o: map[string]any{
+				"status": map[string]any{"phase": "Pending"},
+			},
+			e: "Status Summary:\n" +
+				"  Status:  Pending\n" +
+				"  Ready:   n/a\n" +
+				"  Conditions:  <none>\n",
+		},
+		"conditions": {
+			o: map[string]any{
+				"metadata": map[string]any{"generation": int64(3)},
+				"status": map[string]any{
+					"observedGeneration": int64(2),
+					"conditions": []any{
+						map[string]any{
+							"type":               "Ready",
+							"status":             "False",
+							"reason":             "ReconcileError",
+							"message":            "cannot connect to db ",
+							"lastTransitionTime": "2024-01-01T00:55:00Z",
+						},
+						map[string]any{
+							"type":   "Synced",
+							"status": "True",
+						},
+					},
+				},
+			},
+			e: "Status Summary:\n" +
+				"  Status:               ReconcileError\n" +
+				"  Ready:                False\n" +
+				"  Observed Generation:  2 (stale, generation 3)\n" +
+				"  Conditions:\n" +
+				"    Type    Status  Reason          Last Transition  Message\n" +
+				"    ----    ------  ------          ---------------  -------\n" +
+				"    Ready   False   ReconcileError  5m               cannot connect to db\n" +
+				"    Synced  True    <none>          n/a              <none>\n",
+		},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, StatusSummary(u.o, now))
+		})
+	}
+}
@@ -14,7 +14,6 @@ import (
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/slogs"
-	"github.com/derailed/k9s/internal/watch"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -69,7 +68,7 @@ func (d *DaemonSet) TailLogs(ctx context.Context, opts *LogOptions) ([]LogChan,
 }
 
 func podLogs(ctx context.Context, sel map[string]string, opts *LogOptions) ([]LogChan, error) {
-	f, ok := ctx.Value(internal.KeyFactory).(*watch.Factory)
+	f, ok := ctx.Value(internal.KeyFactory).(Factory)
 	if !ok {
 		return nil, errors.New("expecting a context factory")
 	}
@@ -0,0 +1,288 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/slogs"
+	eventsv1 "k8s.io/api/events/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+)
+
+const evArchiveFlushRate = 30 * time.Second
+
+// evArchiver tracks the active events archiver if any.
+var evArchiver atomic.Pointer[EventArchiver]
+
+// EvArchiver returns the active events archiver if any.
+func EvArchiver() *EventArchiver {
+	return evArchiver.Load()
+}
+
+// SetEvArchiver sets the active events archiver and returns the previous one if any.
+func SetEvArchiver(a *EventArchiver) *EventArchiver {
+	return evArchiver.Swap(a)
+}
+
+var _ Accessor = (*EventArchive)(nil)
+
+// EventArchive represents the archived events model.
+type EventArchive struct {
+	NonResource
+}
+
+// List returns the archived events in a given namespace.
+func (*EventArchive) List(_ context.Context, ns string) ([]runtime.Object, error) {
+	ar := EvArchiver()
+	if ar == nil {
+		return nil, errors.New("event archive is not enabled. Set k9s.eventArchive.enable in your config")
+	}
+
+	ee := ar.Events(ns)
+	oo := make([]runtime.Object, 0, len(ee))
+	for _, e := range ee {
+		oo = append(oo, e)
+	}
+
+	return oo, nil
+}
+
+// EventArchiver records cluster events to a local store while k9s is running.
+type EventArchiver struct {
+	path      string
+	maxAge    time.Duration
+	maxEvents int
+	events    map[string]render.ArchivedEvent
+	dirty     bool
+	mx        sync.RWMutex
+	cancelFn  context.CancelFunc
+	informer  cache.SharedIndexInformer
+	handler   cache.ResourceEventHandlerRegistration
+}
+
+// NewEventArchiver returns a new archiver persisting events to the given file.
+func NewEventArchiver(path string, cfg config.EventArchive) *EventArchiver {
+	return &EventArchiver{
+		path:      path,
+		maxAge:    cfg.Retention(),
+		maxEvents: cfg.MaxEvents,
+		events:    make(map[string]render.ArchivedEvent),
+	}
+}
+
+// Load hydrates the archive from disk.
+func (a *EventArchiver) Load() error {
+	bb, err := os.ReadFile(a.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var ee []render.ArchivedEvent
+	if err := json.Unmarshal(bb, &ee); err != nil {
+		return fmt.Errorf("unable to load events archive %q: %w", a.path, err)
+	}
+
+	a.mx.Lock()
+	defer a.mx.Unlock()
+	for _, e := range ee {
+		a.events[e.UID] = e
+	}
+	a.prune(time.Now())
+
+	return nil
+}
+
+// Start watches cluster events and periodically persists them to disk.
+func (a *EventArchiver) Start(f Factory) error {
+	a.Stop()
+
+	inf, err := f.CanForResource(client.BlankNamespace, client.EvGVR, client.MonitorAccess)
+	if err != nil {
+		return err
+	}
+	if inf == nil {
+		return fmt.Errorf("no informer found for %s", client.EvGVR)
+	}
+	a.informer = inf.Informer()
+	a.handler, err = a.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: a.record,
+		UpdateFunc: func(_, o any) {
+			a.record(o)
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	var ctx context.Context
+	ctx, a.cancelFn = context.WithCancel(context.Background())
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(evArchiveFlushRate):
+				if err := a.Flush(); err != nil {
+					slog.Error("Event archive flush failed", slogs.Error, err)
+				}
+			}
+		}
+	}()
+
+	return nil
+}
+
+// Stop terminates the archiver and persists any pending events.
+func (a *EventArchiver) Stop() {
+	if a.handler != nil {
+		if err := a.informer.RemoveEventHandler(a.handler); err != nil {
+			slog.Warn("Event archive handler removal failed", slogs.Error, err)
+		}
+		a.informer, a.handler = nil, nil
+	}
+	if a.cancelFn == nil {
+		return
+	}
+	a.cancelFn()
+	a.cancelFn = nil
+	if err := a.Flush(); err != nil {
+		slog.Error("Event archive flush failed", slogs.Error, err)
+	}
+}
+
+func (a *EventArchiver) record(o any) {
+	ro, ok := o.(runtime.Object)
+	if !ok {
+		return
+	}
+	var ev eventsv1.Event
+	if err := fromUnstructured(ro, &ev); err != nil {
+		slog.Warn("Event archive conversion failed", slogs.Error, err)
+		return
+	}
+	a.Record(&ev)
+}
+
+// Record archives a given event.
+func (a *EventArchiver) Record(ev *eventsv1.Event) {
+	e := render.ArchivedEvent{
+		UID:       string(ev.UID),
+		Namespace: ev.Namespace,
+		Name:      ev.Name,
+		Type:      ev.Type,
+		Reason:    ev.Reason,
+		Object:    fmt.Sprintf("%s/%s", ev.Regarding.Kind, ev.Regarding.Name),
+		Source:    ev.ReportingController,
+		Message:   ev.Note,
+		Count:     max(ev.DeprecatedCount, 1),
+		FirstSeen: ev.CreationTimestamp.Time,
+		LastSeen:  eventTime(ev),
+	}
+	if e.Source == "" {
+		e.Source = ev.DeprecatedSource.Component
+	}
+	if ev.Series != nil {
+		e.Count = ev.Series.Count
+	}
+	if !ev.DeprecatedFirstTimestamp.IsZero() {
+		e.FirstSeen = ev.DeprecatedFirstTimestamp.Time
+	}
+
+	a.mx.Lock()
+	defer a.mx.Unlock()
+	a.events[e.UID] = e
+	a.dirty = true
+}
+
+// Events returns archived events in a given namespace sorted by last seen.
+func (a *EventArchiver) Events(ns string) []render.ArchivedEvent {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+
+	a.prune(time.Now())
+	ee := make([]render.ArchivedEvent, 0, len(a.events))
+	for _, e := range a.events {
+		if client.IsAllNamespaces(ns) || e.Namespace == ns {
+			ee = append(ee, e)
+		}
+	}
+	sortArchivedEvents(ee)
+
+	return ee
+}
+
+// Flush persists the archive to disk if it changed.
+func (a *EventArchiver) Flush() error {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+
+	if !a.dirty {
+		return nil
+	}
+	a.prune(time.Now())
+	ee := make([]render.ArchivedEvent, 0, len(a.events))
+	for _, e := range a.events {
+		ee = append(ee, e)
+	}
+	sortArchivedEvents(ee)
+	bb, err := json.Marshal(ee)
+	if err != nil {
+		return err
+	}
+	if err := data.EnsureDirPath(a.path, data.DefaultDirMod); err != nil {
+		return err
+	}
+	if err := os.WriteFile(a.path, bb, data.DefaultFileMod); err != nil {
+		return err
+	}
+	a.dirty = false
+
+	return nil
+}
+
+// prune evicts events past their retention and the oldest events over the max count.
+func (a *EventArchiver) prune(now time.Time) {
+	for k, e := range a.events {
+		if now.Sub(e.LastSeen) > a.maxAge {
+			delete(a.events, k)
+			a.dirty = true
+		}
+	}
+	if len(a.events) <= a.maxEvents {
+		return
+	}
+
+	ee := make([]render.ArchivedEvent, 0, len(a.events))
+	for _, e := range a.events {
+		ee = append(ee, e)
+	}
+	sortArchivedEvents(ee)
+	for _, e := range ee[a.maxEvents:] {
+		delete(a.events, e.UID)
+	}
+	a.dirty = true
+}
+
+func sortArchivedEvents(ee []render.ArchivedEvent) {
+	sort.Slice(ee, func(i, j int) bool {
+		return ee[i].LastSeen.After(ee[j].LastSeen)
+	})
+}
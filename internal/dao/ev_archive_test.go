@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	eventsv1 "k8s.io/api/events/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestEventArchiverRetention(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ctx", "events.json")
+	a := dao.NewEventArchiver(path, config.EventArchive{MaxAge: "1h", MaxEvents: 2})
+
+	now := time.Now()
+	a.Record(makeArchEvent("ns1", "e1", now.Add(-2*time.Hour)))
+	a.Record(makeArchEvent("ns1", "e2", now.Add(-30*time.Minute)))
+	a.Record(makeArchEvent("ns2", "e3", now.Add(-20*time.Minute)))
+	a.Record(makeArchEvent("ns2", "e4", now.Add(-10*time.Minute)))
+
+	ee := a.Events(client.NamespaceAll)
+	require.Len(t, ee, 2)
+	assert.Equal(t, "e4", ee[0].Name)
+	assert.Equal(t, "e3", ee[1].Name)
+	assert.Equal(t, "Pod/fred", ee[0].Object)
+	assert.Empty(t, a.Events("ns1"))
+
+	require.NoError(t, a.Flush())
+	a1 := dao.NewEventArchiver(path, config.NewEventArchive())
+	require.NoError(t, a1.Load())
+	ee1 := a1.Events("ns2")
+	require.Len(t, ee1, 2)
+	assert.Equal(t, "e4", ee1[0].Name)
+	assert.True(t, ee[0].LastSeen.Equal(ee1[0].LastSeen))
+}
+
+func TestEventArchiverLoadMissing(t *testing.T) {
+	a := dao.NewEventArchiver(filepath.Join(t.TempDir(), "events.json"), config.NewEventArchive())
+
+	require.NoError(t, a.Load())
+	assert.Empty(t, a.Events(""))
+}
+
+// Helpers...
+
+func makeArchEvent(ns, n string, t time.Time) *eventsv1.Event {
+	return &eventsv1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         ns,
+			Name:              n,
+			UID:               types.UID(ns + n),
+			CreationTimestamp: metav1.NewTime(t),
+		},
+		Type:                    "Warning",
+		Reason:                  "BackOff",
+		Note:                    "blee",
+		Regarding:               v1.ObjectReference{Kind: "Pod", Name: "fred"},
+		DeprecatedLastTimestamp: metav1.NewTime(t),
+	}
+}
+
+func TestEventArchiverStartStop(t *testing.T) {
+	var inf handlerInformer
+	a := dao.NewEventArchiver(filepath.Join(t.TempDir(), "events.json"), config.NewEventArchive())
+	require.NoError(t, a.Start(&handlerFactory{inf: &inf}))
+	assert.Equal(t, 1, inf.handlers)
+
+	a.Stop()
+	assert.Equal(t, 0, inf.handlers)
+	a.Stop()
+	assert.Equal(t, 0, inf.handlers)
+}
+
+// Helpers...
+
+type handlerFactory struct {
+	testFactory
+
+	inf *handlerInformer
+}
+
+func (f *handlerFactory) CanForResource(string, *client.GVR, []string) (informers.GenericInformer, error) {
+	return f.inf, nil
+}
+
+type handlerInformer struct {
+	cache.SharedIndexInformer
+
+	handlers int
+}
+
+func (i *handlerInformer) Informer() cache.SharedIndexInformer { return i }
+func (*handlerInformer) Lister() cache.GenericLister           { return nil }
+
+func (i *handlerInformer) AddEventHandler(cache.ResourceEventHandler) (cache.ResourceEventHandlerRegistration, error) {
+	i.handlers++
+	return i, nil
+}
+
+func (i *handlerInformer) RemoveEventHandler(cache.ResourceEventHandlerRegistration) error {
+	i.handlers--
+	return nil
+}
@@ -6,9 +6,12 @@ package dao
 import (
 	"context"
 	"fmt"
+	"log/slog"
+	"time"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/slogs"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
@@ -87,9 +90,33 @@ func (g *Generic) Get(ctx context.Context, path string) (runtime.Object, error)
 	return dial.Namespace(ns).Get(ctx, n, opts)
 }
 
-// Describe describes a resource.
+// Describe describes a resource. Custom resources are prefixed with a status summary.
 func (g *Generic) Describe(path string) (string, error) {
-	return Describe(g.Client(), g.gvr, path)
+	desc, err := Describe(g.Client(), g.gvr, path)
+	if err != nil {
+		return "", err
+	}
+	if m, e := MetaAccess.MetaFor(g.gvr); e != nil || !IsCRD(m) {
+		return desc, nil
+	}
+	o, err := g.Get(context.Background(), path)
+	if err != nil {
+		slog.Warn("Unable to fetch resource status",
+			slogs.GVR, g.gvr,
+			slogs.FQN, path,
+			slogs.Error, err,
+		)
+		return desc, nil
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return desc, nil
+	}
+	if s := StatusSummary(u.Object, time.Now()); s != "" {
+		return s + "\n" + desc, nil
+	}
+
+	return desc, nil
 }
 
 // ToYAML returns a resource yaml.
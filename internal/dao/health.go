@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const defaultHealthStatus = "Active"
+
+var (
+	healthyPhases = sets.New("Running", "Succeeded", "Active", "Bound", "Available")
+	doneStatuses  = sets.New("Succeeded", "Complete", "Completed")
+
+	// readyFields lists the ready/desired fields pairs used to assert readiness.
+	readyFields = []struct {
+		ready, desired []string
+		strict         bool
+	}{
+		{ready: []string{"status", "readyReplicas"}, desired: []string{"spec", "replicas"}, strict: true},
+		{ready: []string{"status", "numberReady"}, desired: []string{"status", "desiredNumberScheduled"}, strict: true},
+		{ready: []string{"status", "succeeded"}, desired: []string{"spec", "completions"}},
+	}
+)
+
+// ObjectHealth derives a resource status, readiness and issue if any.
+func ObjectHealth(u *unstructured.Unstructured) (status, ready, issue string) {
+	status, healthy := objectStatus(u)
+	ready, allReady := objectReady(u)
+	switch {
+	case !healthy:
+		issue = "status is " + status
+	case !allReady && !doneStatuses.Has(status):
+		issue = "not ready " + ready
+	}
+
+	return
+}
+
+func objectStatus(u *unstructured.Unstructured) (string, bool) {
+	cc, _, _ := unstructured.NestedSlice(u.Object, "status", "containerStatuses")
+	for _, c := range cc {
+		m, ok := c.(map[string]any)
+		if !ok {
+			continue
+		}
+		if reason, _, _ := unstructured.NestedString(m, "state", "waiting", "reason"); reason != "" {
+			return reason, false
+		}
+	}
+	if phase, _, _ := unstructured.NestedString(u.Object, "status", "phase"); phase != "" {
+		return phase, healthyPhases.Has(phase)
+	}
+
+	if status, healthy, ok := render.ConditionsStatus(u.Object); ok {
+		return status, healthy
+	}
+
+	return defaultHealthStatus, true
+}
+
+func objectReady(u *unstructured.Unstructured) (string, bool) {
+	if cc, ok, _ := unstructured.NestedSlice(u.Object, "status", "containerStatuses"); ok {
+		var ready int
+		for _, c := range cc {
+			if m, ok := c.(map[string]any); ok {
+				if b, _, _ := unstructured.NestedBool(m, "ready"); b {
+					ready++
+				}
+			}
+		}
+		return fmt.Sprintf("%d/%d", ready, len(cc)), ready == len(cc)
+	}
+	for _, f := range readyFields {
+		desired, ok, _ := unstructured.NestedInt64(u.Object, f.desired...)
+		if !ok {
+			continue
+		}
+		ready, _, _ := unstructured.NestedInt64(u.Object, f.ready...)
+		return fmt.Sprintf("%d/%d", ready, desired), !f.strict || ready >= desired
+	}
+
+	return render.NAValue, true
+}
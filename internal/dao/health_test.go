@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestObjectHealth(t *testing.T) {
+	uu := map[string]struct {
+		o                    map[string]any
+		status, ready, issue string
+	}{
+		"deployment": {
+			o: map[string]any{
+				"spec": map[string]any{"replicas": int64(2)},
+				"status": map[string]any{
+					"readyReplicas": int64(2),
+					"conditions": []any{
+						map[string]any{"type": "Available", "status": "True"},
+					},
+				},
+			},
+			status: "Available",
+			ready:  "2/2",
+		},
+		"deployment-not-ready": {
+			o: map[string]any{
+				"spec": map[string]any{"replicas": int64(3)},
+				"status": map[string]any{
+					"readyReplicas": int64(1),
+					"conditions": []any{
+						map[string]any{"type": "Available", "status": "True"},
+					},
+				},
+			},
+			status: "Available",
+			ready:  "1/3",
+			issue:  "not ready 1/3",
+		},
+		"node-not-ready": {
+			o: map[string]any{
+				"status": map[string]any{
+					"conditions": []any{
+						map[string]any{"type": "MemoryPressure", "status": "False"},
+						map[string]any{"type": "Ready", "status": "False"},
+					},
+				},
+			},
+			status: "NotReady",
+			ready:  "n/a",
+			issue:  "status is NotReady",
+		},
+		"pod-crashing": {
+			o: map[string]any{
+				"status": map[string]any{
+					"phase": "Running",
+					"containerStatuses": []any{
+						map[string]any{"ready": true},
+						map[string]any{
+							"ready": false,
+							"state": map[string]any{"waiting": map[string]any{"reason": "CrashLoopBackOff"}},
+						},
+					},
+				},
+			},
+			status: "CrashLoopBackOff",
+			ready:  "1/2",
+			issue:  "status is CrashLoopBackOff",
+		},
+		"pod-done": {
+			o: map[string]any{
+				"status": map[string]any{
+					"phase": "Succeeded",
+					"containerStatuses": []any{
+						map[string]any{"ready": false},
+					},
+				},
+			},
+			status: "Succeeded",
+			ready:  "0/1",
+		},
+		"job-running": {
+			o: map[string]any{
+				"spec":   map[string]any{"completions": int64(1)},
+				"status": map[string]any{"active": int64(1)},
+			},
+			status: defaultHealthStatus,
+			ready:  "0/1",
+		},
+		"job-failed": {
+			o: map[string]any{
+				"spec": map[string]any{"completions": int64(1)},
+				"status": map[string]any{
+					"conditions": []any{
+						map[string]any{"type": "Failed", "status": "True"},
+					},
+				},
+			},
+			status: "Failed",
+			ready:  "0/1",
+			issue:  "status is Failed",
+		},
+		"cr-not-synced": {
+			o: map[string]any{
+				"status": map[string]any{
+					"conditions": []any{
+						map[string]any{"type": "Ready", "status": "True"},
+						map[string]any{"type": "Synced", "status": "False", "reason": "ReconcileError"},
+					},
+				},
+			},
+			status: "ReconcileError",
+			ready:  "n/a",
+			issue:  "status is ReconcileError",
+		},
+		"configmap": {
+			o:      map[string]any{"data": map[string]any{"a": "b"}},
+			status: defaultHealthStatus,
+			ready:  "n/a",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			status, ready, issue := ObjectHealth(&unstructured.Unstructured{Object: u.o})
+			assert.Equal(t, u.status, status)
+			assert.Equal(t, u.ready, ready)
+			assert.Equal(t, u.issue, issue)
+		})
+	}
+}
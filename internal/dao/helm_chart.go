@@ -8,12 +8,18 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config/data"
 	"github.com/derailed/k9s/internal/render/helm"
 	"github.com/derailed/k9s/internal/slogs"
+	"github.com/pmezard/go-difflib/difflib"
 	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/release"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
@@ -48,7 +54,9 @@ func (h *HelmChart) List(_ context.Context, ns string) ([]runtime.Object, error)
 
 	oo := make([]runtime.Object, 0, len(rr))
 	for _, r := range rr {
-		oo = append(oo, helm.ReleaseRes{Release: r})
+		res := helm.ReleaseRes{Release: r}
+		res.Ready, res.Total = ReleaseHealth(h.getFactory(), r)
+		oo = append(oo, res)
 	}
 
 	return oo, nil
@@ -143,6 +151,120 @@ func (h *HelmChart) Uninstall(path string, keepHist bool) error {
 	return nil
 }
 
+// Upgrade upgrades a release using the given user supplied values.
+// When dryRun is set, the upgraded release is rendered but not applied.
+func (h *HelmChart) Upgrade(ctx context.Context, path string, values []byte, dryRun bool) (*release.Release, error) {
+	ns, n := client.Namespaced(path)
+	cfg, err := ensureHelmConfig(h.Client().Config().Flags(), ns)
+	if err != nil {
+		return nil, err
+	}
+	rel, err := action.NewGet(cfg).Run(n)
+	if err != nil {
+		return nil, err
+	}
+	vals, err := chartutil.ReadValues(values)
+	if err != nil {
+		return nil, fmt.Errorf("invalid values: %w", err)
+	}
+
+	up := action.NewUpgrade(cfg)
+	up.Namespace, up.DryRun = ns, dryRun
+
+	return up.RunWithContext(ctx, n, rel.Chart, vals)
+}
+
+// DiffUpgrade returns the rendered manifests diff between a release and its upgrade
+// using the given user supplied values.
+func (h *HelmChart) DiffUpgrade(ctx context.Context, path string, values []byte) (string, error) {
+	ns, n := client.Namespaced(path)
+	cfg, err := ensureHelmConfig(h.Client().Config().Flags(), ns)
+	if err != nil {
+		return "", err
+	}
+	cur, err := action.NewGet(cfg).Run(n)
+	if err != nil {
+		return "", err
+	}
+	next, err := h.Upgrade(ctx, path, values, true)
+	if err != nil {
+		return "", err
+	}
+
+	return ManifestDiff(
+		cur.Manifest,
+		next.Manifest,
+		fmt.Sprintf("%s (revision %d)", path, cur.Version),
+		fmt.Sprintf("%s (upgrade)", path),
+	)
+}
+
+// Install installs a release from a local chart directory or packaged chart.
+func (h *HelmChart) Install(ctx context.Context, path, chartPath string, values []byte) (*release.Release, error) {
+	ns, n := client.Namespaced(path)
+	cfg, err := ensureHelmConfig(h.Client().Config().Flags(), ns)
+	if err != nil {
+		return nil, err
+	}
+	chrt, err := loader.Load(chartPath)
+	if err != nil {
+		return nil, err
+	}
+	vals, err := chartutil.ReadValues(values)
+	if err != nil {
+		return nil, fmt.Errorf("invalid values: %w", err)
+	}
+
+	in := action.NewInstall(cfg)
+	in.Namespace, in.ReleaseName = ns, n
+
+	return in.RunWithContext(ctx, chrt, vals)
+}
+
+// IsHelmChart checks if the given path is a chart directory or a packaged chart.
+func IsHelmChart(path string) bool {
+	if strings.HasSuffix(path, ".tgz") || strings.HasSuffix(path, ".tar.gz") {
+		return true
+	}
+	ok, _ := chartutil.IsChartDir(path)
+
+	return ok
+}
+
+// HelmChartName returns a default release name for a local chart.
+func HelmChartName(path string) string {
+	if chrt, err := loader.Load(path); err == nil && chrt.Metadata != nil {
+		return chrt.Name()
+	}
+	n := filepath.Base(path)
+	for _, ext := range []string{".tgz", ".tar.gz"} {
+		n = strings.TrimSuffix(n, ext)
+	}
+
+	return n
+}
+
+// HelmChartValues returns the default values of a local chart.
+func HelmChartValues(path string) ([]byte, error) {
+	chrt, err := loader.Load(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return data.WriteYAML(chrt.Values)
+}
+
+// ManifestDiff returns a unified diff between two rendered manifests.
+func ManifestDiff(from, to, fromLabel, toLabel string) (string, error) {
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(strings.TrimSuffix(from, "\n")),
+		B:        difflib.SplitLines(strings.TrimSuffix(to, "\n")),
+		FromFile: fromLabel,
+		ToFile:   toLabel,
+		Context:  3,
+	})
+}
+
 // ensureHelmConfig return a new configuration.
 func ensureHelmConfig(flags *genericclioptions.ConfigFlags, ns string) (*action.Configuration, error) {
 	settings := &genericclioptions.ConfigFlags{
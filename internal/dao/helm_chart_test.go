@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManifestDiff(t *testing.T) {
+	uu := map[string]struct {
+		from, to, e string
+	}{
+		"same": {
+			from: "a: 1\nb: 2\n",
+			to:   "a: 1\nb: 2\n",
+		},
+		"changed": {
+			from: "a: 1\nb: 2\n",
+			to:   "a: 1\nb: 3\n",
+			e:    "--- rev 1\n+++ rev 2\n@@ -1,2 +1,2 @@\n a: 1\n-b: 2\n+b: 3\n",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			d, err := dao.ManifestDiff(u.from, u.to, "rev 1", "rev 2")
+			require.NoError(t, err)
+			assert.Equal(t, u.e, d)
+		})
+	}
+}
+
+func TestIsHelmChart(t *testing.T) {
+	dir := t.TempDir()
+	chart := filepath.Join(dir, "fred")
+	require.NoError(t, os.MkdirAll(chart, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(chart, "Chart.yaml"), []byte("apiVersion: v2\nname: blee\nversion: 0.1.0\n"), 0o600))
+
+	uu := map[string]struct {
+		path string
+		ok   bool
+		n    string
+	}{
+		"chart-dir": {
+			path: chart,
+			ok:   true,
+			n:    "blee",
+		},
+		"plain-dir": {
+			path: dir,
+			n:    filepath.Base(dir),
+		},
+		"archive": {
+			path: filepath.Join(dir, "zorg-1.0.0.tgz"),
+			ok:   true,
+			n:    "zorg-1.0.0",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.ok, dao.IsHelmChart(u.path))
+			assert.Equal(t, u.n, dao.HelmChartName(u.path))
+		})
+	}
+}
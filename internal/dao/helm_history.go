@@ -135,6 +135,25 @@ func (h *HelmHistory) GetValues(path string, allValues bool) ([]byte, error) {
 	return data.WriteYAML(content)
 }
 
+// Diff returns the rendered manifests diff between two revisions of a release.
+func (h *HelmHistory) Diff(path, from, to string) (string, error) {
+	manifests, labels := make([]string, 0, 2), make([]string, 0, 2)
+	for _, rev := range []string{from, to} {
+		rel, err := h.Get(context.Background(), path+":"+rev)
+		if err != nil {
+			return "", err
+		}
+		resp, ok := rel.(helm.ReleaseRes)
+		if !ok {
+			return "", fmt.Errorf("expected helm.ReleaseRes, but got %T", rel)
+		}
+		manifests = append(manifests, resp.Release.Manifest)
+		labels = append(labels, fmt.Sprintf("%s (revision %s)", path, rev))
+	}
+
+	return ManifestDiff(manifests[0], manifests[1], labels[0], labels[1])
+}
+
 func (h *HelmHistory) Rollback(_ context.Context, path, rev string) error {
 	ns, n := client.Namespaced(path)
 	cfg, err := ensureHelmConfig(h.Client().Config().Flags(), ns)
@@ -0,0 +1,215 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/render/helm"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/release"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+const manifestBufferSize = 4096
+
+var (
+	_ Accessor = (*HelmResource)(nil)
+
+	// healthGVRs tracks the release resources contributing to a release health rollup.
+	healthGVRs = sets.New(client.DpGVR, client.StsGVR, client.DsGVR, client.JobGVR, client.PodGVR, client.PvcGVR)
+)
+
+// HelmResource represents the resources managed by a helm release.
+type HelmResource struct {
+	NonResource
+}
+
+// List returns the release resources with their live status.
+func (h *HelmResource) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	path, ok := ctx.Value(internal.KeyFQN).(string)
+	if !ok {
+		return nil, errors.New("expecting FQN in context")
+	}
+	rel, err := h.release(path)
+	if err != nil {
+		return nil, err
+	}
+
+	rr := ReleaseResources(h.getFactory(), rel)
+	oo := make([]runtime.Object, 0, len(rr))
+	for _, r := range rr {
+		oo = append(oo, r)
+	}
+
+	return oo, nil
+}
+
+// Get returns a release resource.
+func (h *HelmResource) Get(ctx context.Context, path string) (runtime.Object, error) {
+	gvr, fqn := helm.ResourceFromID(path)
+	if fqn == "" {
+		return nil, fmt.Errorf("invalid release resource path %q", path)
+	}
+	oo, err := h.List(ctx, client.BlankNamespace)
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range oo {
+		if r, ok := o.(helm.ResourceRes); ok && r.GVR == gvr && r.Path == fqn {
+			return r, nil
+		}
+	}
+
+	return nil, fmt.Errorf("release resource %q not found", path)
+}
+
+func (h *HelmResource) release(path string) (*release.Release, error) {
+	ns, n := client.Namespaced(path)
+	cfg, err := ensureHelmConfig(h.Client().Config().Flags(), ns)
+	if err != nil {
+		return nil, err
+	}
+
+	return action.NewGet(cfg).Run(n)
+}
+
+// HelmObject represents a resource declared in a release manifest.
+type HelmObject struct {
+	GVR   *client.GVR
+	Kind  string
+	Path  string
+	Issue string
+}
+
+// ReleaseObjects returns the resources declared in a release manifest.
+func ReleaseObjects(rel *release.Release) ([]HelmObject, error) {
+	var oo []HelmObject
+	d := yaml.NewYAMLOrJSONDecoder(strings.NewReader(rel.Manifest), manifestBufferSize)
+	for {
+		var u unstructured.Unstructured
+		if err := d.Decode(&u.Object); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+		if len(u.Object) == 0 {
+			continue
+		}
+		oo = append(oo, toHelmObject(rel.Namespace, &u))
+	}
+
+	return oo, nil
+}
+
+func toHelmObject(ns string, u *unstructured.Unstructured) HelmObject {
+	gvk := u.GroupVersionKind()
+	o := HelmObject{Kind: gvk.Kind, GVR: client.NoGVR}
+	gvr, namespaced, ok := MetaAccess.GVK2GVR(gvk.GroupVersion(), gvk.Kind)
+	if !ok {
+		o.Path, o.Issue = u.GetName(), "unknown resource "+gvk.String()
+		return o
+	}
+	o.GVR = gvr
+	if !namespaced {
+		o.Path = u.GetName()
+		return o
+	}
+	if n := u.GetNamespace(); n != "" {
+		ns = n
+	}
+	o.Path = client.FQN(ns, u.GetName())
+
+	return o
+}
+
+// ReleaseResources returns the release resources with their live status.
+func ReleaseResources(f Factory, rel *release.Release) []helm.ResourceRes {
+	oo, err := ReleaseObjects(rel)
+	if err != nil {
+		return []helm.ResourceRes{{
+			Path:   client.FQN(rel.Namespace, rel.Name),
+			Status: render.UnknownValue,
+			Ready:  render.NAValue,
+			Issue:  "invalid manifest: " + err.Error(),
+		}}
+	}
+
+	rr := make([]helm.ResourceRes, 0, len(oo))
+	for _, o := range oo {
+		rr = append(rr, helmObjectStatus(f, o, true))
+	}
+
+	return rr
+}
+
+// ReleaseHealth returns the number of ready workloads in a release. Only kinds
+// with health semantics are considered and caches are never waited on so
+// releases listings do not block on informers syncs. Workloads whose cache is
+// not yet synced are skipped until the next refresh.
+func ReleaseHealth(f Factory, rel *release.Release) (ready, total int) {
+	oo, err := ReleaseObjects(rel)
+	if err != nil {
+		return 0, 1
+	}
+	for _, o := range oo {
+		if o.Issue != "" || !healthGVRs.Has(o.GVR) {
+			continue
+		}
+		ns, _ := client.Namespaced(o.Path)
+		inf, err := f.CanForResource(ns, o.GVR, []string{client.GetVerb})
+		if err != nil || inf == nil || !inf.Informer().HasSynced() {
+			continue
+		}
+		total++
+		if helmObjectStatus(f, o, false).IsHealthy() {
+			ready++
+		}
+	}
+
+	return ready, total
+}
+
+func helmObjectStatus(f Factory, o HelmObject, wait bool) helm.ResourceRes {
+	res := helm.ResourceRes{
+		GVR:  o.GVR.String(),
+		Kind: o.Kind,
+		Path: o.Path,
+	}
+	if o.Issue != "" {
+		res.Status, res.Ready, res.Issue = render.UnknownValue, render.NAValue, o.Issue
+		return res
+	}
+
+	obj, err := f.Get(o.GVR, o.Path, wait, labels.Everything())
+	if err != nil {
+		res.Status, res.Ready, res.Issue = render.UnknownValue, render.NAValue, err.Error()
+		if apierrors.IsNotFound(err) {
+			res.Status, res.Issue = helm.ResourceMissing, "resource no longer exists"
+		}
+		return res
+	}
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		res.Status, res.Ready, res.Issue = render.UnknownValue, render.NAValue, fmt.Sprintf("expecting unstructured but got %T", obj)
+		return res
+	}
+	res.Created = u.GetCreationTimestamp().Time
+	res.Status, res.Ready, res.Issue = ObjectHealth(u)
+
+	return res
+}
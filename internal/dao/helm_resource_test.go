@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"helm.sh/helm/v3/pkg/release"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+)
+
+func init() {
+	dao.MetaAccess.RegisterMeta(client.DpGVR.String(), &metav1.APIResource{
+		Name:       "deployments",
+		Group:      "apps",
+		Version:    "v1",
+		Kind:       "Deployment",
+		Namespaced: true,
+	})
+	dao.MetaAccess.RegisterMeta(client.CrGVR.String(), &metav1.APIResource{
+		Name:    "clusterroles",
+		Group:   "rbac.authorization.k8s.io",
+		Version: "v1",
+		Kind:    "ClusterRole",
+	})
+}
+
+const releaseManifest = `---
+# Source: fred/templates/deployment.yaml
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: fred
+---
+# Source: fred/templates/role.yaml
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: fred-reader
+---
+# Source: fred/templates/empty.yaml
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: blee
+  namespace: zorg
+---
+apiVersion: bozo.io/v1
+kind: Bozo
+metadata:
+  name: fred
+`
+
+func TestReleaseObjects(t *testing.T) {
+	oo, err := dao.ReleaseObjects(&release.Release{Namespace: "ns1", Manifest: releaseManifest})
+	require.NoError(t, err)
+
+	assert.Equal(t, []dao.HelmObject{
+		{GVR: client.DpGVR, Kind: "Deployment", Path: "ns1/fred"},
+		{GVR: client.CrGVR, Kind: "ClusterRole", Path: "fred-reader"},
+		{GVR: client.DpGVR, Kind: "Deployment", Path: "zorg/blee"},
+		{GVR: client.NoGVR, Kind: "Bozo", Path: "fred", Issue: "unknown resource bozo.io/v1, Kind=Bozo"},
+	}, oo)
+}
+
+func TestReleaseObjectsInvalid(t *testing.T) {
+	_, err := dao.ReleaseObjects(&release.Release{Namespace: "ns1", Manifest: "apiVersion: [v1"})
+	require.Error(t, err)
+}
+
+func TestReleaseHealth(t *testing.T) {
+	uu := map[string]struct {
+		synced       bool
+		ready, total int
+	}{
+		"synced": {
+			synced: true,
+			ready:  1,
+			total:  2,
+		},
+		"not-synced": {},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			f := healthFactory{
+				synced: u.synced,
+				objects: map[string]runtime.Object{
+					"ns1/fred":  deployment("fred", 2, 2),
+					"zorg/blee": deployment("blee", 2, 1),
+				},
+			}
+			ready, total := dao.ReleaseHealth(&f, &release.Release{Namespace: "ns1", Manifest: releaseManifest})
+			assert.Equal(t, u.ready, ready)
+			assert.Equal(t, u.total, total)
+			for _, gvr := range f.requested {
+				assert.Equal(t, client.DpGVR, gvr)
+			}
+		})
+	}
+}
+
+// Helpers...
+
+type healthFactory struct {
+	testFactory
+
+	synced    bool
+	objects   map[string]runtime.Object
+	requested []*client.GVR
+}
+
+func (f *healthFactory) Get(_ *client.GVR, fqn string, _ bool, _ labels.Selector) (runtime.Object, error) {
+	return f.objects[fqn], nil
+}
+
+func (f *healthFactory) CanForResource(_ string, gvr *client.GVR, _ []string) (informers.GenericInformer, error) {
+	f.requested = append(f.requested, gvr)
+
+	return syncedInformer{synced: f.synced}, nil
+}
+
+type syncedInformer struct {
+	cache.SharedIndexInformer
+
+	synced bool
+}
+
+func (i syncedInformer) Informer() cache.SharedIndexInformer { return i }
+func (syncedInformer) Lister() cache.GenericLister           { return nil }
+func (i syncedInformer) HasSynced() bool                     { return i.synced }
+
+func deployment(n string, replicas, ready int64) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]any{"name": n},
+		"spec":       map[string]any{"replicas": replicas},
+		"status":     map[string]any{"readyReplicas": ready},
+	}}
+}
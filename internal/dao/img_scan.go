@@ -5,6 +5,7 @@ package dao
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/derailed/k9s/internal"
@@ -57,9 +58,50 @@ func (is *ImageScan) List(ctx context.Context, _ string) ([]runtime.Object, erro
 			continue
 		}
 		for _, r := range s.Table.Rows {
-			res = append(res, render.ImageScanRes{Image: img, Row: r})
+			res = append(res, render.ImageScanRes{
+				Image:       img,
+				Row:         r,
+				Description: s.Description(r.Vulnerability()),
+			})
 		}
 	}
 
 	return res, nil
 }
+
+var _ Accessor = (*SBOM)(nil)
+
+// SBOM represents an image software bill of materials.
+type SBOM struct {
+	NonResource
+}
+
+// List returns a collection of packages cataloged for a given image.
+func (s *SBOM) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	img, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, fmt.Errorf("no context path for %q", s.gvr)
+	}
+	if vul.ImgScanner == nil {
+		return nil, errors.New("image scans are not enabled")
+	}
+	sc, ok := vul.ImgScanner.GetScan(img)
+	if !ok {
+		return nil, fmt.Errorf("no scan found for image %q", img)
+	}
+
+	vv := make(map[string]int, len(sc.Table.Rows))
+	for _, r := range sc.Table.Rows {
+		vv[r.Name()+"@"+r.Version()]++
+	}
+	res := make([]runtime.Object, 0, len(sc.Packages))
+	for _, p := range sc.Packages {
+		res = append(res, render.SBOMRes{
+			Image:   img,
+			Package: p,
+			Vulns:   vv[p.Name+"@"+p.Version],
+		})
+	}
+
+	return res, nil
+}
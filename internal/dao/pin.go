@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/slogs"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// PinEventWindow tracks how far back events are counted for pinned resources.
+const PinEventWindow = time.Hour
+
+var _ Accessor = (*Pin)(nil)
+
+// Pin represents the pinned resources model.
+type Pin struct {
+	NonResource
+}
+
+// List returns the pinned resources statuses.
+func (p *Pin) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	pp, ok := ctx.Value(internal.KeyPins).([]data.Pin)
+	if !ok {
+		return nil, errors.New("no pins found in context")
+	}
+
+	now := time.Now()
+	oo := make([]runtime.Object, 0, len(pp))
+	for _, pin := range pp {
+		oo = append(oo, PinStatus(p.getFactory(), pin, now))
+	}
+
+	return oo, nil
+}
+
+// PinStatus returns the current status of a pinned resource.
+func PinStatus(f Factory, p data.Pin, now time.Time) render.PinRes {
+	res := render.PinRes{GVR: p.GVR, Path: p.Path}
+
+	var t Timeline
+	t.Init(f, client.TlGVR)
+	gvr := client.NewGVR(p.GVR)
+	u, err := t.object(gvr, p.Path)
+	if err != nil {
+		res.Status, res.Ready, res.Issue = render.UnknownValue, render.NAValue, err.Error()
+		if apierrors.IsNotFound(err) {
+			res.Status, res.Issue = render.PinMissing, "resource no longer exists"
+		}
+		return res
+	}
+	res.Created = u.GetCreationTimestamp().Time
+	res.Status, res.Ready, res.Issue = ObjectHealth(u)
+
+	ee, err := t.eventEntries(u.GetNamespace(), []timelineObj{{gvr: gvr, u: u}})
+	if err != nil {
+		slog.Warn("Pin events fetch failed", slogs.GVR, gvr, slogs.FQN, p.Path, slogs.Error, err)
+		return res
+	}
+	for _, e := range ee {
+		if now.Sub(e.Time) <= PinEventWindow {
+			res.Events++
+		}
+	}
+
+	return res
+}
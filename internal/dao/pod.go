@@ -17,7 +17,6 @@ import (
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/slogs"
-	"github.com/derailed/k9s/internal/watch"
 	"github.com/derailed/tview"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -184,7 +183,7 @@ func (p *Pod) GetInstance(fqn string) (*v1.Pod, error) {
 
 // TailLogs tails a given container logs.
 func (p *Pod) TailLogs(ctx context.Context, opts *LogOptions) ([]LogChan, error) {
-	fac, ok := ctx.Value(internal.KeyFactory).(*watch.Factory)
+	fac, ok := ctx.Value(internal.KeyFactory).(Factory)
 	if !ok {
 		return nil, errors.New("no factory in context")
 	}
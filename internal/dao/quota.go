@@ -0,0 +1,164 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*ResourceQuota)(nil)
+
+// LimitRangeViolation tracks a pod violating a namespace limit range.
+type LimitRangeViolation struct {
+	Path    string
+	Reasons []string
+}
+
+// ResourceQuota represents a resource quota model.
+type ResourceQuota struct {
+	Resource
+}
+
+// Usages returns all quotas consumption in a given namespace.
+func (r *ResourceQuota) Usages(ns string) ([]render.QuotaUsage, error) {
+	oo, err := r.getFactory().List(client.RqGVR, ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	uu := make([]render.QuotaUsage, 0, len(oo))
+	for _, o := range oo {
+		var q v1.ResourceQuota
+		if err := fromUnstructured(o, &q); err != nil {
+			return nil, err
+		}
+		uu = append(uu, render.QuotaUsages(&q)...)
+	}
+
+	return uu, nil
+}
+
+// Violations returns all pods in a namespace that violate a limit range.
+func (r *ResourceQuota) Violations(ns string) ([]LimitRangeViolation, error) {
+	oo, err := r.getFactory().List(client.LrGVR, ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	if len(oo) == 0 {
+		return nil, nil
+	}
+	lrs := make([]v1.LimitRange, 0, len(oo))
+	for _, o := range oo {
+		var lr v1.LimitRange
+		if err := fromUnstructured(o, &lr); err != nil {
+			return nil, err
+		}
+		lrs = append(lrs, lr)
+	}
+
+	pp, err := r.getFactory().List(client.PodGVR, ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	vv := make([]LimitRangeViolation, 0, len(pp))
+	for _, o := range pp {
+		var po v1.Pod
+		if err := fromUnstructured(o, &po); err != nil {
+			return nil, err
+		}
+		if rr := CheckLimitRanges(&po, lrs); len(rr) > 0 {
+			vv = append(vv, LimitRangeViolation{
+				Path:    client.FQN(po.Namespace, po.Name),
+				Reasons: rr,
+			})
+		}
+	}
+
+	return vv, nil
+}
+
+// CheckLimitRanges returns the reasons why a pod violates the given limit ranges if any.
+func CheckLimitRanges(po *v1.Pod, lrs []v1.LimitRange) []string {
+	var rr []string
+	for i := range lrs {
+		for _, item := range lrs[i].Spec.Limits {
+			if item.Type != v1.LimitTypeContainer {
+				continue
+			}
+			for j := range po.Spec.Containers {
+				rr = append(rr, checkContainerLimits(lrs[i].Name, &po.Spec.Containers[j], &item)...)
+			}
+		}
+	}
+
+	return rr
+}
+
+func checkContainerLimits(lr string, co *v1.Container, item *v1.LimitRangeItem) []string {
+	var rr []string
+	req, lim := co.Resources.Requests, co.Resources.Limits
+	for _, k := range sortedResourceNames(item.Min) {
+		lo := item.Min[k]
+		if q, ok := req[k]; ok && q.Cmp(lo) < 0 {
+			rr = append(rr, fmt.Sprintf("%s: container %s %s request %s is below min %s", lr, co.Name, k, q.String(), lo.String()))
+		}
+	}
+	for _, k := range sortedResourceNames(item.Max) {
+		hi := item.Max[k]
+		if q, ok := lim[k]; ok && q.Cmp(hi) > 0 {
+			rr = append(rr, fmt.Sprintf("%s: container %s %s limit %s exceeds max %s", lr, co.Name, k, q.String(), hi.String()))
+		}
+	}
+	for _, k := range sortedResourceNames(item.Default) {
+		if _, ok := lim[k]; ok {
+			continue
+		}
+		def := item.Default[k]
+		if q, ok := req[k]; ok && q.Cmp(def) > 0 {
+			rr = append(rr, fmt.Sprintf("%s: container %s %s request %s exceeds default limit %s", lr, co.Name, k, q.String(), def.String()))
+		}
+	}
+	for _, k := range sortedResourceNames(item.MaxLimitRequestRatio) {
+		ratio := item.MaxLimitRequestRatio[k]
+		l, okl := lim[k]
+		r, okr := req[k]
+		if !okl || !okr || r.IsZero() {
+			continue
+		}
+		if float64(l.MilliValue())/float64(r.MilliValue()) > ratio.AsApproximateFloat64() {
+			rr = append(rr, fmt.Sprintf("%s: container %s %s limit/request ratio exceeds %s", lr, co.Name, k, ratio.String()))
+		}
+	}
+
+	return rr
+}
+
+func sortedResourceNames(rl v1.ResourceList) []v1.ResourceName {
+	nn := make([]v1.ResourceName, 0, len(rl))
+	for k := range rl {
+		nn = append(nn, k)
+	}
+	sort.Slice(nn, func(i, j int) bool {
+		return nn[i] < nn[j]
+	})
+
+	return nn
+}
+
+func fromUnstructured(o runtime.Object, res any) error {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+	}
+
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, res)
+}
@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCheckLimitRanges(t *testing.T) {
+	lr := v1.LimitRange{
+		ObjectMeta: metav1.ObjectMeta{Name: "lr"},
+		Spec: v1.LimitRangeSpec{
+			Limits: []v1.LimitRangeItem{
+				{
+					Type:                 v1.LimitTypeContainer,
+					Min:                  v1.ResourceList{v1.ResourceCPU: resource.MustParse("50m")},
+					Max:                  v1.ResourceList{v1.ResourceMemory: resource.MustParse("1Gi")},
+					Default:              v1.ResourceList{v1.ResourceCPU: resource.MustParse("500m")},
+					MaxLimitRequestRatio: v1.ResourceList{v1.ResourceMemory: resource.MustParse("2")},
+				},
+			},
+		},
+	}
+
+	uu := map[string]struct {
+		res v1.ResourceRequirements
+		e   []string
+	}{
+		"happy": {
+			res: v1.ResourceRequirements{
+				Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("100m")},
+			},
+		},
+		"below-min": {
+			res: v1.ResourceRequirements{
+				Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("10m")},
+			},
+			e: []string{"lr: container c1 cpu request 10m is below min 50m"},
+		},
+		"above-max": {
+			res: v1.ResourceRequirements{
+				Requests: v1.ResourceList{v1.ResourceMemory: resource.MustParse("1Gi")},
+				Limits:   v1.ResourceList{v1.ResourceMemory: resource.MustParse("2Gi")},
+			},
+			e: []string{"lr: container c1 memory limit 2Gi exceeds max 1Gi"},
+		},
+		"above-default": {
+			res: v1.ResourceRequirements{
+				Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("1")},
+			},
+			e: []string{"lr: container c1 cpu request 1 exceeds default limit 500m"},
+		},
+		"ratio": {
+			res: v1.ResourceRequirements{
+				Requests: v1.ResourceList{v1.ResourceMemory: resource.MustParse("100Mi")},
+				Limits:   v1.ResourceList{v1.ResourceMemory: resource.MustParse("500Mi")},
+			},
+			e: []string{"lr: container c1 memory limit/request ratio exceeds 2"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			po := v1.Pod{
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{{Name: "c1", Resources: u.res}},
+				},
+			}
+			assert.Equal(t, u.e, dao.CheckLimitRanges(&po, []v1.LimitRange{lr}))
+		})
+	}
+}
@@ -239,6 +239,13 @@ func loadK9s(m ResourceMetas) {
 		Verbs:        []string{"delete"},
 		Categories:   []string{k9sCat},
 	}
+	m[client.SdpGVR] = &metav1.APIResource{
+		Name:         client.SdpGVR.String(),
+		Kind:         "DumpPreviews",
+		SingularName: "dumppreview",
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
 	m[client.BeGVR] = &metav1.APIResource{
 		Name:         "benchmarks",
 		Kind:         "Benchmarks",
@@ -263,6 +270,80 @@ func loadK9s(m ResourceMetas) {
 		Verbs:        []string{},
 		Categories:   []string{k9sCat},
 	}
+	m[client.CapGVR] = &metav1.APIResource{
+		Name:         "capacities",
+		Kind:         "Capacity",
+		SingularName: "capacity",
+		ShortNames:   []string{"cap"},
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
+	m[client.CpoGVR] = &metav1.APIResource{
+		Name:         "capacity-pods",
+		Kind:         "CapacityPods",
+		SingularName: "capacity-pod",
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
+	m[client.TlGVR] = &metav1.APIResource{
+		Name:         "timelines",
+		Kind:         "Timeline",
+		SingularName: "timeline",
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
+	m[client.EvaGVR] = &metav1.APIResource{
+		Name:         "eventarchives",
+		Kind:         "EventArchive",
+		SingularName: "eventarchive",
+		ShortNames:   []string{"eva"},
+		Namespaced:   true,
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
+	m[client.SesGVR] = &metav1.APIResource{
+		Name:         "sessions",
+		Kind:         "Session",
+		SingularName: "session",
+		Verbs:        []string{"delete"},
+		Categories:   []string{k9sCat},
+	}
+	m[client.SseGVR] = &metav1.APIResource{
+		Name:         client.SseGVR.String(),
+		Kind:         "SessionEntry",
+		SingularName: "session-entry",
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
+	m[client.PinGVR] = &metav1.APIResource{
+		Name:         "pins",
+		Kind:         "Pin",
+		SingularName: "pin",
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
+	m[client.AlGVR] = &metav1.APIResource{
+		Name:         "alerts",
+		Kind:         "Alert",
+		SingularName: "alert",
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
+	m[client.CrtGVR] = &metav1.APIResource{
+		Name:         "tlscerts",
+		Kind:         "TLSCert",
+		SingularName: "tlscert",
+		Namespaced:   true,
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
+	m[client.DkGVR] = &metav1.APIResource{
+		Name:         "datakeys",
+		Kind:         "DataKey",
+		SingularName: "datakey",
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
 	m[client.ScnGVR] = &metav1.APIResource{
 		Name:         "scans",
 		Kind:         "Scans",
@@ -270,6 +351,13 @@ func loadK9s(m ResourceMetas) {
 		Verbs:        []string{},
 		Categories:   []string{k9sCat},
 	}
+	m[client.BomGVR] = &metav1.APIResource{
+		Name:         "sboms",
+		Kind:         "SBOM",
+		SingularName: "sbom",
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
 }
 
 func loadHelm(m ResourceMetas) {
@@ -287,6 +375,13 @@ func loadHelm(m ResourceMetas) {
 		Verbs:      []string{"delete"},
 		Categories: []string{helmCat},
 	}
+	m[client.HmrGVR] = &metav1.APIResource{
+		Name:       "resources",
+		Kind:       "Resource",
+		Namespaced: true,
+		Verbs:      []string{},
+		Categories: []string{helmCat},
+	}
 }
 
 func loadRBAC(m ResourceMetas) {
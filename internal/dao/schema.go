@@ -0,0 +1,478 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"gopkg.in/yaml.v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	schemaRefPrefix = "#/components/schemas/"
+	schemaJSON      = "application/json"
+	schemaWrap      = 80
+
+	// maxSchemaDepth caps schemas expansion for deeply nested resources.
+	maxSchemaDepth = 15
+)
+
+// Schema represents a resource schema as advertised by its CRD or the api-server
+// OpenAPI v3 discovery.
+type Schema struct {
+	GVK      schema.GroupVersionKind
+	Resource string
+	Versions []string
+	Root     *SchemaField
+}
+
+// SchemaField represents a resource schema field.
+type SchemaField struct {
+	Name        string
+	Type        string
+	Format      string
+	Description string
+	Required    bool
+	Enum        []any
+	Default     any
+	MinItems    int64
+	MinLength   int64
+	Minimum     *float64
+	Items       *SchemaField
+	Fields      []*SchemaField
+
+	kind string
+}
+
+// ResourceSchema fetches a resource schema. A blank version yields the CRD
+// storage version or the resource preferred version for built-in types.
+func ResourceSchema(f Factory, gvr *client.GVR, version string) (*Schema, error) {
+	m, err := MetaAccess.MetaFor(gvr)
+	if err != nil {
+		return nil, err
+	}
+	if IsCRD(m) {
+		o, err := f.Get(client.CrdGVR, client.FQN(client.ClusterScope, m.Name+"."+m.Group), true, labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		return CRDSchema(u.Object, version)
+	}
+	if version == "" {
+		version = gvr.V()
+	}
+
+	return openAPISchema(f, m, version)
+}
+
+// CRDSchema extracts a schema from a custom resource definition.
+func CRDSchema(crd map[string]any, version string) (*Schema, error) {
+	group, _, _ := unstructured.NestedString(crd, "spec", "group")
+	kind, _, _ := unstructured.NestedString(crd, "spec", "names", "kind")
+	plural, _, _ := unstructured.NestedString(crd, "spec", "names", "plural")
+	vv, _, _ := unstructured.NestedSlice(crd, "spec", "versions")
+
+	s := Schema{Resource: plural}
+	var spec map[string]any
+	for _, v := range vv {
+		m, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+		if served, _, _ := unstructured.NestedBool(m, "served"); !served {
+			continue
+		}
+		n, _, _ := unstructured.NestedString(m, "name")
+		s.Versions = append(s.Versions, n)
+		storage, _, _ := unstructured.NestedBool(m, "storage")
+		if n == version || (version == "" && storage) {
+			s.GVK = schema.GroupVersionKind{Group: group, Version: n, Kind: kind}
+			spec, _, _ = unstructured.NestedMap(m, "schema", "openAPIV3Schema")
+		}
+	}
+	if s.GVK.Version == "" {
+		return nil, fmt.Errorf("no served version %q found for %s", version, kind)
+	}
+	if spec == nil {
+		return nil, fmt.Errorf("no schema defined for %s/%s", kind, s.GVK.Version)
+	}
+	s.Root = newSchemaBuilder(nil).build(kind, spec, 0)
+
+	return &s, nil
+}
+
+// OpenAPISchema extracts a resource schema from an OpenAPI v3 group version document.
+func OpenAPISchema(doc map[string]any, gvk schema.GroupVersionKind) (*Schema, error) {
+	defs, _, _ := unstructured.NestedMap(doc, "components", "schemas")
+	for _, k := range sortedKeys(defs) {
+		def, ok := defs[k].(map[string]any)
+		if !ok || !hasGVK(def, gvk) {
+			continue
+		}
+		s := Schema{
+			GVK:      gvk,
+			Versions: []string{gvk.Version},
+			Root:     newSchemaBuilder(defs).build(gvk.Kind, def, 0),
+		}
+		return &s, nil
+	}
+
+	return nil, fmt.Errorf("no schema found for %s", gvk)
+}
+
+func openAPISchema(f Factory, m *metav1.APIResource, version string) (*Schema, error) {
+	dial, err := f.Client().CachedDiscovery()
+	if err != nil {
+		return nil, err
+	}
+	pp, err := dial.OpenAPIV3().Paths()
+	if err != nil {
+		return nil, err
+	}
+	path := "apis/" + m.Group + "/" + version
+	if m.Group == "" {
+		path = "api/" + version
+	}
+	gv, ok := pp[path]
+	if !ok {
+		return nil, fmt.Errorf("no OpenAPI schema found for %q", path)
+	}
+	bb, err := gv.Schema(schemaJSON)
+	if err != nil {
+		return nil, err
+	}
+	var doc map[string]any
+	if err := json.Unmarshal(bb, &doc); err != nil {
+		return nil, err
+	}
+	s, err := OpenAPISchema(doc, schema.GroupVersionKind{Group: m.Group, Version: version, Kind: m.Kind})
+	if err != nil {
+		return nil, err
+	}
+	s.Resource = m.Name
+
+	return s, nil
+}
+
+// Explain renders the schema as an explain style fields tree.
+func (s *Schema) Explain(descriptions bool) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "KIND:     %s\n", s.GVK.Kind)
+	fmt.Fprintf(&b, "VERSION:  %s\n", s.GVK.GroupVersion())
+	if s.Resource != "" {
+		fmt.Fprintf(&b, "RESOURCE: %s\n", s.Resource)
+	}
+	if len(s.Versions) > 1 {
+		fmt.Fprintf(&b, "VERSIONS: %s\n", strings.Join(s.Versions, ", "))
+	}
+	if s.Root.Description != "" {
+		b.WriteString("\nDESCRIPTION:\n")
+		writeWrapped(&b, s.Root.Description, 2)
+	}
+	b.WriteString("\nFIELDS:\n")
+	for _, f := range s.Root.children() {
+		f.explain(&b, 1, descriptions)
+	}
+
+	return b.String()
+}
+
+// Example generates a minimal manifest satisfying the schema required fields.
+func (s *Schema) Example() (string, error) {
+	m := map[string]any{
+		"apiVersion": s.GVK.GroupVersion().String(),
+		"kind":       s.GVK.Kind,
+		"metadata":   map[string]any{"name": "example"},
+	}
+	for _, f := range s.Root.children() {
+		if _, ok := m[f.Name]; ok || !f.Required {
+			continue
+		}
+		m[f.Name] = f.example()
+	}
+	bb, err := yaml.Marshal(m)
+	if err != nil {
+		return "", err
+	}
+
+	return string(bb), nil
+}
+
+func (f *SchemaField) children() []*SchemaField {
+	if f.Items != nil && len(f.Fields) == 0 {
+		return f.Items.children()
+	}
+
+	return f.Fields
+}
+
+func (f *SchemaField) explain(b *strings.Builder, level int, descriptions bool) {
+	indent := strings.Repeat("  ", level)
+	fmt.Fprintf(b, "%s%s <%s>", indent, f.Name, f.Type)
+	if f.Required {
+		b.WriteString(" -required-")
+	}
+	b.WriteString("\n")
+	if descriptions {
+		if len(f.Enum) > 0 {
+			fmt.Fprintf(b, "%s    enum: %s\n", indent, joinAny(f.Enum))
+		}
+		if f.Default != nil {
+			fmt.Fprintf(b, "%s    default: %v\n", indent, f.Default)
+		}
+		if f.Description != "" {
+			writeWrapped(b, f.Description, 2*level+4)
+		}
+	}
+	for _, c := range f.children() {
+		c.explain(b, level+1, descriptions)
+	}
+}
+
+func (f *SchemaField) example() any {
+	switch {
+	case f.Default != nil:
+		return f.Default
+	case len(f.Enum) > 0:
+		return f.Enum[0]
+	}
+
+	switch f.kind {
+	case "object":
+		m := make(map[string]any)
+		for _, c := range f.Fields {
+			if c.Required {
+				m[c.Name] = c.example()
+			}
+		}
+		return m
+	case "array":
+		if f.Items == nil {
+			return []any{}
+		}
+		aa := make([]any, 0, f.MinItems)
+		for range f.MinItems {
+			aa = append(aa, f.Items.example())
+		}
+		return aa
+	case "string":
+		if f.Format == "date-time" {
+			return "1970-01-01T00:00:00Z"
+		}
+		return strings.Repeat("x", int(f.MinLength))
+	case "integer":
+		if f.Minimum != nil {
+			return int64(*f.Minimum)
+		}
+		return 0
+	case "number":
+		if f.Minimum != nil {
+			return *f.Minimum
+		}
+		return 0
+	case "boolean":
+		return false
+	case "int-or-string":
+		return 0
+	default:
+		return map[string]any{}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// schemaBuilder converts json schemas into fields trees, resolving references
+// against the OpenAPI document definitions.
+type schemaBuilder struct {
+	defs map[string]any
+	refs map[string]struct{}
+}
+
+func newSchemaBuilder(defs map[string]any) *schemaBuilder {
+	return &schemaBuilder{defs: defs, refs: make(map[string]struct{})}
+}
+
+func (b *schemaBuilder) build(name string, s map[string]any, depth int) *SchemaField {
+	f := SchemaField{Name: name}
+	f.Description, _, _ = unstructured.NestedString(s, "description")
+	f.Default = s["default"]
+
+	ref, s := b.resolve(s)
+	if f.Description == "" {
+		f.Description, _, _ = unstructured.NestedString(s, "description")
+	}
+	if f.Default == nil {
+		f.Default = s["default"]
+	}
+	if ee, ok := s["enum"].([]any); ok {
+		f.Enum = ee
+	}
+	f.kind, _, _ = unstructured.NestedString(s, "type")
+	f.Format, _, _ = unstructured.NestedString(s, "format")
+	f.MinItems = asInt(s["minItems"])
+	f.MinLength = asInt(s["minLength"])
+	if v, ok := asFloat(s["minimum"]); ok {
+		f.Minimum = &v
+	}
+	if ios, _ := s["x-kubernetes-int-or-string"].(bool); ios {
+		f.kind = "int-or-string"
+	}
+
+	if _, ok := b.refs[ref]; ok || depth > maxSchemaDepth {
+		f.Type = b.typeOf(ref, &f, nil)
+		return &f
+	}
+	if ref != "" {
+		b.refs[ref] = struct{}{}
+		defer delete(b.refs, ref)
+	}
+
+	var items *SchemaField
+	switch {
+	case f.kind == "array":
+		if m, ok := s["items"].(map[string]any); ok {
+			f.Items = b.build(name, m, depth+1)
+		}
+	case f.kind == "object" || s["properties"] != nil:
+		f.kind = "object"
+		props, _ := s["properties"].(map[string]any)
+		req, _, _ := unstructured.NestedStringSlice(s, "required")
+		for _, k := range sortedKeys(props) {
+			m, ok := props[k].(map[string]any)
+			if !ok {
+				continue
+			}
+			c := b.build(k, m, depth+1)
+			c.Required = slices.Contains(req, k)
+			f.Fields = append(f.Fields, c)
+		}
+		if m, ok := s["additionalProperties"].(map[string]any); ok && len(props) == 0 {
+			items = b.build(name, m, depth+1)
+		}
+	}
+	f.Type = b.typeOf(ref, &f, items)
+
+	return &f
+}
+
+// resolve follows a schema reference if any.
+func (b *schemaBuilder) resolve(s map[string]any) (string, map[string]any) {
+	ref, _ := s["$ref"].(string)
+	if all, ok := s["allOf"].([]any); ok && ref == "" && len(all) == 1 {
+		if m, ok := all[0].(map[string]any); ok {
+			ref, _ = m["$ref"].(string)
+		}
+	}
+	if ref == "" {
+		return "", s
+	}
+	ref = strings.TrimPrefix(ref, schemaRefPrefix)
+	if def, ok := b.defs[ref].(map[string]any); ok {
+		return ref, def
+	}
+
+	return ref, s
+}
+
+func (*schemaBuilder) typeOf(ref string, f *SchemaField, values *SchemaField) string {
+	switch {
+	case f.kind == "array" && f.Items != nil:
+		return "[]" + f.Items.Type
+	case values != nil:
+		return "map[string]" + values.Type
+	case ref != "" && (f.kind == "object" || f.kind == ""):
+		return ref[strings.LastIndex(ref, ".")+1:]
+	case f.kind == "int-or-string":
+		return "IntOrString"
+	case f.kind == "object", f.kind == "":
+		return "Object"
+	default:
+		return f.kind
+	}
+}
+
+func hasGVK(def map[string]any, gvk schema.GroupVersionKind) bool {
+	gg, _ := def["x-kubernetes-group-version-kind"].([]any)
+	for _, g := range gg {
+		m, ok := g.(map[string]any)
+		if !ok {
+			continue
+		}
+		if m["group"] == gvk.Group && m["version"] == gvk.Version && m["kind"] == gvk.Kind {
+			return true
+		}
+	}
+
+	return false
+}
+
+func sortedKeys(m map[string]any) []string {
+	kk := make([]string, 0, len(m))
+	for k := range m {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+
+	return kk
+}
+
+func asInt(v any) int64 {
+	f, _ := asFloat(v)
+
+	return int64(f)
+}
+
+func asFloat(v any) (float64, bool) {
+	switch t := v.(type) {
+	case int64:
+		return float64(t), true
+	case int:
+		return float64(t), true
+	case float64:
+		return t, true
+	default:
+		return 0, false
+	}
+}
+
+func joinAny(aa []any) string {
+	ss := make([]string, 0, len(aa))
+	for _, a := range aa {
+		ss = append(ss, fmt.Sprintf("%v", a))
+	}
+
+	return strings.Join(ss, ", ")
+}
+
+func writeWrapped(b *strings.Builder, s string, indent int) {
+	pad := strings.Repeat(" ", indent)
+	line := pad
+	for w := range strings.FieldsSeq(s) {
+		if len(line) > indent && len(line)+len(w)+1 > schemaWrap {
+			b.WriteString(line + "\n")
+			line = pad
+		}
+		if len(line) > indent {
+			line += " "
+		}
+		line += w
+	}
+	if len(line) > indent {
+		b.WriteString(line + "\n")
+	}
+}
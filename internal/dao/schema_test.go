@@ -0,0 +1,184 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const crdJSON = `{
+  "spec": {
+    "group": "fred.io",
+    "names": {"kind": "Blee", "plural": "blees"},
+    "versions": [
+      {"name": "v1alpha1", "served": false, "storage": false},
+      {"name": "v1beta1", "served": true, "storage": false,
+       "schema": {"openAPIV3Schema": {"type": "object"}}},
+      {"name": "v1", "served": true, "storage": true,
+       "schema": {"openAPIV3Schema": {
+         "type": "object",
+         "description": "Blee is a cool resource.",
+         "required": ["spec"],
+         "properties": {
+           "apiVersion": {"type": "string"},
+           "kind": {"type": "string"},
+           "metadata": {"type": "object"},
+           "spec": {
+             "type": "object",
+             "required": ["mode", "replicas", "targets", "size"],
+             "properties": {
+               "mode": {"type": "string", "enum": ["fast", "slow"], "description": "Sets the processing mode."},
+               "replicas": {"type": "integer", "minimum": 1},
+               "size": {"x-kubernetes-int-or-string": true},
+               "paused": {"type": "boolean", "default": false},
+               "labels": {"type": "object", "additionalProperties": {"type": "string"}},
+               "targets": {
+                 "type": "array",
+                 "minItems": 1,
+                 "items": {
+                   "type": "object",
+                   "required": ["name"],
+                   "properties": {
+                     "name": {"type": "string", "minLength": 3},
+                     "port": {"type": "integer"}
+                   }
+                 }
+               }
+             }
+           },
+           "status": {"type": "object", "x-kubernetes-preserve-unknown-fields": true}
+         }
+       }}}
+    ]
+  }
+}`
+
+const openAPIJSON = `{
+  "components": {
+    "schemas": {
+      "io.fred.v1.Node": {
+        "type": "object",
+        "properties": {
+          "name": {"type": "string"},
+          "children": {"type": "array", "items": {"allOf": [{"$ref": "#/components/schemas/io.fred.v1.Node"}]}}
+        }
+      },
+      "io.fred.v1.Tree": {
+        "type": "object",
+        "description": "Tree is a recursive resource.",
+        "x-kubernetes-group-version-kind": [{"group": "fred.io", "version": "v1", "kind": "Tree"}],
+        "properties": {
+          "apiVersion": {"type": "string"},
+          "kind": {"type": "string"},
+          "root": {"description": "The root node.", "allOf": [{"$ref": "#/components/schemas/io.fred.v1.Node"}]}
+        },
+        "required": ["root"]
+      }
+    }
+  }
+}`
+
+func TestCRDSchemaExplain(t *testing.T) {
+	s, err := dao.CRDSchema(loadJSON(t, crdJSON), "")
+	require.NoError(t, err)
+
+	assert.Equal(t, schema.GroupVersionKind{Group: "fred.io", Version: "v1", Kind: "Blee"}, s.GVK)
+	assert.Equal(t, []string{"v1beta1", "v1"}, s.Versions)
+
+	e := `KIND:     Blee
+VERSION:  fred.io/v1
+RESOURCE: blees
+VERSIONS: v1beta1, v1
+
+DESCRIPTION:
+  Blee is a cool resource.
+
+FIELDS:
+  apiVersion <string>
+  kind <string>
+  metadata <Object>
+  spec <Object> -required-
+    labels <map[string]string>
+    mode <string> -required-
+        enum: fast, slow
+        Sets the processing mode.
+    paused <boolean>
+        default: false
+    replicas <integer> -required-
+    size <IntOrString> -required-
+    targets <[]Object> -required-
+      name <string> -required-
+      port <integer>
+  status <Object>
+`
+	assert.Equal(t, e, s.Explain(true))
+}
+
+func TestCRDSchemaExample(t *testing.T) {
+	s, err := dao.CRDSchema(loadJSON(t, crdJSON), "")
+	require.NoError(t, err)
+
+	e := `apiVersion: fred.io/v1
+kind: Blee
+metadata:
+    name: example
+spec:
+    mode: fast
+    replicas: 1
+    size: 0
+    targets:
+        - name: xxx
+`
+	ex, err := s.Example()
+	require.NoError(t, err)
+	assert.Equal(t, e, ex)
+}
+
+func TestCRDSchemaVersions(t *testing.T) {
+	s, err := dao.CRDSchema(loadJSON(t, crdJSON), "v1beta1")
+	require.NoError(t, err)
+	assert.Equal(t, "v1beta1", s.GVK.Version)
+
+	_, err = dao.CRDSchema(loadJSON(t, crdJSON), "v1alpha1")
+	assert.ErrorContains(t, err, `no served version "v1alpha1" found for Blee`)
+}
+
+func TestOpenAPISchema(t *testing.T) {
+	doc := loadJSON(t, openAPIJSON)
+	s, err := dao.OpenAPISchema(doc, schema.GroupVersionKind{Group: "fred.io", Version: "v1", Kind: "Tree"})
+	require.NoError(t, err)
+
+	e := `KIND:     Tree
+VERSION:  fred.io/v1
+
+DESCRIPTION:
+  Tree is a recursive resource.
+
+FIELDS:
+  apiVersion <string>
+  kind <string>
+  root <Node> -required-
+    children <[]Node>
+    name <string>
+`
+	assert.Equal(t, e, s.Explain(false))
+
+	_, err = dao.OpenAPISchema(doc, schema.GroupVersionKind{Group: "fred.io", Version: "v1", Kind: "Bozo"})
+	assert.Error(t, err)
+}
+
+// Helpers...
+
+func loadJSON(t *testing.T, raw string) map[string]any {
+	var m map[string]any
+	require.NoError(t, json.Unmarshal([]byte(raw), &m))
+
+	return m
+}
@@ -6,10 +6,15 @@ package dao
 import (
 	"context"
 	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
 	"os"
 
 	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/model1"
 	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/slogs"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -17,6 +22,7 @@ import (
 var (
 	_ Accessor = (*ScreenDump)(nil)
 	_ Nuker    = (*ScreenDump)(nil)
+	_ Accessor = (*DumpPreview)(nil)
 )
 
 // ScreenDump represents a scraped resources.
@@ -24,8 +30,12 @@ type ScreenDump struct {
 	NonResource
 }
 
-// Delete a ScreenDump.
+// Delete a ScreenDump and its metadata sidecar if any.
 func (*ScreenDump) Delete(_ context.Context, path string, _ *metav1.DeletionPropagation, _ Grace) error {
+	if err := os.Remove(model1.DumpMetaPath(path)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
 	return os.Remove(path)
 }
 
@@ -40,12 +50,95 @@ func (*ScreenDump) List(ctx context.Context, _ string) ([]runtime.Object, error)
 	if err != nil {
 		return nil, err
 	}
-	oo := make([]runtime.Object, len(ff))
-	for i, f := range ff {
+	oo := make([]runtime.Object, 0, len(ff))
+	for _, f := range ff {
+		if model1.IsDumpMeta(f.Name()) {
+			continue
+		}
 		if fi, err := f.Info(); err == nil {
-			oo[i] = render.FileRes{File: fi, Dir: dir}
+			oo = append(oo, render.FileRes{File: fi, Dir: dir})
 		}
 	}
 
 	return oo, nil
 }
+
+// DumpPreview represents a screen dump content.
+type DumpPreview struct {
+	NonResource
+}
+
+// List returns a screen dump rows as a table.
+func (*DumpPreview) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, errors.New("no screendump path found in context")
+	}
+	d, err := LoadScreenDump(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return []runtime.Object{dumpToTable(d)}, nil
+}
+
+// LoadScreenDump reads a screen dump and its metadata sidecar if any from disk.
+func LoadScreenDump(path string) (*model1.ScreenDump, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer closeDump(f, path)
+
+	format := model1.DumpFormatFor(path)
+	d, err := model1.ReadScreenDump(f, format)
+	if err != nil || model1.HasInlineMeta(format) {
+		return d, err
+	}
+
+	mpath := model1.DumpMetaPath(path)
+	m, err := os.Open(mpath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return d, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer closeDump(m, mpath)
+
+	return d, d.ReadMeta(m)
+}
+
+func closeDump(f *os.File, path string) {
+	if err := f.Close(); err != nil {
+		slog.Error("Closing screen dump failed", slogs.Path, path, slogs.Error, err)
+	}
+}
+
+func dumpToTable(d *model1.ScreenDump) *metav1.Table {
+	var t metav1.Table
+	t.ColumnDefinitions = make([]metav1.TableColumnDefinition, 0, len(d.Header))
+	for _, h := range d.Header {
+		t.ColumnDefinitions = append(t.ColumnDefinitions, metav1.TableColumnDefinition{Name: h, Type: "string"})
+	}
+	t.Rows = make([]metav1.TableRow, 0, len(d.Rows))
+	for i, r := range d.Rows {
+		cells := make([]any, len(d.Header))
+		for j := range cells {
+			cells[j] = ""
+			if j < len(r) {
+				cells[j] = r[j]
+			}
+		}
+		t.Rows = append(t.Rows, metav1.TableRow{
+			Cells: cells,
+			Object: runtime.RawExtension{
+				Object: &metav1.PartialObjectMetadata{
+					ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("%06d", i)},
+				},
+			},
+		})
+	}
+
+	return &t
+}
@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDumpPreviewList(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "pods-default-1.md")
+	raw := `# v1/pods
+
+- resource: v1/pods
+- context: ct-1
+- namespace: default
+- filter: 
+- timestamp: 2026-10-19T10:00:00Z
+
+| NAME | STATUS |
+| --- | --- |
+| p1 | Running |
+| p1 | Error |
+| p2 |
+`
+	require.NoError(t, os.WriteFile(path, []byte(raw), 0600))
+
+	var d DumpPreview
+	oo, err := d.List(context.WithValue(context.Background(), internal.KeyPath, path), "")
+	require.NoError(t, err)
+	require.Len(t, oo, 1)
+
+	tt, ok := oo[0].(*metav1.Table)
+	require.True(t, ok)
+	require.Len(t, tt.ColumnDefinitions, 2)
+	assert.Equal(t, "STATUS", tt.ColumnDefinitions[1].Name)
+	require.Len(t, tt.Rows, 3)
+	assert.Equal(t, []any{"p1", "Error"}, tt.Rows[1].Cells)
+	assert.Equal(t, []any{"p2", ""}, tt.Rows[2].Cells)
+
+	m0, ok := tt.Rows[0].Object.Object.(*metav1.PartialObjectMetadata)
+	require.True(t, ok)
+	m1, ok := tt.Rows[1].Object.Object.(*metav1.PartialObjectMetadata)
+	require.True(t, ok)
+	assert.NotEqual(t, m0.Name, m1.Name)
+}
+
+func TestDumpPreviewListNoPath(t *testing.T) {
+	var d DumpPreview
+	_, err := d.List(context.Background(), "")
+
+	assert.Error(t, err)
+}
+
+func TestScreenDumpCSVSidecar(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "pods-default-1.csv")
+	require.NoError(t, os.WriteFile(path, []byte("NAME,STATUS\np1,Running\n"), 0600))
+	require.NoError(t, os.WriteFile(model1.DumpMetaPath(path), []byte("resource: v1/pods\ncontext: ct-1\n"), 0600))
+
+	d, err := LoadScreenDump(path)
+	require.NoError(t, err)
+	assert.Equal(t, "v1/pods", d.Meta.Resource)
+	assert.Equal(t, "ct-1", d.Meta.Context)
+	assert.Equal(t, [][]string{{"p1", "Running"}}, d.Rows)
+
+	var s ScreenDump
+	oo, err := s.List(context.WithValue(context.Background(), internal.KeyDir, dir), "")
+	require.NoError(t, err)
+	assert.Len(t, oo, 1)
+
+	require.NoError(t, s.Delete(context.Background(), path, nil, NowGrace))
+	ff, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, ff)
+}
@@ -0,0 +1,334 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/slogs"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	sessionExt     = ".jsonl"
+	sessionTimeFmt = "20060102-150405"
+)
+
+// sessRecorder tracks the active session recorder if any.
+var sessRecorder atomic.Pointer[SessionRecorder]
+
+// SessRecorder returns the active session recorder if any.
+func SessRecorder() *SessionRecorder {
+	return sessRecorder.Load()
+}
+
+// SetSessRecorder sets the active session recorder and returns the previous one if any.
+func SetSessRecorder(r *SessionRecorder) *SessionRecorder {
+	return sessRecorder.Swap(r)
+}
+
+var (
+	_ Accessor = (*Session)(nil)
+	_ Nuker    = (*Session)(nil)
+	_ Accessor = (*SessionEntry)(nil)
+)
+
+// Session represents the recorded sessions model.
+type Session struct {
+	NonResource
+}
+
+// Delete removes a recorded session.
+func (*Session) Delete(_ context.Context, path string, _ *metav1.DeletionPropagation, _ Grace) error {
+	if r := SessRecorder(); r != nil && r.Path() == path {
+		return errors.New("unable to delete the active session")
+	}
+
+	return os.Remove(path)
+}
+
+// List returns all recorded sessions.
+func (*Session) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	dir, ok := ctx.Value(internal.KeyDir).(string)
+	if !ok {
+		return nil, errors.New("no sessions dir found in context")
+	}
+	ff, err := sessionFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	oo := make([]runtime.Object, 0, len(ff))
+	for _, f := range ff {
+		s, err := summarizeSession(filepath.Join(dir, f))
+		if err != nil {
+			slog.Warn("Unable to load session", slogs.Path, f, slogs.Error, err)
+			continue
+		}
+		oo = append(oo, s)
+	}
+
+	return oo, nil
+}
+
+// SessionEntry represents a recorded session entries model.
+type SessionEntry struct {
+	NonResource
+}
+
+// List returns the entries of a given session.
+func (*SessionEntry) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, errors.New("no session path found in context")
+	}
+	ee, err := LoadSession(path)
+	if err != nil {
+		return nil, err
+	}
+	oo := make([]runtime.Object, 0, len(ee))
+	for _, e := range ee {
+		oo = append(oo, e)
+	}
+
+	return oo, nil
+}
+
+// LoadSession reads all entries from a recorded session file.
+func LoadSession(path string) ([]render.SessionEntryRes, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			slog.Error("Closing session file failed", slogs.Path, path, slogs.Error, err)
+		}
+	}()
+
+	var ee []render.SessionEntryRes
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		var e render.SessionEntryRes
+		if err := json.Unmarshal([]byte(line), &e); err != nil {
+			return nil, fmt.Errorf("invalid session entry in %q: %w", path, err)
+		}
+		e.Seq = len(ee) + 1
+		ee = append(ee, e)
+	}
+
+	return ee, scanner.Err()
+}
+
+// SessionRecorder records operator interactions into a session audit file.
+type SessionRecorder struct {
+	dir         string
+	context     string
+	maxSessions int
+	path        string
+	file        *os.File
+	suspended   bool
+	mx          sync.Mutex
+}
+
+// NewSessionRecorder returns a new recorder persisting sessions in the given directory.
+func NewSessionRecorder(dir, context string, cfg config.SessionRecording) *SessionRecorder {
+	return &SessionRecorder{
+		dir:         dir,
+		context:     context,
+		maxSessions: cfg.MaxSessions,
+	}
+}
+
+// Start opens a new session file and evicts the oldest sessions past the max count.
+func (r *SessionRecorder) Start(now time.Time) error {
+	r.Stop()
+
+	if err := data.EnsureFullPath(r.dir, data.DefaultDirMod); err != nil {
+		return err
+	}
+	if err := r.prune(); err != nil {
+		slog.Warn("Session pruning failed", slogs.Error, err)
+	}
+
+	r.mx.Lock()
+	defer r.mx.Unlock()
+	path := filepath.Join(r.dir, fmt.Sprintf("%s-%d%s", now.Format(sessionTimeFmt), os.Getpid(), sessionExt))
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, data.DefaultFileMod)
+	if err != nil {
+		return err
+	}
+	r.path, r.file = path, f
+
+	return nil
+}
+
+// Stop closes the active session file.
+func (r *SessionRecorder) Stop() {
+	if r == nil {
+		return
+	}
+	r.mx.Lock()
+	defer r.mx.Unlock()
+	if r.file == nil {
+		return
+	}
+	if err := r.file.Close(); err != nil {
+		slog.Error("Closing session file failed", slogs.Path, r.path, slogs.Error, err)
+	}
+	r.file = nil
+}
+
+// Suspend stops recording interactions until resumed.
+func (r *SessionRecorder) Suspend() {
+	r.setSuspended(true)
+}
+
+// Resume resumes recording interactions.
+func (r *SessionRecorder) Resume() {
+	r.setSuspended(false)
+}
+
+func (r *SessionRecorder) setSuspended(b bool) {
+	if r == nil {
+		return
+	}
+	r.mx.Lock()
+	defer r.mx.Unlock()
+	r.suspended = b
+}
+
+// Path returns the active session file path.
+func (r *SessionRecorder) Path() string {
+	if r == nil {
+		return ""
+	}
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	return r.path
+}
+
+// Record appends an operator interaction and its outcome to the active session.
+func (r *SessionRecorder) Record(kind, command, target string, err error) {
+	if r == nil {
+		return
+	}
+	e := render.SessionEntryRes{
+		Time:    time.Now(),
+		Context: r.context,
+		Kind:    kind,
+		Command: command,
+		Target:  target,
+	}
+	if err != nil {
+		e.Error = err.Error()
+	}
+	bb, err := json.Marshal(e)
+	if err != nil {
+		slog.Error("Session entry encoding failed", slogs.Error, err)
+		return
+	}
+
+	r.mx.Lock()
+	defer r.mx.Unlock()
+	if r.file == nil || r.suspended {
+		return
+	}
+	if _, err := r.file.Write(append(bb, '\n')); err != nil {
+		slog.Error("Session entry write failed", slogs.Path, r.path, slogs.Error, err)
+	}
+}
+
+// prune evicts the oldest sessions to make room for a new one.
+func (r *SessionRecorder) prune() error {
+	ff, err := sessionFiles(r.dir)
+	if err != nil {
+		return err
+	}
+	if len(ff) < r.maxSessions {
+		return nil
+	}
+	sort.Strings(ff)
+	var errs error
+	for _, f := range ff[:len(ff)-r.maxSessions+1] {
+		errs = errors.Join(errs, os.Remove(filepath.Join(r.dir, f)))
+	}
+
+	return errs
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func sessionFiles(dir string) ([]string, error) {
+	ee, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	ff := make([]string, 0, len(ee))
+	for _, e := range ee {
+		if !e.IsDir() && filepath.Ext(e.Name()) == sessionExt {
+			ff = append(ff, e.Name())
+		}
+	}
+
+	return ff, nil
+}
+
+func summarizeSession(path string) (render.SessionRes, error) {
+	s := render.SessionRes{
+		Path: path,
+		Name: strings.TrimSuffix(filepath.Base(path), sessionExt),
+	}
+	ee, err := LoadSession(path)
+	if err != nil {
+		return s, err
+	}
+	if len(ee) == 0 {
+		if fi, err := os.Stat(path); err == nil {
+			s.Started, s.Ended = fi.ModTime(), fi.ModTime()
+		}
+		return s, nil
+	}
+
+	s.Context = ee[0].Context
+	s.Started, s.Ended = ee[0].Time, ee[len(ee)-1].Time
+	for _, e := range ee {
+		if render.IsSessionAction(e.Kind) {
+			s.Actions++
+		} else {
+			s.Commands++
+		}
+		if e.Error != "" {
+			s.Failures++
+		}
+	}
+
+	return s, nil
+}
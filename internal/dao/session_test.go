@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionRecorder(t *testing.T) {
+	dir := t.TempDir()
+	r := NewSessionRecorder(dir, "ct-1", config.SessionRecording{Enable: true, MaxSessions: 2})
+	require.NoError(t, r.Start(time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)))
+	r.Record(render.SessionCommand, "pods kube-system", "", nil)
+	r.Record(render.SessionFilter, "fred", "v1/pods", nil)
+	r.Record(render.SessionDelete, "delete", "v1/pods:default/p1", errors.New("boom"))
+	r.Suspend()
+	r.Record(render.SessionCommand, "ctx fred", "", nil)
+	r.Resume()
+	r.Stop()
+	r.Record(render.SessionCommand, "dp", "", nil)
+
+	ee, err := LoadSession(r.Path())
+	require.NoError(t, err)
+	require.Len(t, ee, 3)
+	assert.Equal(t, 1, ee[0].Seq)
+	assert.Equal(t, "ct-1", ee[0].Context)
+	assert.Equal(t, "pods kube-system", ee[0].Command)
+	assert.Equal(t, "v1/pods", ee[1].Target)
+	assert.Equal(t, "boom", ee[2].Error)
+	assert.Equal(t, "FAILED", ee[2].Result())
+
+	var s Session
+	oo, err := s.List(context.WithValue(context.Background(), internal.KeyDir, dir), "")
+	require.NoError(t, err)
+	require.Len(t, oo, 1)
+	sess, ok := oo[0].(render.SessionRes)
+	require.True(t, ok)
+	assert.Equal(t, "ct-1", sess.Context)
+	assert.Equal(t, 2, sess.Commands)
+	assert.Equal(t, 1, sess.Actions)
+	assert.Equal(t, 1, sess.Failures)
+}
+
+func TestSessionRecorderPrune(t *testing.T) {
+	dir := t.TempDir()
+	r := NewSessionRecorder(dir, "ct-1", config.SessionRecording{Enable: true, MaxSessions: 2})
+	t0 := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+	var first string
+	for i := range 3 {
+		require.NoError(t, r.Start(t0.Add(time.Duration(i)*time.Minute)))
+		if i == 0 {
+			first = r.Path()
+		}
+		r.Record(render.SessionCommand, "pods", "", nil)
+	}
+	r.Stop()
+
+	ff, err := sessionFiles(dir)
+	require.NoError(t, err)
+	assert.Len(t, ff, 2)
+	_, err = os.Stat(first)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+	assert.Contains(t, ff, filepath.Base(r.Path()))
+}
+
+func TestSessionRecorderNil(t *testing.T) {
+	var r *SessionRecorder
+	r.Record(render.SessionCommand, "pods", "", nil)
+	r.Stop()
+	assert.Empty(t, r.Path())
+}
@@ -0,0 +1,262 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/slogs"
+	v1 "k8s.io/api/core/v1"
+	eventsv1 "k8s.io/api/events/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ Accessor = (*Timeline)(nil)
+
+// timelineChildren tracks the resources owned by a given workload.
+var timelineChildren = map[*client.GVR][]*client.GVR{
+	client.DpGVR:  {client.RsGVR},
+	client.RsGVR:  {client.PodGVR},
+	client.StsGVR: {client.PodGVR},
+	client.DsGVR:  {client.PodGVR},
+	client.CjGVR:  {client.JobGVR},
+	client.JobGVR: {client.PodGVR},
+}
+
+type timelineObj struct {
+	gvr *client.GVR
+	u   *unstructured.Unstructured
+}
+
+// Timeline correlates an object events, its owned children events and pods lifecycles.
+type Timeline struct {
+	NonResource
+}
+
+// List returns the timeline entries for a given object in chronological order.
+func (t *Timeline) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	gvr, ok := ctx.Value(internal.KeyGVR).(*client.GVR)
+	if !ok {
+		return nil, errors.New("no context for gvr found")
+	}
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, errors.New("no context for path found")
+	}
+
+	u, err := t.object(gvr, path)
+	if err != nil {
+		return nil, err
+	}
+	objs := append([]timelineObj{{gvr: gvr, u: u}}, t.descendants(gvr, u)...)
+
+	ee, err := t.eventEntries(u.GetNamespace(), objs)
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range objs {
+		if obj.gvr != client.PodGVR {
+			continue
+		}
+		var po v1.Pod
+		if err := fromUnstructured(obj.u, &po); err != nil {
+			return nil, err
+		}
+		ee = append(ee, PodTimelineEntries(&po)...)
+	}
+
+	return timelineRes(ee), nil
+}
+
+// ResourceEvents returns the events regarding a given resource in chronological order.
+func ResourceEvents(f Factory, gvr *client.GVR, path string) ([]render.TimelineEntry, error) {
+	var t Timeline
+	t.Init(f, client.TlGVR)
+	u, err := t.object(gvr, path)
+	if err != nil {
+		return nil, err
+	}
+	ee, err := t.eventEntries(u.GetNamespace(), []timelineObj{{gvr: gvr, u: u}})
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(ee, func(i, j int) bool {
+		return ee[i].Time.Before(ee[j].Time)
+	})
+
+	return ee, nil
+}
+
+func (t *Timeline) object(gvr *client.GVR, path string) (*unstructured.Unstructured, error) {
+	o, err := t.getFactory().Get(gvr, path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+	}
+
+	return u, nil
+}
+
+// descendants returns all the resources owned directly or transitively by the given object.
+func (t *Timeline) descendants(gvr *client.GVR, u *unstructured.Unstructured) []timelineObj {
+	var objs []timelineObj
+	for _, cgvr := range timelineChildren[gvr] {
+		oo, err := t.getFactory().List(cgvr, u.GetNamespace(), true, labels.Everything())
+		if err != nil {
+			slog.Warn("Timeline children fetch failed", slogs.GVR, cgvr, slogs.Error, err)
+			continue
+		}
+		for _, o := range oo {
+			c, ok := o.(*unstructured.Unstructured)
+			if !ok || !isOwnedBy(c, u.GetUID()) {
+				continue
+			}
+			objs = append(objs, timelineObj{gvr: cgvr, u: c})
+			objs = append(objs, t.descendants(cgvr, c)...)
+		}
+	}
+
+	return objs
+}
+
+func (t *Timeline) eventEntries(ns string, objs []timelineObj) ([]render.TimelineEntry, error) {
+	uids := make(map[types.UID]timelineObj, len(objs))
+	for _, o := range objs {
+		uids[o.u.GetUID()] = o
+	}
+
+	oo, err := t.getFactory().List(client.EvGVR, ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	ee := make([]render.TimelineEntry, 0, len(oo))
+	for _, o := range oo {
+		var ev eventsv1.Event
+		if err := fromUnstructured(o, &ev); err != nil {
+			return nil, err
+		}
+		obj, ok := uids[ev.Regarding.UID]
+		if !ok {
+			continue
+		}
+		ee = append(ee, render.TimelineEntry{
+			Time:    eventTime(&ev),
+			GVR:     obj.gvr.String(),
+			Kind:    timelineKind(obj),
+			Path:    client.FQN(obj.u.GetNamespace(), obj.u.GetName()),
+			Type:    ev.Type,
+			Reason:  ev.Reason,
+			Message: ev.Note,
+		})
+	}
+
+	return ee, nil
+}
+
+// PodTimelineEntries returns a pod conditions transitions and containers restarts.
+func PodTimelineEntries(po *v1.Pod) []render.TimelineEntry {
+	path := client.FQN(po.Namespace, po.Name)
+	ee := make([]render.TimelineEntry, 0, len(po.Status.Conditions))
+	for _, c := range po.Status.Conditions {
+		if c.LastTransitionTime.IsZero() {
+			continue
+		}
+		msg := c.Message
+		if msg == "" {
+			msg = c.Reason
+		}
+		ee = append(ee, render.TimelineEntry{
+			Time:    c.LastTransitionTime.Time,
+			GVR:     client.PodGVR.String(),
+			Kind:    "Pod",
+			Path:    path,
+			Type:    render.TimelineCondition,
+			Reason:  fmt.Sprintf("%s=%s", c.Type, c.Status),
+			Message: msg,
+		})
+	}
+
+	cos := append(append([]v1.ContainerStatus{}, po.Status.InitContainerStatuses...), po.Status.ContainerStatuses...)
+	for _, co := range cos {
+		term := co.LastTerminationState.Terminated
+		if co.RestartCount == 0 || term == nil {
+			continue
+		}
+		ee = append(ee, render.TimelineEntry{
+			Time:    term.FinishedAt.Time,
+			GVR:     client.PodGVR.String(),
+			Kind:    "Pod",
+			Path:    path,
+			Type:    render.TimelineRestart,
+			Reason:  term.Reason,
+			Message: fmt.Sprintf("container %s exited with code %d (restarts: %d)", co.Name, term.ExitCode, co.RestartCount),
+		})
+	}
+
+	return ee
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func timelineRes(ee []render.TimelineEntry) []runtime.Object {
+	sort.SliceStable(ee, func(i, j int) bool {
+		return ee[i].Time.Before(ee[j].Time)
+	})
+	var start, end time.Time
+	if len(ee) > 0 {
+		start, end = ee[0].Time, ee[len(ee)-1].Time
+	}
+	oo := make([]runtime.Object, 0, len(ee))
+	for _, e := range ee {
+		oo = append(oo, render.TimelineRes{Entry: e, Start: start, End: end})
+	}
+
+	return oo
+}
+
+func isOwnedBy(u *unstructured.Unstructured, uid types.UID) bool {
+	for _, ref := range u.GetOwnerReferences() {
+		if ref.UID == uid {
+			return true
+		}
+	}
+
+	return false
+}
+
+func timelineKind(o timelineObj) string {
+	if k := o.u.GetKind(); k != "" {
+		return k
+	}
+
+	return o.gvr.R()
+}
+
+func eventTime(ev *eventsv1.Event) time.Time {
+	switch {
+	case ev.Series != nil && !ev.Series.LastObservedTime.IsZero():
+		return ev.Series.LastObservedTime.Time
+	case !ev.DeprecatedLastTimestamp.IsZero():
+		return ev.DeprecatedLastTimestamp.Time
+	case !ev.EventTime.IsZero():
+		return ev.EventTime.Time
+	default:
+		return ev.CreationTimestamp.Time
+	}
+}
@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPodTimelineEntries(t *testing.T) {
+	t0 := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	po := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "p1"},
+		Status: v1.PodStatus{
+			Conditions: []v1.PodCondition{
+				{Type: v1.PodScheduled, Status: v1.ConditionTrue, LastTransitionTime: metav1.NewTime(t0)},
+				{Type: v1.PodReady, Status: v1.ConditionFalse, Reason: "ContainersNotReady", LastTransitionTime: metav1.NewTime(t0.Add(time.Minute))},
+				{Type: v1.PodInitialized, Status: v1.ConditionTrue},
+			},
+			ContainerStatuses: []v1.ContainerStatus{
+				{
+					Name:         "c1",
+					RestartCount: 2,
+					LastTerminationState: v1.ContainerState{
+						Terminated: &v1.ContainerStateTerminated{
+							Reason:     "OOMKilled",
+							ExitCode:   137,
+							FinishedAt: metav1.NewTime(t0.Add(2 * time.Minute)),
+						},
+					},
+				},
+				{Name: "c2"},
+			},
+		},
+	}
+
+	ee := dao.PodTimelineEntries(&po)
+	assert.Len(t, ee, 3)
+	assert.Equal(t, render.TimelineEntry{
+		Time:    t0.Add(time.Minute),
+		GVR:     "v1/pods",
+		Kind:    "Pod",
+		Path:    "default/p1",
+		Type:    render.TimelineCondition,
+		Reason:  "Ready=False",
+		Message: "ContainersNotReady",
+	}, ee[1])
+	assert.Equal(t, render.TimelineRestart, ee[2].Type)
+	assert.Equal(t, "OOMKilled", ee[2].Reason)
+	assert.Equal(t, "container c1 exited with code 137 (restarts: 2)", ee[2].Message)
+}
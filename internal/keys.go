@@ -36,4 +36,7 @@ const (
 	KeyWait          ContextKey = "wait"
 	KeyPodCounting   ContextKey = "podCounting"
 	KeyEnableImgScan ContextKey = "vulScan"
+	KeyExpiring      ContextKey = "expiring"
+	KeyPins          ContextKey = "pins"
+	KeyAlerts        ContextKey = "alerts"
 )
@@ -0,0 +1,395 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/slogs"
+)
+
+const (
+	alertRefresh   = 2 * time.Second
+	webhookTimeout = 5 * time.Second
+)
+
+// AlertListener represents an alert listener.
+type AlertListener interface {
+	// AlertFired notifies an alert fired.
+	AlertFired(render.AlertRes)
+
+	// AlertResolved notifies an alert resolved.
+	AlertResolved(render.AlertRes)
+}
+
+// GVRFunc resolves a resource alias or gvr.
+type GVRFunc func(string) *client.GVR
+
+type alertRule struct {
+	config.AlertRule
+
+	gvr *client.GVR
+	rx  *regexp.Regexp
+}
+
+// match checks if a row matches the rule and returns the matching value.
+func (r alertRule) match(h model1.Header, row model1.Row) (string, bool) {
+	if r.Namespace != "" {
+		if ns, _ := client.Namespaced(row.ID); ns != r.Namespace {
+			return "", false
+		}
+	}
+
+	var vv []string
+	if r.rx != nil {
+		idx, ok := h.IndexOf(r.ColumnName(), true)
+		if !ok || idx >= len(row.Fields) || !r.rx.MatchString(row.Fields[idx]) {
+			return "", false
+		}
+		vv = append(vv, row.Fields[idx])
+	}
+	if r.NotReady {
+		idx, ok := h.IndexOf("READY", true)
+		if !ok || idx >= len(row.Fields) || isReady(row.Fields[idx]) {
+			return "", false
+		}
+		vv = append(vv, row.Fields[idx])
+	}
+
+	return strings.Join(vv, " "), true
+}
+
+// isReady checks if a ready/desired value is fully ready.
+func isReady(s string) bool {
+	ready, desired, ok := strings.Cut(s, "/")
+	if !ok {
+		return true
+	}
+	r, err := strconv.Atoi(strings.TrimSpace(ready))
+	if err != nil {
+		return true
+	}
+	d, err := strconv.Atoi(strings.TrimSpace(desired))
+	if err != nil {
+		return true
+	}
+
+	return r >= d
+}
+
+// AlertMonitor evaluates alert rules against the cluster resources.
+type AlertMonitor struct {
+	factory   dao.Factory
+	context   string
+	maxAlerts int
+	rules     []alertRule
+	tables    map[string]*model1.TableData
+	pending   map[string]time.Time
+	active    map[string]render.AlertRes
+	resolved  []render.AlertRes
+	listeners []AlertListener
+	cancelFn  context.CancelFunc
+	mx        sync.RWMutex
+}
+
+// NewAlertMonitor returns a new alert monitor.
+func NewAlertMonitor(f dao.Factory, ctx string, cfg config.Alerts, gvrFn GVRFunc) *AlertMonitor {
+	m := AlertMonitor{
+		factory:   f,
+		context:   ctx,
+		maxAlerts: cfg.MaxAlerts,
+		tables:    make(map[string]*model1.TableData),
+		pending:   make(map[string]time.Time),
+		active:    make(map[string]render.AlertRes),
+	}
+	for _, r := range cfg.Rules {
+		rule := alertRule{AlertRule: r, gvr: gvrFn(r.Resource)}
+		if r.Match != "" {
+			rule.rx = regexp.MustCompile(r.Match)
+		}
+		m.rules = append(m.rules, rule)
+	}
+
+	return &m
+}
+
+// AddListener registers a new alert listener.
+func (m *AlertMonitor) AddListener(l AlertListener) {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	m.listeners = append(m.listeners, l)
+}
+
+// Alerts returns the firing and resolved alerts, most recent first.
+func (m *AlertMonitor) Alerts() []render.AlertRes {
+	m.mx.RLock()
+	defer m.mx.RUnlock()
+
+	aa := make([]render.AlertRes, 0, len(m.active)+len(m.resolved))
+	for _, a := range m.active {
+		aa = append(aa, a)
+	}
+	aa = append(aa, m.resolved...)
+	sort.SliceStable(aa, func(i, j int) bool {
+		return aa[i].Fired.After(aa[j].Fired)
+	})
+
+	return aa
+}
+
+// Firing returns the number of firing alerts.
+func (m *AlertMonitor) Firing() int {
+	m.mx.RLock()
+	defer m.mx.RUnlock()
+
+	return len(m.active)
+}
+
+// Start starts evaluating the alert rules.
+func (m *AlertMonitor) Start(ctx context.Context) {
+	m.Stop()
+
+	ctx, cancel := context.WithCancel(ctx)
+	m.mx.Lock()
+	m.cancelFn = cancel
+	m.mx.Unlock()
+
+	go m.run(ctx)
+}
+
+// Stop stops evaluating the alert rules.
+func (m *AlertMonitor) Stop() {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	if m.cancelFn != nil {
+		m.cancelFn()
+		m.cancelFn = nil
+	}
+}
+
+func (m *AlertMonitor) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(alertRefresh):
+			m.refresh(ctx)
+		}
+	}
+}
+
+func (m *AlertMonitor) refresh(ctx context.Context) {
+	ctx = context.WithValue(ctx, internal.KeyFactory, m.factory)
+	for _, gvr := range m.gvrs() {
+		td, err := m.reconcile(ctx, gvr)
+		if err != nil {
+			slog.Warn("Alert rules evaluation failed", slogs.GVR, gvr, slogs.Error, err)
+			continue
+		}
+		m.Evaluate(gvr, td, time.Now())
+	}
+}
+
+func (m *AlertMonitor) gvrs() []*client.GVR {
+	seen := make(map[string]struct{}, len(m.rules))
+	gg := make([]*client.GVR, 0, len(m.rules))
+	for _, r := range m.rules {
+		if _, ok := seen[r.gvr.String()]; ok {
+			continue
+		}
+		seen[r.gvr.String()] = struct{}{}
+		gg = append(gg, r.gvr)
+	}
+
+	return gg
+}
+
+// reconcile renders the resource rows, tracking changes via the table row events.
+func (m *AlertMonitor) reconcile(ctx context.Context, gvr *client.GVR) (*model1.TableData, error) {
+	meta := resourceMeta(gvr)
+	meta.DAO.Init(m.factory, gvr)
+	oo, err := meta.DAO.List(ctx, client.BlankNamespace)
+	if err != nil {
+		return nil, err
+	}
+
+	td, ok := m.tables[gvr.String()]
+	if !ok {
+		td = model1.NewTableData(gvr)
+		td.Reset(client.NamespaceAll)
+		m.tables[gvr.String()] = td
+	}
+
+	return td, td.Render(ctx, meta.Renderer, oo)
+}
+
+// Evaluate checks the resource rows against the alert rules.
+func (m *AlertMonitor) Evaluate(gvr *client.GVR, td *model1.TableData, now time.Time) {
+	var fired, resolved []render.AlertRes
+
+	m.mx.Lock()
+	h := td.GetHeader()
+	for _, r := range m.rules {
+		if r.gvr.String() != gvr.String() {
+			continue
+		}
+		seen := make(map[string]struct{})
+		td.RowsRange(func(_ int, re model1.RowEvent) bool {
+			v, ok := r.match(h, re.Row)
+			if !ok {
+				return true
+			}
+			k := alertKey(gvr, re.Row.ID, r.Name)
+			seen[k] = struct{}{}
+			if a, ok := m.active[k]; ok {
+				a.Value = v
+				m.active[k] = a
+				return true
+			}
+			started, ok := m.pending[k]
+			if !ok {
+				started, m.pending[k] = now, now
+			}
+			if now.Sub(started) < r.Duration() {
+				return true
+			}
+			delete(m.pending, k)
+			a := render.AlertRes{
+				Rule:     r.Name,
+				Severity: r.Severity,
+				Context:  m.context,
+				GVR:      gvr.String(),
+				Path:     re.Row.ID,
+				Value:    v,
+				Started:  started,
+				Fired:    now,
+			}
+			m.active[k] = a
+			fired = append(fired, a)
+			return true
+		})
+		prefix := alertKey(gvr, "", "")
+		for k := range m.pending {
+			if _, ok := seen[k]; !ok && strings.HasPrefix(k, prefix) && strings.HasSuffix(k, " "+r.Name) {
+				delete(m.pending, k)
+			}
+		}
+		for k, a := range m.active {
+			if _, ok := seen[k]; ok || a.GVR != gvr.String() || a.Rule != r.Name {
+				continue
+			}
+			delete(m.active, k)
+			a.Resolved = now
+			resolved = append(resolved, a)
+		}
+	}
+	sort.SliceStable(resolved, func(i, j int) bool {
+		if resolved[i].Rule != resolved[j].Rule {
+			return resolved[i].Rule < resolved[j].Rule
+		}
+		return resolved[i].Path < resolved[j].Path
+	})
+	m.resolved = append(resolved, m.resolved...)
+	if n := m.maxAlerts - len(m.active); len(m.resolved) > n {
+		m.resolved = m.resolved[:max(n, 0)]
+	}
+	ll := m.listeners
+	m.mx.Unlock()
+
+	for _, l := range ll {
+		for _, a := range fired {
+			l.AlertFired(a)
+		}
+		for _, a := range resolved {
+			l.AlertResolved(a)
+		}
+	}
+}
+
+func alertKey(gvr *client.GVR, path, rule string) string {
+	return gvr.String() + " " + path + " " + rule
+}
+
+// ----------------------------------------------------------------------------
+// Webhook...
+
+// AlertWebhook posts alerts to a remote url.
+type AlertWebhook struct {
+	url    string
+	client *http.Client
+}
+
+// NewAlertWebhook returns a new webhook.
+func NewAlertWebhook(url string) *AlertWebhook {
+	return &AlertWebhook{
+		url:    url,
+		client: &http.Client{Timeout: webhookTimeout},
+	}
+}
+
+// AlertFired notifies an alert fired.
+func (w *AlertWebhook) AlertFired(a render.AlertRes) {
+	go w.post(a)
+}
+
+// AlertResolved notifies an alert resolved.
+func (w *AlertWebhook) AlertResolved(a render.AlertRes) {
+	go w.post(a)
+}
+
+type alertPayload struct {
+	render.AlertRes
+
+	State string `json:"state"`
+}
+
+// Post sends the alert to the webhook url.
+func (w *AlertWebhook) Post(a render.AlertRes) error {
+	raw, err := json.Marshal(alertPayload{AlertRes: a, State: a.State()})
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), webhookTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(raw))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("webhook call failed: %s", resp.Status)
+	}
+
+	return nil
+}
+
+func (w *AlertWebhook) post(a render.AlertRes) {
+	if err := w.Post(a); err != nil {
+		slog.Warn("Alert webhook failed", slogs.URL, w.url, slogs.Error, err)
+	}
+}
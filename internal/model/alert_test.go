@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlertMonitorEvaluate(t *testing.T) {
+	cfg := config.Alerts{
+		MaxAlerts: 10,
+		Rules: []config.AlertRule{
+			{Name: "crash", Resource: "po", Namespace: "ns1", Column: "STATUS", Match: "CrashLoop", Severity: config.AlertError},
+			{Name: "unready", Resource: "po", NotReady: true, For: "2m", Severity: config.AlertWarn},
+		},
+	}
+	m := model.NewAlertMonitor(nil, "fred", cfg, func(string) *client.GVR { return client.PodGVR })
+	var l alertListener
+	m.AddListener(&l)
+
+	now := time.Now()
+	m.Evaluate(client.PodGVR, podsTable(
+		podRow("ns1/p1", "0/1", "CrashLoopBackOff"),
+		podRow("ns2/p2", "0/1", "CrashLoopBackOff"),
+		podRow("ns1/p3", "1/1", "Running"),
+	), now)
+	assert.Equal(t, []string{"crash ns1/p1"}, l.fired)
+	assert.Equal(t, 1, m.Firing())
+
+	m.Evaluate(client.PodGVR, podsTable(
+		podRow("ns1/p1", "0/1", "CrashLoopBackOff"),
+		podRow("ns2/p2", "0/1", "CrashLoopBackOff"),
+		podRow("ns1/p3", "1/1", "Running"),
+	), now.Add(3*time.Minute))
+	assert.Equal(t, []string{"crash ns1/p1", "unready ns1/p1", "unready ns2/p2"}, l.fired)
+	assert.Equal(t, 3, m.Firing())
+
+	m.Evaluate(client.PodGVR, podsTable(
+		podRow("ns1/p1", "1/1", "Running"),
+	), now.Add(4*time.Minute))
+	assert.Equal(t, []string{"crash ns1/p1", "unready ns1/p1", "unready ns2/p2"}, l.resolved)
+	assert.Equal(t, 0, m.Firing())
+
+	aa := m.Alerts()
+	require.Len(t, aa, 3)
+	for _, a := range aa {
+		assert.Equal(t, render.AlertResolved, a.State())
+		assert.Equal(t, "fred", a.Context)
+	}
+}
+
+func TestAlertMonitorMaxAlerts(t *testing.T) {
+	cfg := config.Alerts{
+		MaxAlerts: 1,
+		Rules: []config.AlertRule{
+			{Name: "crash", Resource: "po", Column: "STATUS", Match: "CrashLoop", Severity: config.AlertError},
+		},
+	}
+	m := model.NewAlertMonitor(nil, "fred", cfg, func(string) *client.GVR { return client.PodGVR })
+
+	now := time.Now()
+	for i := range 3 {
+		m.Evaluate(client.PodGVR, podsTable(podRow("ns1/p1", "0/1", "CrashLoopBackOff")), now.Add(time.Duration(2*i)*time.Second))
+		m.Evaluate(client.PodGVR, podsTable(), now.Add(time.Duration(2*i+1)*time.Second))
+	}
+	assert.Len(t, m.Alerts(), 1)
+}
+
+func TestAlertWebhook(t *testing.T) {
+	var got map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	w := model.NewAlertWebhook(srv.URL)
+	require.NoError(t, w.Post(render.AlertRes{Rule: "crash", GVR: "v1/pods", Path: "ns1/p1", Severity: "error"}))
+	assert.Equal(t, "crash", got["rule"])
+	assert.Equal(t, "ns1/p1", got["path"])
+	assert.Equal(t, render.AlertFiring, got["state"])
+}
+
+// Helpers...
+
+type alertListener struct {
+	fired, resolved []string
+}
+
+func (l *alertListener) AlertFired(a render.AlertRes) {
+	l.fired = append(l.fired, a.Rule+" "+a.Path)
+}
+
+func (l *alertListener) AlertResolved(a render.AlertRes) {
+	l.resolved = append(l.resolved, a.Rule+" "+a.Path)
+}
+
+func podRow(id, ready, status string) model1.RowEvent {
+	ns, n := client.Namespaced(id)
+	return model1.NewRowEvent(model1.EventAdd, model1.Row{ID: id, Fields: model1.Fields{ns, n, ready, status}})
+}
+
+func podsTable(ee ...model1.RowEvent) *model1.TableData {
+	return model1.NewTableDataWithRows(
+		client.PodGVR,
+		model1.Header{
+			model1.HeaderColumn{Name: "NAMESPACE"},
+			model1.HeaderColumn{Name: "NAME"},
+			model1.HeaderColumn{Name: "READY"},
+			model1.HeaderColumn{Name: "STATUS"},
+		},
+		model1.NewRowEventsWithEvts(ee...),
+	)
+}
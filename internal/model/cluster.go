@@ -92,6 +92,11 @@ func (c *Cluster) UserName() string {
 	return n
 }
 
+// Impersonation returns the impersonated identity if any.
+func (c *Cluster) Impersonation() string {
+	return c.factory.Client().Config().ImpersonationInfo()
+}
+
 // Metrics gathers node level metrics and compute utilization percentages.
 func (c *Cluster) Metrics(ctx context.Context, mx *client.ClusterMetrics) error {
 	var (
@@ -10,14 +10,17 @@ import (
 	"reflect"
 	"strings"
 	"sync/atomic"
+	"text/tabwriter"
 	"time"
 
 	backoff "github.com/cenkalti/backoff/v4"
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/slogs"
 	"github.com/sahilm/fuzzy"
+	"k8s.io/apimachinery/pkg/util/duration"
 )
 
 // Describe tracks describable resources.
@@ -30,6 +33,7 @@ type Describe struct {
 	refreshRate time.Duration
 	listeners   []ResourceViewerListener
 	decode      bool
+	describeFn  func(context.Context, *client.GVR, string) (string, error)
 }
 
 // NewDescribe returns a new describe resource model.
@@ -41,6 +45,14 @@ func NewDescribe(gvr *client.GVR, path string) *Describe {
 	}
 }
 
+// NewResourceEvents returns a new model tracking the events of a given resource.
+func NewResourceEvents(gvr *client.GVR, path string) *Describe {
+	d := NewDescribe(gvr, path)
+	d.describeFn = resourceEvents
+
+	return d
+}
+
 // GVR returns the resource gvr.
 func (d *Describe) GVR() *client.GVR {
 	return d.gvr
@@ -157,7 +169,11 @@ func (d *Describe) refresh(ctx context.Context) error {
 }
 
 func (d *Describe) reconcile(ctx context.Context) error {
-	s, err := d.describe(ctx, d.gvr, d.path)
+	describe := d.describe
+	if d.describeFn != nil {
+		describe = d.describeFn
+	}
+	s, err := describe(ctx, d.gvr, d.path)
 	if err != nil {
 		return err
 	}
@@ -188,6 +204,37 @@ func (d *Describe) describe(ctx context.Context, gvr *client.GVR, path string) (
 	return desc.Describe(path)
 }
 
+func resourceEvents(ctx context.Context, gvr *client.GVR, path string) (string, error) {
+	factory, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
+	if !ok {
+		return "", fmt.Errorf("expected Factory in context but got %T", ctx.Value(internal.KeyFactory))
+	}
+	ee, err := dao.ResourceEvents(factory, gvr, path)
+	if err != nil {
+		return "", err
+	}
+
+	return eventsText(ee, time.Now()), nil
+}
+
+// eventsText renders events as a describe events section.
+func eventsText(ee []render.TimelineEntry, now time.Time) string {
+	if len(ee) == 0 {
+		return "Events: <none>"
+	}
+
+	var b strings.Builder
+	w := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Type\tReason\tAge\tMessage")
+	fmt.Fprintln(w, "----\t------\t---\t-------")
+	for _, e := range ee {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", e.Type, e.Reason, duration.HumanDuration(now.Sub(e.Time)), strings.TrimSpace(e.Message))
+	}
+	_ = w.Flush()
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
 // AddListener adds a new model listener.
 func (d *Describe) AddListener(l ResourceViewerListener) {
 	d.listeners = append(d.listeners, l)
@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_eventsText(t *testing.T) {
+	now := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+	uu := map[string]struct {
+		ee []render.TimelineEntry
+		e  string
+	}{
+		"none": {
+			e: "Events: <none>",
+		},
+		"events": {
+			ee: []render.TimelineEntry{
+				{Time: now.Add(-5 * time.Minute), Type: "Normal", Reason: "Scheduled", Message: "Successfully assigned default/p1\n"},
+				{Time: now.Add(-30 * time.Second), Type: "Warning", Reason: "BackOff", Message: "Back-off restarting failed container"},
+			},
+			e: "Type     Reason     Age  Message\n" +
+				"----     ------     ---  -------\n" +
+				"Normal   Scheduled  5m   Successfully assigned default/p1\n" +
+				"Warning  BackOff    30s  Back-off restarting failed container",
+		},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, eventsText(u.ee, now))
+		})
+	}
+}
@@ -6,7 +6,9 @@ package model
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"regexp"
+	"sync"
 	"time"
 
 	"github.com/cenkalti/backoff/v4"
@@ -14,8 +16,11 @@ import (
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/slogs"
 	"github.com/sahilm/fuzzy"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 func getMeta(ctx context.Context, gvr *client.GVR) (ResourceMeta, error) {
@@ -44,6 +49,41 @@ func resourceMeta(gvr *client.GVR) ResourceMeta {
 	return meta
 }
 
+// newLookup returns a related resources lookup for custom columns expressions.
+// Results are cached for the lifetime of the lookup ie a table refresh. Lookups read
+// from the informers cache without waiting on it. The first lookup of a given kind
+// starts watching it, thus its resources resolve once the cache is synced.
+func newLookup(f dao.Factory) render.LookupFunc {
+	var (
+		mx    sync.Mutex
+		cache = make(map[string]map[string]any)
+	)
+
+	return func(gvr, fqn string) (map[string]any, bool) {
+		mx.Lock()
+		defer mx.Unlock()
+
+		key := gvr + "|" + fqn
+		if o, ok := cache[key]; ok {
+			return o, o != nil
+		}
+		var m map[string]any
+		o, err := f.Get(client.NewGVR(gvr), fqn, false, labels.Everything())
+		if err != nil {
+			slog.Debug("Custom column lookup failed",
+				slogs.GVR, gvr,
+				slogs.FQN, fqn,
+				slogs.Error, err,
+			)
+		} else if u, ok := o.(*unstructured.Unstructured); ok {
+			m = u.Object
+		}
+		cache[key] = m
+
+		return m, m != nil
+	}
+}
+
 // MetaFQN returns a fully qualified resource name.
 func MetaFQN(m *metav1.ObjectMeta) string {
 	return FQN(m.Namespace, m.Name)
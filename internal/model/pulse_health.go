@@ -7,7 +7,9 @@ import (
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model1"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/slogs"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -126,18 +128,19 @@ func (h *PulseHealth) check(ctx context.Context, ns string, gvr *client.GVR) (He
 	if err != nil {
 		return HealthPoint{}, err
 	}
+	rules := h.rulesFor(ctx, gvr, ns)
 	c := HealthPoint{GVR: gvr, Total: len(oo)}
 	if isTable(oo) {
 		ta := oo[0].(*metav1.Table)
 		c.Total = len(ta.Rows)
 		for _, row := range ta.Rows {
-			if err := meta.Renderer.Healthy(ctx, row); err != nil {
+			if isFaulty(ctx, meta.Renderer, rules, ns, row) {
 				c.Faults++
 			}
 		}
 	} else {
 		for _, o := range oo {
-			if err := meta.Renderer.Healthy(ctx, o); err != nil {
+			if isFaulty(ctx, meta.Renderer, rules, ns, o) {
 				c.Faults++
 			}
 		}
@@ -147,6 +150,33 @@ func (h *PulseHealth) check(ctx context.Context, ns string, gvr *client.GVR) (He
 	return c, nil
 }
 
+// rulesFor returns the custom row rules for a given resource if any.
+func (h *PulseHealth) rulesFor(ctx context.Context, gvr *client.GVR, ns string) render.RowRules {
+	cv, ok := ctx.Value(internal.KeyViewConfig).(*config.CustomView)
+	if !ok {
+		return nil
+	}
+	rr, err := render.NewRowRules(cv.RulesFor(gvr.String(), ns)...)
+	if err != nil {
+		slog.Warn("Unable to grok custom row rules", slogs.GVR, gvr, slogs.Error, err)
+		return nil
+	}
+	rr.SetLookup(newLookup(h.factory))
+
+	return rr
+}
+
+// isFaulty checks a resource health. Custom row rules severity takes precedence.
+func isFaulty(ctx context.Context, r model1.Renderer, rules render.RowRules, ns string, o any) bool {
+	if len(rules) > 0 {
+		if rule, ok := rules.MatchResource(r, ns, o); ok && rule.Severity != "" {
+			return rule.IsFault()
+		}
+	}
+
+	return r.Healthy(ctx, o) != nil
+}
+
 func isTable(oo []runtime.Object) bool {
 	if len(oo) == 0 || len(oo) > 1 {
 		return false
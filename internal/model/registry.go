@@ -31,13 +31,18 @@ var Registry = map[*client.GVR]ResourceMeta{
 		DAO: new(dao.Pulse),
 	},
 	client.HmGVR: {
-		DAO:      new(dao.HelmChart),
-		Renderer: new(helm.Chart),
+		DAO:          new(dao.HelmChart),
+		Renderer:     new(helm.Chart),
+		TreeRenderer: new(xray.HelmRelease),
 	},
 	client.HmhGVR: {
 		DAO:      new(dao.HelmHistory),
 		Renderer: new(helm.History),
 	},
+	client.HmrGVR: {
+		DAO:      new(dao.HelmResource),
+		Renderer: new(helm.Resource),
+	},
 	client.CoGVR: {
 		DAO:          new(dao.Container),
 		Renderer:     new(render.Container),
@@ -47,6 +52,10 @@ var Registry = map[*client.GVR]ResourceMeta{
 		DAO:      new(dao.ImageScan),
 		Renderer: new(render.ImageScan),
 	},
+	client.BomGVR: {
+		DAO:      new(dao.SBOM),
+		Renderer: new(render.SBOM),
+	},
 	client.CtGVR: {
 		DAO:      new(dao.Context),
 		Renderer: new(render.Context),
@@ -55,6 +64,10 @@ var Registry = map[*client.GVR]ResourceMeta{
 		DAO:      new(dao.ScreenDump),
 		Renderer: new(render.ScreenDump),
 	},
+	client.SdpGVR: {
+		DAO:      new(dao.DumpPreview),
+		Renderer: new(render.Table),
+	},
 	client.RbacGVR: {
 		DAO:      new(dao.Rbac),
 		Renderer: new(render.Rbac),
@@ -83,6 +96,46 @@ var Registry = map[*client.GVR]ResourceMeta{
 		DAO:      new(dao.Alias),
 		Renderer: new(render.Alias),
 	},
+	client.CapGVR: {
+		DAO:      new(dao.Capacity),
+		Renderer: new(render.Capacity),
+	},
+	client.CpoGVR: {
+		DAO:      new(dao.CapacityPod),
+		Renderer: new(render.CapacityPod),
+	},
+	client.TlGVR: {
+		DAO:      new(dao.Timeline),
+		Renderer: new(render.Timeline),
+	},
+	client.CrtGVR: {
+		DAO:      new(dao.Cert),
+		Renderer: new(render.Cert),
+	},
+	client.DkGVR: {
+		DAO:      new(dao.DataKey),
+		Renderer: new(render.DataKey),
+	},
+	client.EvaGVR: {
+		DAO:      new(dao.EventArchive),
+		Renderer: new(render.EventArchive),
+	},
+	client.SesGVR: {
+		DAO:      new(dao.Session),
+		Renderer: new(render.Session),
+	},
+	client.SseGVR: {
+		DAO:      new(dao.SessionEntry),
+		Renderer: new(render.SessionEntry),
+	},
+	client.PinGVR: {
+		DAO:      new(dao.Pin),
+		Renderer: new(render.Pin),
+	},
+	client.AlGVR: {
+		DAO:      new(dao.Alert),
+		Renderer: new(render.Alert),
+	},
 
 	// Discovery...
 	client.EpsGVR: {
@@ -132,6 +185,13 @@ var Registry = map[*client.GVR]ResourceMeta{
 		DAO:      new(dao.Table),
 		Renderer: new(render.Event),
 	},
+	client.RqGVR: {
+		DAO:      new(dao.ResourceQuota),
+		Renderer: new(render.ResourceQuota),
+	},
+	client.LrGVR: {
+		Renderer: new(render.LimitRange),
+	},
 
 	// Apps...
 	client.DpGVR: {
@@ -17,6 +17,7 @@ import (
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/slogs"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -246,13 +247,30 @@ func (t *Table) refresh(ctx context.Context) error {
 	return nil
 }
 
-func (t *Table) list(ctx context.Context, a dao.Accessor) ([]runtime.Object, error) {
+func (t *Table) initAccessor(ctx context.Context, a dao.Accessor) error {
 	factory, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
 	if !ok {
-		return nil, fmt.Errorf("expected Factory in context but got %T", ctx.Value(internal.KeyFactory))
+		return fmt.Errorf("expected Factory in context but got %T", ctx.Value(internal.KeyFactory))
 	}
 	a.Init(factory, t.gvr)
 
+	return nil
+}
+
+func (t *Table) get(ctx context.Context, a dao.Accessor, path string) ([]runtime.Object, error) {
+	if err := t.initAccessor(ctx, a); err != nil {
+		return nil, err
+	}
+	o, err := a.Get(ctx, path)
+
+	return []runtime.Object{o}, err
+}
+
+func (t *Table) list(ctx context.Context, a dao.Accessor) ([]runtime.Object, error) {
+	if err := t.initAccessor(ctx, a); err != nil {
+		return nil, err
+	}
+
 	t.mx.RLock()
 	ctx = context.WithValue(ctx, internal.KeyLabels, t.labelSelector)
 	t.mx.RUnlock()
@@ -270,26 +288,51 @@ func (t *Table) reconcile(ctx context.Context) error {
 		oo  []runtime.Object
 		err error
 	)
-	meta := resourceMeta(t.gvr)
-	if t.vs != nil {
+	meta := t.resourceMeta()
+	var condCols []string
+	if f, ok := ctx.Value(internal.KeyFactory).(dao.Factory); ok {
+		condCols = dao.ConditionCols(f, t.gvr)
+	}
+	if !t.vs.IsBlank() || t.vs.IsServerPrinted() || t.vs.HasRules() || len(condCols) > 0 {
 		meta.DAO.SetIncludeObject(true)
 	}
 	ctx = context.WithValue(ctx, internal.KeyLabels, t.labelSelector)
 	if t.instance == "" {
 		oo, err = t.list(ctx, meta.DAO)
 	} else {
-		o, e := t.Get(ctx, t.instance)
-		oo, err = []runtime.Object{o}, e
+		oo, err = t.get(ctx, meta.DAO, t.instance)
 	}
 	if err != nil {
 		return err
 	}
 	r := meta.Renderer
 	r.SetViewSetting(t.vs)
+	if c, ok := r.(render.ConditionsColumner); ok {
+		c.SetConditionCols(condCols)
+	}
+	if l, ok := r.(render.Lookuper); ok {
+		if f, ok := ctx.Value(internal.KeyFactory).(dao.Factory); ok {
+			l.SetLookup(newLookup(f))
+		}
+	}
 
 	return t.data.Render(ctx, meta.Renderer, oo)
 }
 
+// resourceMeta returns the resource meta, swapping k9s renderers for the api server
+// table printers when requested. Actions still go thru the resource registered dao.
+func (t *Table) resourceMeta() ResourceMeta {
+	meta := resourceMeta(t.gvr)
+	if !t.vs.IsServerPrinted() || !t.gvr.IsK8sRes() || meta.Renderer.IsGeneric() {
+		return meta
+	}
+
+	return ResourceMeta{
+		DAO:      new(dao.Table),
+		Renderer: new(render.Table),
+	}
+}
+
 func (t *Table) fireTableChanged(data *model1.TableData) {
 	var ll []TableListener
 	t.mx.RLock()
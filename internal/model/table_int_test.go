@@ -12,6 +12,7 @@ import (
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model1"
 	"github.com/derailed/k9s/internal/render"
@@ -94,6 +95,65 @@ func TestTableMeta(t *testing.T) {
 	}
 }
 
+func TestTableServerPrinted(t *testing.T) {
+	uu := map[string]struct {
+		gvr      *client.GVR
+		vs       *config.ViewSetting
+		accessor dao.Accessor
+		renderer model1.Renderer
+	}{
+		"k9s": {
+			gvr:      client.NodeGVR,
+			vs:       &config.ViewSetting{Printer: config.PrinterK9s},
+			accessor: &dao.Node{},
+			renderer: &render.Node{},
+		},
+		"server": {
+			gvr:      client.NodeGVR,
+			vs:       &config.ViewSetting{Printer: config.PrinterServer},
+			accessor: &dao.Table{},
+			renderer: &render.Table{},
+		},
+		"k9s-res": {
+			gvr:      client.CtGVR,
+			vs:       &config.ViewSetting{Printer: config.PrinterServer},
+			accessor: &dao.Context{},
+			renderer: &render.Context{},
+		},
+		"generic": {
+			gvr:      client.NewGVR("fred.io/v1/blees"),
+			vs:       &config.ViewSetting{Printer: config.PrinterK9s},
+			accessor: &dao.Table{},
+			renderer: &render.Table{},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ta := NewTable(u.gvr)
+			ta.SetViewSetting(context.Background(), u.vs)
+			m := ta.resourceMeta()
+
+			assert.Equal(t, u.accessor, m.DAO)
+			assert.Equal(t, u.renderer, m.Renderer)
+		})
+	}
+}
+
+func TestTableLookup(t *testing.T) {
+	po := load(t, "p1")
+
+	l := newLookup(testFactory{rows: []runtime.Object{po}})
+	m, ok := l("v1/pods", "default/p1")
+	assert.True(t, ok)
+	assert.Equal(t, po.Object, m)
+
+	l = newLookup(makeFactory())
+	_, ok = l("v1/pods", "default/p1")
+	assert.False(t, ok)
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
@@ -42,6 +42,7 @@ type Tree struct {
 	inUpdate    int32
 	refreshRate time.Duration
 	query       string
+	instance    string
 }
 
 // NewTree returns a new model.
@@ -57,6 +58,11 @@ func (t *Tree) ClearFilter() {
 	t.query = ""
 }
 
+// SetInstance roots the tree at a given resource instance.
+func (t *Tree) SetInstance(path string) {
+	t.instance = path
+}
+
 // SetFilter sets the current filter.
 func (t *Tree) SetFilter(q string) {
 	t.query = q
@@ -224,6 +230,9 @@ func (t *Tree) reconcile(ctx context.Context) error {
 		return err
 	}
 
+	if t.instance != "" {
+		root = t.rootAt(root)
+	}
 	root.Sort()
 	if t.query != "" {
 		t.root = root.Filter(t.query, rxMatch)
@@ -236,6 +245,25 @@ func (t *Tree) reconcile(ctx context.Context) error {
 	return nil
 }
 
+// rootAt prunes the tree to only include the selected instance and its namespace.
+func (t *Tree) rootAt(root *xray.TreeNode) *xray.TreeNode {
+	n := root.Find(t.gvr, t.instance)
+	if n == nil {
+		return xray.NewTreeNode(t.gvr, t.gvr.R())
+	}
+
+	r := xray.NewTreeNode(t.gvr, t.gvr.R())
+	if p := n.Parent; p != nil && p != root {
+		nsn := p.ShallowClone()
+		nsn.Add(n)
+		r.Add(nsn)
+		return r
+	}
+	r.Add(n)
+
+	return r
+}
+
 func (t *Tree) resourceMeta() ResourceMeta {
 	meta, ok := Registry[t.gvr]
 	if !ok {
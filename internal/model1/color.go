@@ -3,7 +3,12 @@
 
 package model1
 
-import "github.com/derailed/tcell/v2"
+import (
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/tcell/v2"
+)
 
 var (
 	// ModColor row modified color.
@@ -49,3 +54,35 @@ func DefaultColorer(ns string, h Header, re *RowEvent) tcell.Color {
 		return StdColor
 	}
 }
+
+// SeverityCol tracks the hidden column holding custom row rules results.
+const SeverityCol = "SEVERITY"
+
+// RuleColorer colors rows matched by custom row rules, deferring to the
+// given colorer otherwise.
+func RuleColorer(c ColorerFunc) ColorerFunc {
+	return func(ns string, h Header, re *RowEvent) tcell.Color {
+		idx, ok := h.IndexOf(SeverityCol, true)
+		if !ok || idx >= len(re.Row.Fields) || re.Row.Fields[idx] == "" || re.Kind == EventDelete {
+			return c(ns, h, re)
+		}
+		sev, color, _ := strings.Cut(re.Row.Fields[idx], ":")
+		if color != "" {
+			if cc := tcell.GetColor(color); cc != tcell.ColorDefault {
+				return cc
+			}
+		}
+		switch sev {
+		case config.SeverityError:
+			return ErrColor
+		case config.SeverityWarn:
+			return PendingColor
+		case config.SeverityCompleted:
+			return CompletedColor
+		case config.SeverityOK:
+			return StdColor
+		default:
+			return c(ns, h, re)
+		}
+	}
+}
@@ -64,3 +64,66 @@ func TestDefaultColorer(t *testing.T) {
 		})
 	}
 }
+
+func TestRuleColorer(t *testing.T) {
+	model1.ErrColor, model1.PendingColor = tcell.ColorRed, tcell.ColorOrange
+	model1.CompletedColor, model1.StdColor = tcell.ColorGray, tcell.ColorWhite
+	model1.AddColor = tcell.ColorBlue
+
+	uu := map[string]struct {
+		sev string
+		k   model1.ResEvent
+		e   tcell.Color
+	}{
+		"none": {
+			k: model1.EventAdd,
+			e: model1.AddColor,
+		},
+		"error": {
+			sev: "error",
+			e:   model1.ErrColor,
+		},
+		"warn": {
+			sev: "warn",
+			e:   model1.PendingColor,
+		},
+		"completed": {
+			sev: "completed",
+			e:   model1.CompletedColor,
+		},
+		"ok": {
+			sev: "ok",
+			k:   model1.EventAdd,
+			e:   model1.StdColor,
+		},
+		"color": {
+			sev: "warn:fuchsia",
+			e:   tcell.ColorFuchsia,
+		},
+		"color-only": {
+			sev: ":#ff0000",
+			e:   tcell.GetColor("#ff0000"),
+		},
+		"bad-color": {
+			sev: "error:zorg",
+			e:   model1.ErrColor,
+		},
+		"deleted": {
+			sev: "error",
+			k:   model1.EventDelete,
+			e:   model1.KillColor,
+		},
+	}
+
+	h := model1.Header{
+		model1.HeaderColumn{Name: "A"},
+		model1.HeaderColumn{Name: model1.SeverityCol, Attrs: model1.Attrs{Hide: true}},
+	}
+	c := model1.RuleColorer(model1.DefaultColorer)
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			re := model1.RowEvent{Kind: u.k, Row: model1.Row{Fields: model1.Fields{"a", u.sev}}}
+			assert.Equal(t, u.e, c("", h, &re))
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 const poolSize = 10
 
 func Hydrate(ns string, oo []runtime.Object, rr Rows, re Renderer) error {
+	apply := rulesFor(ns, re)
 	pool := NewWorkerPool(context.Background(), poolSize)
 	for i, o := range oo {
 		pool.Add(func(ctx context.Context) error {
@@ -28,7 +29,11 @@ func Hydrate(ns string, oo []runtime.Object, rr Rows, re Renderer) error {
 				slog.Debug("Worker canceled")
 				return nil
 			default:
-				return re.Render(o, ns, &rr[i])
+				if err := re.Render(o, ns, &rr[i]); err != nil {
+					return err
+				}
+				apply(o, &rr[i])
+				return nil
 			}
 		})
 	}
@@ -46,6 +51,7 @@ func GenericHydrate(ns string, table *metav1.Table, rr Rows, re Renderer) error
 		return fmt.Errorf("expecting generic renderer but got %T", re)
 	}
 	gr.SetTable(ns, table)
+	apply := rulesFor(ns, re)
 	pool := NewWorkerPool(context.Background(), poolSize)
 	for i, row := range table.Rows {
 		pool.Add(func(ctx context.Context) error {
@@ -54,7 +60,11 @@ func GenericHydrate(ns string, table *metav1.Table, rr Rows, re Renderer) error
 				slog.Debug("Worker canceled")
 				return nil
 			default:
-				return gr.Render(row, ns, &rr[i])
+				if err := gr.Render(row, ns, &rr[i]); err != nil {
+					return err
+				}
+				apply(row, &rr[i])
+				return nil
 			}
 		})
 	}
@@ -66,6 +76,19 @@ func GenericHydrate(ns string, table *metav1.Table, rr Rows, re Renderer) error
 	return nil
 }
 
+// rulesFor returns a row annotator if the renderer defines custom row rules.
+func rulesFor(ns string, re Renderer) func(any, *Row) {
+	rl, ok := re.(RowRuler)
+	if !ok || !rl.HasRules() {
+		return func(any, *Row) {}
+	}
+	h := re.Header(ns)
+
+	return func(o any, row *Row) {
+		rl.ApplyRules(o, h, row)
+	}
+}
+
 // IsValid returns true if resource is valid, false otherwise.
 func IsValid(_ string, h Header, r Row) bool {
 	if len(r.Fields) == 0 {
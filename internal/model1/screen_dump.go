@@ -0,0 +1,354 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model1
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"html"
+	"io"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	mdMetaPrefix = "- "
+	dumpMetaExt  = ".meta"
+)
+
+var (
+	htmlMetaRx = regexp.MustCompile(`<dt>(.*?)</dt><dd>(.*?)</dd>`)
+	htmlRowRx  = regexp.MustCompile(`^<tr>(.*)</tr>$`)
+	htmlCellRx = regexp.MustCompile(`<t[hd]>(.*?)</t[hd]>`)
+)
+
+// DumpMeta represents screen dump metadata.
+type DumpMeta struct {
+	Resource  string `json:"resource" yaml:"resource"`
+	Context   string `json:"context" yaml:"context"`
+	Namespace string `json:"namespace" yaml:"namespace"`
+	Filter    string `json:"filter,omitempty" yaml:"filter,omitempty"`
+	Timestamp string `json:"timestamp" yaml:"timestamp"`
+}
+
+func (m DumpMeta) pairs() [][2]string {
+	return [][2]string{
+		{"resource", m.Resource},
+		{"context", m.Context},
+		{"namespace", m.Namespace},
+		{"filter", m.Filter},
+		{"timestamp", m.Timestamp},
+	}
+}
+
+func (m *DumpMeta) set(k, v string) {
+	switch strings.TrimSpace(k) {
+	case "resource":
+		m.Resource = v
+	case "context":
+		m.Context = v
+	case "namespace":
+		m.Namespace = v
+	case "filter":
+		m.Filter = v
+	case "timestamp":
+		m.Timestamp = v
+	}
+}
+
+// ScreenDump represents a table snapshot.
+type ScreenDump struct {
+	Meta   DumpMeta   `json:"meta" yaml:"meta"`
+	Header []string   `json:"header" yaml:"header"`
+	Rows   [][]string `json:"rows" yaml:"rows"`
+}
+
+// NewScreenDump returns a new table snapshot.
+func NewScreenDump(meta DumpMeta, data *TableData) *ScreenDump {
+	d := ScreenDump{
+		Meta:   meta,
+		Header: data.ColumnNames(true),
+	}
+	data.RowsRange(func(_ int, re RowEvent) bool {
+		d.Rows = append(d.Rows, re.Row.Fields)
+		return true
+	})
+
+	return &d
+}
+
+// DumpMetaPath returns the metadata sidecar path for formats that can't embed metadata.
+func DumpMetaPath(path string) string {
+	return path + dumpMetaExt
+}
+
+// IsDumpMeta checks if a path is a metadata sidecar.
+func IsDumpMeta(path string) bool {
+	return strings.HasSuffix(path, dumpMetaExt)
+}
+
+// HasInlineMeta checks if a dump format embeds its metadata.
+func HasInlineMeta(format string) bool {
+	return format != config.DumpCSV
+}
+
+// DumpFormatFor returns a screen dump format based on the file extension.
+func DumpFormatFor(path string) string {
+	f := strings.TrimPrefix(filepath.Ext(path), ".")
+	if f == "yml" {
+		f = config.DumpYAML
+	}
+	if !config.IsDumpFormat(f) {
+		return config.DumpCSV
+	}
+
+	return f
+}
+
+// Write encodes the dump in a given format.
+func (d *ScreenDump) Write(w io.Writer, format string) error {
+	switch format {
+	case config.DumpJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(d)
+	case config.DumpYAML:
+		enc := yaml.NewEncoder(w)
+		enc.SetIndent(2)
+		if err := enc.Encode(d); err != nil {
+			return err
+		}
+		return enc.Close()
+	case config.DumpMarkdown:
+		return d.writeMarkdown(w)
+	case config.DumpHTML:
+		return d.writeHTML(w)
+	default:
+		return d.writeCSV(w)
+	}
+}
+
+// WriteMeta encodes the dump metadata as a sidecar.
+func (d *ScreenDump) WriteMeta(w io.Writer) error {
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(d.Meta); err != nil {
+		return err
+	}
+
+	return enc.Close()
+}
+
+// ReadMeta decodes the dump metadata from a sidecar.
+func (d *ScreenDump) ReadMeta(r io.Reader) error {
+	return yaml.NewDecoder(r).Decode(&d.Meta)
+}
+
+func (d *ScreenDump) writeCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	_ = cw.Write(d.Header)
+	for _, r := range d.Rows {
+		_ = cw.Write(r)
+	}
+	cw.Flush()
+
+	return cw.Error()
+}
+
+func (d *ScreenDump) writeMarkdown(w io.Writer) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n\n", d.Meta.Resource)
+	for _, p := range d.Meta.pairs() {
+		fmt.Fprintf(&b, "%s%s: %s\n", mdMetaPrefix, p[0], p[1])
+	}
+	b.WriteString("\n")
+	b.WriteString(mdRow(d.Header))
+	seps := make([]string, len(d.Header))
+	for i := range seps {
+		seps[i] = "---"
+	}
+	b.WriteString("| " + strings.Join(seps, " | ") + " |\n")
+	for _, r := range d.Rows {
+		b.WriteString(mdRow(r))
+	}
+	_, err := io.WriteString(w, b.String())
+
+	return err
+}
+
+func mdRow(ff []string) string {
+	cc := make([]string, 0, len(ff))
+	for _, f := range ff {
+		cc = append(cc, strings.ReplaceAll(f, "|", `\|`))
+	}
+
+	return "| " + strings.Join(cc, " | ") + " |\n"
+}
+
+func (d *ScreenDump) writeHTML(w io.Writer) error {
+	var b strings.Builder
+	b.WriteString("<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n")
+	fmt.Fprintf(&b, "<title>%s</title>\n", html.EscapeString(d.Meta.Resource))
+	b.WriteString("</head>\n<body>\n<dl>\n")
+	for _, p := range d.Meta.pairs() {
+		fmt.Fprintf(&b, "<dt>%s</dt><dd>%s</dd>\n", p[0], html.EscapeString(p[1]))
+	}
+	b.WriteString("</dl>\n<table>\n<thead>\n")
+	b.WriteString(htmlRow("th", d.Header))
+	b.WriteString("</thead>\n<tbody>\n")
+	for _, r := range d.Rows {
+		b.WriteString(htmlRow("td", r))
+	}
+	b.WriteString("</tbody>\n</table>\n</body>\n</html>\n")
+	_, err := io.WriteString(w, b.String())
+
+	return err
+}
+
+func htmlRow(tag string, ff []string) string {
+	var b strings.Builder
+	b.WriteString("<tr>")
+	for _, f := range ff {
+		fmt.Fprintf(&b, "<%s>%s</%s>", tag, html.EscapeString(f), tag)
+	}
+	b.WriteString("</tr>\n")
+
+	return b.String()
+}
+
+// ReadScreenDump decodes a screen dump in a given format.
+func ReadScreenDump(r io.Reader, format string) (*ScreenDump, error) {
+	bb, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var d ScreenDump
+	switch format {
+	case config.DumpJSON:
+		err = json.Unmarshal(bb, &d)
+	case config.DumpYAML:
+		err = yaml.Unmarshal(bb, &d)
+	case config.DumpMarkdown:
+		err = d.readMarkdown(bb)
+	case config.DumpHTML:
+		err = d.readHTML(bb)
+	default:
+		err = d.readCSV(bb)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+func (d *ScreenDump) readCSV(bb []byte) error {
+	cr := csv.NewReader(bytes.NewReader(bb))
+	cr.FieldsPerRecord = -1
+	rr, err := cr.ReadAll()
+	if err != nil {
+		return err
+	}
+	if len(rr) == 0 {
+		return nil
+	}
+	d.Header, d.Rows = rr[0], rr[1:]
+
+	return nil
+}
+
+func (d *ScreenDump) readMarkdown(bb []byte) error {
+	var rows [][]string
+	scanner := bufio.NewScanner(bytes.NewReader(bb))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case strings.HasPrefix(line, mdMetaPrefix) && len(rows) == 0:
+			if k, v, ok := strings.Cut(strings.TrimPrefix(line, mdMetaPrefix), ": "); ok {
+				d.Meta.set(k, v)
+			}
+		case strings.HasPrefix(line, "|"):
+			cells := splitMDRow(line)
+			if isMDSeparator(cells) {
+				continue
+			}
+			rows = append(rows, cells)
+		}
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+	d.Header, d.Rows = rows[0], rows[1:]
+
+	return scanner.Err()
+}
+
+func splitMDRow(line string) []string {
+	line = strings.TrimSuffix(strings.TrimPrefix(line, "|"), "|")
+
+	var (
+		cc   []string
+		cell strings.Builder
+	)
+	for i := 0; i < len(line); i++ {
+		switch {
+		case line[i] == '\\' && i+1 < len(line) && line[i+1] == '|':
+			cell.WriteByte('|')
+			i++
+		case line[i] == '|':
+			cc = append(cc, strings.TrimSpace(cell.String()))
+			cell.Reset()
+		default:
+			cell.WriteByte(line[i])
+		}
+	}
+
+	return append(cc, strings.TrimSpace(cell.String()))
+}
+
+func isMDSeparator(cc []string) bool {
+	for _, c := range cc {
+		if strings.Trim(c, "-:") != "" || c == "" {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (d *ScreenDump) readHTML(bb []byte) error {
+	var rows [][]string
+	scanner := bufio.NewScanner(bytes.NewReader(bb))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if m := htmlMetaRx.FindStringSubmatch(line); m != nil {
+			d.Meta.set(m[1], html.UnescapeString(m[2]))
+			continue
+		}
+		m := htmlRowRx.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		mm := htmlCellRx.FindAllStringSubmatch(m[1], -1)
+		cells := make([]string, 0, len(mm))
+		for _, c := range mm {
+			cells = append(cells, html.UnescapeString(c[1]))
+		}
+		rows = append(rows, cells)
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+	d.Header, d.Rows = rows[0], rows[1:]
+
+	return scanner.Err()
+}
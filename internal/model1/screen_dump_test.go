@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model1
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScreenDumpRoundTrip(t *testing.T) {
+	data := NewTableDataWithRows(
+		client.NewGVR("test"),
+		Header{
+			HeaderColumn{Name: "NAME"},
+			HeaderColumn{Name: "STATUS"},
+			HeaderColumn{Name: "LABELS"},
+		},
+		NewRowEventsWithEvts(
+			RowEvent{Row: Row{ID: "a", Fields: Fields{"a", "Running", "app=a|b"}}},
+			RowEvent{Row: Row{ID: "b", Fields: Fields{"b", "Error", `<x & "y">`}}},
+			RowEvent{Row: Row{ID: "c", Fields: Fields{"c", "", "x,y"}}},
+		),
+	)
+	meta := DumpMeta{
+		Resource:  "pods",
+		Context:   "ct-1",
+		Namespace: "default",
+		Filter:    "-l app=a",
+		Timestamp: "2026-10-19T10:00:00Z",
+	}
+	d := NewScreenDump(meta, data)
+
+	for _, f := range config.DumpFormats {
+		t.Run(f, func(t *testing.T) {
+			var b bytes.Buffer
+			require.NoError(t, d.Write(&b, f))
+
+			d1, err := ReadScreenDump(&b, f)
+			require.NoError(t, err)
+			if !HasInlineMeta(f) {
+				var m bytes.Buffer
+				require.NoError(t, d.WriteMeta(&m))
+				require.NoError(t, d1.ReadMeta(&m))
+			}
+			assert.Equal(t, d, d1)
+		})
+	}
+}
+
+func TestScreenDumpReadCSVNoMeta(t *testing.T) {
+	d, err := ReadScreenDump(bytes.NewBufferString("NAME,STATUS\na,Running\n"), config.DumpCSV)
+
+	require.NoError(t, err)
+	assert.Equal(t, DumpMeta{}, d.Meta)
+	assert.Equal(t, []string{"NAME", "STATUS"}, d.Header)
+	assert.Equal(t, [][]string{{"a", "Running"}}, d.Rows)
+}
+
+func TestScreenDumpCSVPlain(t *testing.T) {
+	d := ScreenDump{
+		Meta:   DumpMeta{Resource: "pods"},
+		Header: []string{"NAME", "STATUS"},
+		Rows:   [][]string{{"#a", "Running"}},
+	}
+	var b bytes.Buffer
+	require.NoError(t, d.Write(&b, config.DumpCSV))
+	assert.Equal(t, "NAME,STATUS\n#a,Running\n", b.String())
+
+	d1, err := ReadScreenDump(&b, config.DumpCSV)
+	require.NoError(t, err)
+	assert.Equal(t, d.Rows, d1.Rows)
+}
+
+func TestDumpFormatFor(t *testing.T) {
+	uu := map[string]struct {
+		path, e string
+	}{
+		"csv":      {path: "/a/pods-123.csv", e: config.DumpCSV},
+		"json":     {path: "/a/pods-123.json", e: config.DumpJSON},
+		"yml":      {path: "/a/pods-123.yml", e: config.DumpYAML},
+		"md":       {path: "/a/pods-123.md", e: config.DumpMarkdown},
+		"html":     {path: "/a/pods-123.html", e: config.DumpHTML},
+		"unknown":  {path: "/a/pods-123.txt", e: config.DumpCSV},
+		"noExtent": {path: "/a/pods", e: config.DumpCSV},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, DumpFormatFor(u.path))
+		})
+	}
+}
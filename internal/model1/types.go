@@ -57,6 +57,15 @@ type Renderer interface {
 	Healthy(ctx context.Context, o any) error
 }
 
+// RowRuler represents a renderer that applies custom row rules.
+type RowRuler interface {
+	// HasRules checks if custom row rules are defined.
+	HasRules() bool
+
+	// ApplyRules annotates a rendered row based on custom row rules.
+	ApplyRules(o any, h Header, row *Row)
+}
+
 // Generic represents a generic resource.
 type Generic interface {
 	// SetTable sets up the resource tabular definition.
@@ -10,6 +10,7 @@ import (
 	"io"
 	"log/slog"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strings"
@@ -19,12 +20,13 @@ import (
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/slogs"
 	"github.com/rakyll/hey/requester"
 )
 
 const (
-	// BOZO!! Revisit bench and when we should timeout.
+	// benchTimeout bounds each benchmark step.
 	benchTimeout = 2 * time.Minute
 	benchFmat    = "%s_%s_%d.txt"
 	k9sUA        = "k9s/"
@@ -34,7 +36,9 @@ const (
 type Benchmark struct {
 	canceled bool
 	config   *config.BenchConfig
-	worker   *requester.Work
+	steps    []config.BenchStep
+	workers  []*requester.Work
+	ctx      context.Context
 	cancelFn context.CancelFunc
 	mx       sync.RWMutex
 }
@@ -49,37 +53,46 @@ func NewBenchmark(base, version string, cfg *config.BenchConfig) (*Benchmark, er
 }
 
 func (b *Benchmark) init(base, version string) error {
-	var ctx context.Context
-	ctx, b.cancelFn = context.WithTimeout(context.Background(), benchTimeout)
-	req, err := http.NewRequestWithContext(ctx, b.config.HTTP.Method, base, http.NoBody)
-	if err != nil {
-		return err
-	}
-	if b.config.Auth.User != "" || b.config.Auth.Password != "" {
-		req.SetBasicAuth(b.config.Auth.User, b.config.Auth.Password)
-	}
-	req.Header = b.config.HTTP.Headers
-	slog.Debug("Benchmarking Request", slogs.URL, req.URL.String())
-
-	ua := req.UserAgent()
-	if ua == "" {
-		ua = k9sUA
-	} else {
-		ua += " " + k9sUA
-	}
-	ua += version
-	if req.Header == nil {
-		req.Header = make(http.Header)
-	}
-	req.Header.Set("User-Agent", ua)
-
-	slog.Debug(fmt.Sprintf("Using bench config N:%d--C:%d", b.config.N, b.config.C))
-	b.worker = &requester.Work{
-		Request:     req,
-		RequestBody: []byte(b.config.HTTP.Body),
-		N:           b.config.N,
-		C:           b.config.C,
-		H2:          b.config.HTTP.HTTP2,
+	b.ctx, b.cancelFn = context.WithCancel(context.Background())
+
+	b.steps = b.config.Steps()
+	nn := b.config.Distribute(b.steps)
+	b.workers = make([]*requester.Work, 0, len(b.steps))
+	for i, s := range b.steps {
+		u, err := stepURL(base, s, b.config.IsScenario())
+		if err != nil {
+			return err
+		}
+		req, err := http.NewRequestWithContext(b.ctx, s.Method, u, http.NoBody)
+		if err != nil {
+			return err
+		}
+		if b.config.Auth.User != "" || b.config.Auth.Password != "" {
+			req.SetBasicAuth(b.config.Auth.User, b.config.Auth.Password)
+		}
+		req.Header = s.Headers.Clone()
+		slog.Debug("Benchmarking Request", slogs.URL, req.URL.String())
+
+		ua := req.UserAgent()
+		if ua == "" {
+			ua = k9sUA
+		} else {
+			ua += " " + k9sUA
+		}
+		ua += version
+		if req.Header == nil {
+			req.Header = make(http.Header)
+		}
+		req.Header.Set("User-Agent", ua)
+
+		slog.Debug(fmt.Sprintf("Using bench config N:%d--C:%d", nn[i], b.config.C))
+		b.workers = append(b.workers, &requester.Work{
+			Request:     req,
+			RequestBody: []byte(s.Body),
+			N:           nn[i],
+			C:           b.config.C,
+			H2:          b.config.HTTP.HTTP2,
+		})
 	}
 
 	return nil
@@ -102,20 +115,31 @@ func (b *Benchmark) Cancel() {
 
 // Canceled checks if the benchmark was canceled.
 func (b *Benchmark) Canceled() bool {
+	b.mx.RLock()
+	defer b.mx.RUnlock()
+
 	return b.canceled
 }
 
-// Run starts a benchmark.
+// Run starts a benchmark. Scenario steps are run sequentially and each step
+// results are prefixed by a step marker in the report.
 func (b *Benchmark) Run(cluster, ct string, done func()) {
 	slog.Debug("Running benchmark",
 		slogs.Cluster, cluster,
 		slogs.Context, ct,
 	)
 	buff := new(bytes.Buffer)
-	b.worker.Writer = buff
-	// this call will block until the benchmark is complete or times out.
-	b.worker.Run()
-	b.worker.Stop()
+	for i, w := range b.workers {
+		if b.Canceled() {
+			break
+		}
+		if b.config.IsScenario() {
+			s := b.steps[i]
+			fmt.Fprintf(buff, "%s %s %s %s\n", render.BenchStepMarker, s.Name, s.Method, w.Request.URL.Path)
+		}
+		w.Writer = buff
+		b.runStep(w)
+	}
 	if buff.Len() > 0 {
 		if err := b.save(cluster, ct, buff); err != nil {
 			slog.Error("Saving Benchmark", slogs.Error, err)
@@ -124,6 +148,27 @@ func (b *Benchmark) Run(cluster, ct string, done func()) {
 	done()
 }
 
+// runStep runs a single step. The step workers are stopped once the step times
+// out or the benchmark is canceled.
+func (b *Benchmark) runStep(w *requester.Work) {
+	ctx, cancel := context.WithTimeout(b.ctx, benchTimeout)
+	defer cancel()
+
+	w.Init()
+	w.Request = w.Request.WithContext(ctx)
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			w.Stop()
+		case <-done:
+		}
+	}()
+	// this call will block until the step is complete or stopped.
+	w.Run()
+	close(done)
+}
+
 func (b *Benchmark) save(cluster, ct string, r io.Reader) error {
 	ns, n := client.Namespaced(b.config.Name)
 	n = strings.ReplaceAll(n, "|", "_")
@@ -155,3 +200,21 @@ func (b *Benchmark) save(cluster, ct string, r io.Reader) error {
 
 	return nil
 }
+
+// stepURL computes a scenario step url. Steps paths override the base url path.
+func stepURL(base string, s config.BenchStep, scenario bool) (string, error) {
+	if !scenario || s.Path == "" {
+		return base, nil
+	}
+	u, err := url.Parse(base)
+	if err != nil {
+		return "", err
+	}
+	p, err := url.Parse(s.Path)
+	if err != nil {
+		return "", err
+	}
+	u.Path, u.RawQuery = p.Path, p.RawQuery
+
+	return u.String(), nil
+}
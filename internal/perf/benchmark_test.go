@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package perf
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBenchmarkRunScenario(t *testing.T) {
+	var (
+		mx   sync.Mutex
+		hits = make(map[string]int)
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mx.Lock()
+		hits[r.Method+" "+r.URL.Path]++
+		mx.Unlock()
+		if r.URL.Path == "/boom" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	dir := config.AppBenchmarksDir
+	config.AppBenchmarksDir = t.TempDir()
+	defer func() { config.AppBenchmarksDir = dir }()
+
+	cfg := config.BenchConfig{
+		Name: "default/fred",
+		C:    2,
+		N:    40,
+		HTTP: config.HTTP{Method: http.MethodGet, Path: "/"},
+		Scenario: []config.BenchStep{
+			{Name: "home", Weight: 3},
+			{Name: "post", Method: http.MethodPost, Path: "/", Body: "blee"},
+			{Name: "boom", Path: "/boom"},
+		},
+	}
+	b, err := NewBenchmark(srv.URL+"/", "0.0.0", &cfg)
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+	b.Run("c1", "ct1", func() { close(done) })
+	<-done
+
+	assert.Equal(t, map[string]int{"GET /": 24, "POST /": 8, "GET /boom": 8}, hits)
+
+	dd, err := config.EnsureBenchmarksDir("c1", "ct1")
+	require.NoError(t, err)
+	ee, err := os.ReadDir(dd)
+	require.NoError(t, err)
+	require.Len(t, ee, 1)
+	bb, err := os.ReadFile(filepath.Join(dd, ee[0].Name()))
+	require.NoError(t, err)
+
+	r := render.ParseBenchReport(string(bb))
+	require.Len(t, r.Steps, 3)
+	assert.Equal(t, "home GET /", r.Steps[0].Name)
+	assert.Equal(t, "boom GET /boom", r.Steps[2].Name)
+	assert.False(t, r.Failed())
+	sum := r.Summary()
+	assert.Equal(t, 32, sum.CodesIn(2))
+	assert.Equal(t, 8, sum.CodesIn(5))
+	assert.Len(t, sum.Latencies, 7)
+}
+
+func TestBenchmarkCancel(t *testing.T) {
+	var (
+		b    *Benchmark
+		once sync.Once
+		hits atomic.Int32
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if hits.Add(1) == 5 {
+			once.Do(b.Cancel)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	dir := config.AppBenchmarksDir
+	config.AppBenchmarksDir = t.TempDir()
+	defer func() { config.AppBenchmarksDir = dir }()
+
+	cfg := config.BenchConfig{
+		Name: "default/fred",
+		C:    1,
+		N:    200,
+		HTTP: config.HTTP{Method: http.MethodGet, Path: "/"},
+	}
+	var err error
+	b, err = NewBenchmark(srv.URL+"/", "0.0.0", &cfg)
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+	b.Run("c1", "ct1", func() { close(done) })
+	<-done
+
+	assert.True(t, b.Canceled())
+	dd, err := config.EnsureBenchmarksDir("c1", "ct1")
+	require.NoError(t, err)
+	ee, err := os.ReadDir(dd)
+	require.NoError(t, err)
+	require.Len(t, ee, 1)
+	bb, err := os.ReadFile(filepath.Join(dd, ee[0].Name()))
+	require.NoError(t, err)
+
+	sum := render.ParseBenchReport(string(bb)).Summary()
+	assert.Less(t, sum.Requests(), 10)
+}
+
+func Test_stepURL(t *testing.T) {
+	uu := map[string]struct {
+		base     string
+		step     config.BenchStep
+		scenario bool
+		e        string
+	}{
+		"plain": {
+			base: "http://localhost:8080/fred",
+			step: config.BenchStep{Path: "/blee"},
+			e:    "http://localhost:8080/fred",
+		},
+		"scenario": {
+			base:     "http://localhost:8080/fred",
+			step:     config.BenchStep{Path: "/blee?a=1"},
+			scenario: true,
+			e:        "http://localhost:8080/blee?a=1",
+		},
+		"no-path": {
+			base:     "http://localhost:8080/fred",
+			scenario: true,
+			e:        "http://localhost:8080/fred",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s, err := stepURL(u.base, u.step, u.scenario)
+			require.NoError(t, err)
+			assert.Equal(t, u.e, s)
+		})
+	}
+}
@@ -0,0 +1,163 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+const (
+	// AlertFiring denotes an active alert.
+	AlertFiring = "Firing"
+
+	// AlertResolved denotes an alert whose condition no longer holds.
+	AlertResolved = "Resolved"
+)
+
+// Alert renders a resource alert to screen.
+type Alert struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (Alert) ColorerFunc() model1.ColorerFunc {
+	return func(ns string, h model1.Header, re *model1.RowEvent) tcell.Color {
+		c := model1.DefaultColorer(ns, h, re)
+		sidx, ok := h.IndexOf("STATE", true)
+		if !ok {
+			return c
+		}
+		if strings.TrimSpace(re.Row.Fields[sidx]) == AlertResolved {
+			return model1.CompletedColor
+		}
+		idx, ok := h.IndexOf("SEVERITY", true)
+		if !ok {
+			return c
+		}
+		switch strings.TrimSpace(re.Row.Fields[idx]) {
+		case "error":
+			return model1.ErrColor
+		case "warn":
+			return model1.PendingColor
+		default:
+			return c
+		}
+	}
+}
+
+// Header returns a header row.
+func (Alert) Header(string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "SEVERITY"},
+		model1.HeaderColumn{Name: "RULE"},
+		model1.HeaderColumn{Name: "RESOURCE"},
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "VALUE"},
+		model1.HeaderColumn{Name: "STATE"},
+		model1.HeaderColumn{Name: "DURATION"},
+		model1.HeaderColumn{Name: "CONTEXT", Attrs: model1.Attrs{Wide: true}},
+		model1.HeaderColumn{Name: "GVR", Attrs: model1.Attrs{Wide: true}},
+		model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true}},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Alert) Render(o any, _ string, r *model1.Row) error {
+	a, ok := o.(AlertRes)
+	if !ok {
+		return fmt.Errorf("expected AlertRes, but got %T", o)
+	}
+
+	r.ID = AlertID(a.GVR, a.Path, a.Rule, a.Fired)
+	r.Fields = model1.Fields{
+		a.Severity,
+		a.Rule,
+		client.NewGVR(a.GVR).R(),
+		a.Path,
+		a.Value,
+		a.State(),
+		a.Duration(time.Now()),
+		a.Context,
+		a.GVR,
+		ToAge(metav1.NewTime(a.Fired)),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// AlertID returns an alert identifier.
+func AlertID(gvr, path, rule string, fired time.Time) string {
+	return strings.Join([]string{gvr, path, rule, strconv.FormatInt(fired.UnixNano(), 10)}, " ")
+}
+
+// AlertFromID returns an alert gvr and resource path from its identifier.
+func AlertFromID(id string) (gvr, path string) {
+	tokens := strings.SplitN(id, " ", 4)
+	if len(tokens) < 2 {
+		return id, ""
+	}
+
+	return tokens[0], tokens[1]
+}
+
+// AlertRes represents a raised alert.
+type AlertRes struct {
+	Rule     string    `json:"rule"`
+	Severity string    `json:"severity"`
+	Context  string    `json:"context"`
+	GVR      string    `json:"gvr"`
+	Path     string    `json:"path"`
+	Value    string    `json:"value"`
+	Started  time.Time `json:"started"`
+	Fired    time.Time `json:"fired"`
+	Resolved time.Time `json:"resolved,omitzero"`
+}
+
+// IsResolved checks if the alert condition no longer holds.
+func (a AlertRes) IsResolved() bool {
+	return !a.Resolved.IsZero()
+}
+
+// State returns the alert state.
+func (a AlertRes) State() string {
+	if a.IsResolved() {
+		return AlertResolved
+	}
+
+	return AlertFiring
+}
+
+// Duration returns how long the alert condition has held.
+func (a AlertRes) Duration(now time.Time) string {
+	end := now
+	if a.IsResolved() {
+		end = a.Resolved
+	}
+
+	return duration.HumanDuration(end.Sub(a.Started))
+}
+
+// GetObjectKind returns a schema object.
+func (AlertRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (a AlertRes) DeepCopyObject() runtime.Object {
+	return a
+}
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlertRender(t *testing.T) {
+	now := time.Now()
+	uu := map[string]struct {
+		a  render.AlertRes
+		ee model1.Fields
+	}{
+		"firing": {
+			a: render.AlertRes{
+				Rule:     "crash",
+				Severity: "error",
+				Context:  "fred",
+				GVR:      "v1/pods",
+				Path:     "default/p1",
+				Value:    "CrashLoopBackOff",
+				Started:  now.Add(-2 * time.Hour),
+				Fired:    now.Add(-2 * time.Hour),
+			},
+			ee: model1.Fields{"error", "crash", "pods", "default/p1", "CrashLoopBackOff", render.AlertFiring, "120m", "fred", "v1/pods", "120m"},
+		},
+		"resolved": {
+			a: render.AlertRes{
+				Rule:     "unavailable",
+				Severity: "warn",
+				Context:  "fred",
+				GVR:      "apps/v1/deployments",
+				Path:     "default/nginx",
+				Value:    "0/1",
+				Started:  now.Add(-5 * time.Minute),
+				Fired:    now.Add(-3 * time.Minute),
+				Resolved: now.Add(-time.Minute),
+			},
+			ee: model1.Fields{"warn", "unavailable", "deployments", "default/nginx", "0/1", render.AlertResolved, "4m", "fred", "apps/v1/deployments", "3m"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r model1.Row
+			require.NoError(t, render.Alert{}.Render(u.a, "", &r))
+			assert.Equal(t, render.AlertID(u.a.GVR, u.a.Path, u.a.Rule, u.a.Fired), r.ID)
+			assert.Equal(t, u.ee, r.Fields)
+
+			gvr, path := render.AlertFromID(r.ID)
+			assert.Equal(t, u.a.GVR, gvr)
+			assert.Equal(t, u.a.Path, path)
+		})
+	}
+}
@@ -21,6 +21,8 @@ var AgeDecorator = toAgeHuman
 type Base struct {
 	vs         *config.ViewSetting
 	specs      ColumnSpecs
+	expr       *exprEvaluator
+	rules      RowRules
 	includeObj bool
 }
 
@@ -35,25 +37,64 @@ func (*Base) IsGeneric() bool {
 
 func (b *Base) doHeader(dh model1.Header) model1.Header {
 	if b.specs.isEmpty() {
-		return dh
+		return b.rules.Header(dh)
 	}
 
-	return b.specs.Header(dh)
+	return b.rules.Header(b.specs.Header(dh))
 }
 
 // SetViewSetting sets custom view settings if any.
 func (b *Base) SetViewSetting(vs *config.ViewSetting) {
-	var cols []string
+	var (
+		cols  []string
+		rules []config.RowRule
+	)
 	b.vs = vs
 	if vs != nil {
-		cols = vs.Columns
+		cols, rules = vs.Columns, vs.Rules
 	}
-	specs, err := NewColsSpecs(cols...).parseSpecs()
+	specs, err := NewColsSpecs(cols...).parseSpecs(b.expr)
 	if err != nil {
 		slog.Error("Unable to grok custom columns", slogs.Error, err)
 		return
 	}
+	for _, s := range specs {
+		if s.eval != nil {
+			b.expr = s.eval
+			break
+		}
+	}
 	b.specs = specs
+
+	rr, err := newRowRules(b.expr, rules...)
+	if err != nil {
+		slog.Error("Unable to grok custom row rules", slogs.Error, err)
+		rr = nil
+	}
+	for _, r := range rr {
+		if r.eval != nil {
+			b.expr = r.eval
+			break
+		}
+	}
+	b.rules = rr
+}
+
+// HasRules checks if custom row rules are defined.
+func (b *Base) HasRules() bool {
+	return len(b.rules) > 0
+}
+
+// ApplyRules annotates a rendered row based on custom row rules.
+func (b *Base) ApplyRules(o any, h model1.Header, row *model1.Row) {
+	b.rules.Apply(ruleObject(o), h, row)
+}
+
+// SetLookup sets the related resources lookup used by custom columns expressions.
+func (b *Base) SetLookup(f LookupFunc) {
+	if b.expr != nil {
+		b.expr.setLookup(f)
+	}
 }
 
 // ColorerFunc colors a resource row.
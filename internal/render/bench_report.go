@@ -0,0 +1,305 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"bufio"
+	"maps"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	// BenchStepMarker prefixes each scenario step results in a benchmark report.
+	BenchStepMarker = "### Step:"
+
+	benchHistogramBuckets = 11
+)
+
+var (
+	benchSummaryRx = regexp.MustCompile(`^\s*(Total|Slowest|Fastest|Average|Requests/sec):\s+([0-9.]+|NaN)`)
+	benchBucketRx  = regexp.MustCompile(`^\s*([0-9.]+)\s+\[(\d+)\]\s+\|`)
+	benchLatencyRx = regexp.MustCompile(`^\s*(\d+)%+\s+in\s+([0-9.]+)\s+secs`)
+	benchCodeRx    = regexp.MustCompile(`^\s*\[(\d{3})\]\s+(\d+)\s+responses`)
+	benchErrorRx   = regexp.MustCompile(`^\s*\[(\d+)\]\s+\S`)
+)
+
+// BenchPercentiles tracks the reported latency percentiles.
+var BenchPercentiles = []int{50, 90, 99}
+
+// BenchBucket represents a latency histogram bucket.
+type BenchBucket struct {
+	Mark  float64
+	Count int
+}
+
+// BenchStep represents a parsed benchmark step results.
+type BenchStep struct {
+	Name        string
+	Total       float64
+	Slowest     float64
+	Fastest     float64
+	Average     float64
+	RPS         float64
+	Latencies   map[int]float64
+	Histogram   []BenchBucket
+	StatusCodes map[int]int
+	Errors      int
+}
+
+func newBenchStep(name string) BenchStep {
+	return BenchStep{
+		Name:        name,
+		Latencies:   make(map[int]float64),
+		StatusCodes: make(map[int]int),
+	}
+}
+
+// Requests returns the total number of requests issued.
+func (s BenchStep) Requests() int {
+	n := s.Errors
+	for _, c := range s.StatusCodes {
+		n += c
+	}
+
+	return n
+}
+
+// CodesIn returns the number of responses within a given status class ie 2 for 2xx.
+func (s BenchStep) CodesIn(classes ...int) int {
+	var n int
+	for code, c := range s.StatusCodes {
+		for _, cl := range classes {
+			if code/100 == cl {
+				n += c
+			}
+		}
+	}
+
+	return n
+}
+
+// Failed checks if the step reported transport errors.
+func (s BenchStep) Failed() bool {
+	return s.Errors > 0
+}
+
+// BenchReport represents a parsed benchmark report.
+type BenchReport struct {
+	Steps []BenchStep
+}
+
+// ParseBenchReport parses a raw benchmark report.
+func ParseBenchReport(raw string) *BenchReport {
+	var (
+		r       BenchReport
+		current *BenchStep
+		inErrs  bool
+	)
+	scanner := bufio.NewScanner(strings.NewReader(raw))
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, BenchStepMarker):
+			r.Steps = append(r.Steps, newBenchStep(strings.TrimSpace(strings.TrimPrefix(line, BenchStepMarker))))
+			current, inErrs = &r.Steps[len(r.Steps)-1], false
+			continue
+		case strings.HasPrefix(line, "Summary:") && current == nil:
+			r.Steps = append(r.Steps, newBenchStep(""))
+			current = &r.Steps[len(r.Steps)-1]
+			continue
+		case current == nil:
+			continue
+		case strings.HasPrefix(line, "Error distribution:"):
+			inErrs = true
+			continue
+		}
+
+		if inErrs {
+			if m := benchErrorRx.FindStringSubmatch(line); m != nil {
+				n, _ := strconv.Atoi(m[1])
+				current.Errors += n
+			}
+			continue
+		}
+		if m := benchSummaryRx.FindStringSubmatch(line); m != nil {
+			v, _ := strconv.ParseFloat(m[2], 64)
+			switch m[1] {
+			case "Total":
+				current.Total = v
+			case "Slowest":
+				current.Slowest = v
+			case "Fastest":
+				current.Fastest = v
+			case "Average":
+				current.Average = v
+			case "Requests/sec":
+				current.RPS = v
+			}
+			continue
+		}
+		if m := benchBucketRx.FindStringSubmatch(line); m != nil {
+			mark, _ := strconv.ParseFloat(m[1], 64)
+			c, _ := strconv.Atoi(m[2])
+			current.Histogram = append(current.Histogram, BenchBucket{Mark: mark, Count: c})
+			continue
+		}
+		if m := benchLatencyRx.FindStringSubmatch(line); m != nil {
+			p, _ := strconv.Atoi(m[1])
+			current.Latencies[p], _ = strconv.ParseFloat(m[2], 64)
+			continue
+		}
+		if m := benchCodeRx.FindStringSubmatch(line); m != nil {
+			code, _ := strconv.Atoi(m[1])
+			c, _ := strconv.Atoi(m[2])
+			current.StatusCodes[code] += c
+		}
+	}
+
+	return &r
+}
+
+// Failed checks if any of the steps failed.
+func (r *BenchReport) Failed() bool {
+	for _, s := range r.Steps {
+		if s.Failed() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Summary aggregates all steps results. Latency percentiles are computed from the
+// steps merged latency distributions.
+func (r *BenchReport) Summary() BenchStep {
+	if len(r.Steps) == 1 {
+		return r.Steps[0]
+	}
+
+	sum := newBenchStep("")
+	var reqs int
+	for i, s := range r.Steps {
+		n := s.Requests()
+		reqs += n
+		sum.Total += s.Total
+		sum.Errors += s.Errors
+		sum.Average += s.Average * float64(n)
+		if i == 0 || s.Fastest < sum.Fastest {
+			sum.Fastest = s.Fastest
+		}
+		if s.Slowest > sum.Slowest {
+			sum.Slowest = s.Slowest
+		}
+		for code, c := range s.StatusCodes {
+			sum.StatusCodes[code] += c
+		}
+		for p := range s.Latencies {
+			sum.Latencies[p] = 0
+		}
+	}
+	if reqs > 0 {
+		sum.Average /= float64(reqs)
+		for p := range sum.Latencies {
+			sum.Latencies[p] = mergedPercentile(r.Steps, sum.Fastest, sum.Slowest, float64(p)/100)
+		}
+	}
+	if sum.Total > 0 {
+		sum.RPS = float64(reqs) / sum.Total
+	}
+	sum.Histogram = mergeBenchHistograms(r.Steps)
+
+	return sum
+}
+
+// mergedPercentile returns the latency under which the given fraction of all steps
+// requests fall.
+func mergedPercentile(ss []BenchStep, lo, hi, q float64) float64 {
+	var reqs float64
+	for _, s := range ss {
+		reqs += float64(s.Requests())
+	}
+	for range 64 {
+		mid := (lo + hi) / 2
+		var under float64
+		for _, s := range ss {
+			under += s.cdf(mid) * float64(s.Requests())
+		}
+		if under/reqs < q {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+
+	return hi
+}
+
+// cdf returns the fraction of the step requests completed within a given latency.
+// The distribution is interpolated between the reported percentiles.
+func (s BenchStep) cdf(l float64) float64 {
+	type point struct{ l, f float64 }
+
+	pp := make([]point, 0, len(s.Latencies)+2)
+	pp = append(pp, point{l: s.Fastest})
+	for _, p := range slices.Sorted(maps.Keys(s.Latencies)) {
+		pp = append(pp, point{l: s.Latencies[p], f: float64(p) / 100})
+	}
+	pp = append(pp, point{l: s.Slowest, f: 1})
+
+	if l < pp[0].l {
+		return 0
+	}
+	for i := 1; i < len(pp); i++ {
+		if l >= pp[i].l {
+			continue
+		}
+		from, to := pp[i-1], pp[i]
+		if to.l <= from.l {
+			return to.f
+		}
+		return from.f + (to.f-from.f)*(l-from.l)/(to.l-from.l)
+	}
+
+	return 1
+}
+
+// mergeBenchHistograms rebuckets all steps histograms over a common latency range.
+func mergeBenchHistograms(ss []BenchStep) []BenchBucket {
+	lo, hi, found := 0.0, 0.0, false
+	for _, s := range ss {
+		for _, b := range s.Histogram {
+			if !found || b.Mark < lo {
+				lo = b.Mark
+			}
+			if !found || b.Mark > hi {
+				hi = b.Mark
+			}
+			found = true
+		}
+	}
+	if !found {
+		return nil
+	}
+
+	bb := make([]BenchBucket, benchHistogramBuckets)
+	step := (hi - lo) / float64(benchHistogramBuckets-1)
+	for i := range bb {
+		bb[i].Mark = lo + step*float64(i)
+	}
+	for _, s := range ss {
+		for _, b := range s.Histogram {
+			i := 0
+			if step > 0 {
+				i = int(math.Round((b.Mark - lo) / step))
+			}
+			bb[i].Count += b.Count
+		}
+	}
+
+	return bb
+}
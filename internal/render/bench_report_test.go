@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBenchReport(t *testing.T) {
+	uu := map[string]struct {
+		file   string
+		steps  []string
+		failed bool
+		reqs   int
+		codes  map[int]int
+		lats   map[int]float64
+		hist   int
+	}{
+		"single": {
+			file:  "testdata/bench/single.txt",
+			steps: []string{""},
+			reqs:  100,
+			codes: map[int]int{200: 100},
+			lats:  map[int]float64{10: 0.0314, 25: 0.0317, 50: 0.032, 75: 0.0327, 90: 0.0369, 95: 0.0394, 99: 0.1031},
+			hist:  11,
+		},
+		"scenario": {
+			file:  "testdata/bench/scenario.txt",
+			steps: []string{"home GET /", "login POST /login"},
+			reqs:  150,
+			codes: map[int]int{200: 140, 500: 10},
+			lats:  map[int]float64{50: 0.033636363636, 90: 0.087799227799, 99: 0.147777777778},
+			hist:  11,
+		},
+		"toast": {
+			file:   "testdata/bench/toast.txt",
+			steps:  []string{""},
+			failed: true,
+			reqs:   84,
+			codes:  map[int]int{},
+			lats:   map[int]float64{},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			bb, err := os.ReadFile(u.file)
+			require.NoError(t, err)
+
+			r := ParseBenchReport(string(bb))
+			require.Len(t, r.Steps, len(u.steps))
+			for i, s := range r.Steps {
+				assert.Equal(t, u.steps[i], s.Name)
+			}
+			assert.Equal(t, u.failed, r.Failed())
+
+			sum := r.Summary()
+			assert.Equal(t, u.reqs, sum.Requests())
+			assert.Equal(t, u.codes, sum.StatusCodes)
+			assert.Len(t, sum.Latencies, len(u.lats))
+			for p, l := range u.lats {
+				assert.InDelta(t, l, sum.Latencies[p], 1e-9)
+			}
+			assert.Len(t, sum.Histogram, u.hist)
+		})
+	}
+}
+
+func TestBenchReportSummaryScenario(t *testing.T) {
+	bb, err := os.ReadFile("testdata/bench/scenario.txt")
+	require.NoError(t, err)
+
+	sum := ParseBenchReport(string(bb)).Summary()
+	assert.InDelta(t, 1.5, sum.Total, 1e-9)
+	assert.InDelta(t, 100.0, sum.RPS, 1e-9)
+	assert.InDelta(t, 0.01, sum.Fastest, 1e-9)
+	assert.InDelta(t, 0.16, sum.Slowest, 1e-9)
+	assert.InDelta(t, 0.04, sum.Average, 1e-9)
+	assert.Equal(t, 140, sum.CodesIn(2))
+	assert.Equal(t, 10, sum.CodesIn(4, 5))
+
+	var count int
+	for _, b := range sum.Histogram {
+		count += b.Count
+	}
+	assert.Equal(t, 150, count)
+	assert.InDelta(t, 0.01, sum.Histogram[0].Mark, 1e-9)
+	assert.InDelta(t, 0.16, sum.Histogram[len(sum.Histogram)-1].Mark, 1e-9)
+}
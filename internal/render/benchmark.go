@@ -7,7 +7,6 @@ import (
 	"errors"
 	"fmt"
 	"os"
-	"regexp"
 	"strconv"
 	"strings"
 
@@ -20,14 +19,6 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
-var (
-	totalRx = regexp.MustCompile(`Total:\s+([0-9.]+)\ssecs`)
-	reqRx   = regexp.MustCompile(`Requests/sec:\s+([0-9.]+)`)
-	okRx    = regexp.MustCompile(`\[2\d{2}\]\s+(\d+)\s+responses`)
-	errRx   = regexp.MustCompile(`\[[45]\d{2}\]\s+(\d+)\s+responses`)
-	toastRx = regexp.MustCompile(`Error distribution`)
-)
-
 // Benchmark renders a benchmarks to screen.
 type Benchmark struct {
 	Base
@@ -54,6 +45,9 @@ func (Benchmark) Header(string) model1.Header {
 		model1.HeaderColumn{Name: "REQ/S", Attrs: model1.Attrs{Align: tview.AlignRight}},
 		model1.HeaderColumn{Name: "2XX", Attrs: model1.Attrs{Align: tview.AlignRight}},
 		model1.HeaderColumn{Name: "4XX/5XX", Attrs: model1.Attrs{Align: tview.AlignRight}},
+		model1.HeaderColumn{Name: "P50", Attrs: model1.Attrs{Align: tview.AlignRight}},
+		model1.HeaderColumn{Name: "P90", Attrs: model1.Attrs{Align: tview.AlignRight}},
+		model1.HeaderColumn{Name: "P99", Attrs: model1.Attrs{Align: tview.AlignRight}},
 		model1.HeaderColumn{Name: "REPORT"},
 		model1.HeaderColumn{Name: "VALID", Attrs: model1.Attrs{Wide: true}},
 		model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true}},
@@ -78,7 +72,7 @@ func (b Benchmark) Render(o any, ns string, r *model1.Row) error {
 		return err
 	}
 	b.augmentRow(r.Fields, data)
-	r.Fields[8] = AsStatus(b.diagnose(ns, r.Fields))
+	r.Fields[11] = AsStatus(b.diagnose(ns, r.Fields))
 
 	return nil
 }
@@ -118,57 +112,51 @@ func (Benchmark) initRow(row model1.Fields, f os.FileInfo) error {
 	}
 	row[0] = tokens[0]
 	row[1] = tokens[1]
-	row[7] = f.Name()
-	row[9] = ToAge(metav1.Time{Time: f.ModTime()})
+	row[10] = f.Name()
+	row[12] = ToAge(metav1.Time{Time: f.ModTime()})
 
 	return nil
 }
 
-func (b Benchmark) augmentRow(fields model1.Fields, data string) {
+func (Benchmark) augmentRow(fields model1.Fields, data string) {
 	if data == "" {
 		return
 	}
 
+	report := ParseBenchReport(data)
+	sum := report.Summary()
 	col := 2
 	fields[col] = "pass"
-	mf := toastRx.FindAllStringSubmatch(data, 1)
-	if len(mf) > 0 {
+	if report.Failed() {
 		fields[col] = "fail"
 	}
 	col++
 
-	mt := totalRx.FindAllStringSubmatch(data, 1)
-	if len(mt) > 0 {
-		fields[col] = mt[0][1]
-	}
+	fields[col] = AsDecimal(sum.Total)
 	col++
 
-	mr := reqRx.FindAllStringSubmatch(data, 1)
-	if len(mr) > 0 {
-		fields[col] = mr[0][1]
-	}
+	fields[col] = AsDecimal(sum.RPS)
 	col++
 
-	ms := okRx.FindAllStringSubmatch(data, -1)
-	fields[col] = b.countReq(ms)
+	fields[col] = AsThousands(int64(sum.CodesIn(2)))
 	col++
 
-	me := errRx.FindAllStringSubmatch(data, -1)
-	fields[col] = b.countReq(me)
-}
-
-func (Benchmark) countReq(rr [][]string) string {
-	if len(rr) == 0 {
-		return "0"
-	}
+	fields[col] = AsThousands(int64(sum.CodesIn(4, 5)))
+	col++
 
-	var sum int
-	for _, m := range rr {
-		if m, err := strconv.Atoi(m[1]); err == nil {
-			sum += m
+	for _, p := range BenchPercentiles {
+		if l, ok := sum.Latencies[p]; ok {
+			fields[col] = AsDecimal(l)
+		} else {
+			fields[col] = NAValue
 		}
+		col++
 	}
-	return AsThousands(int64(sum))
+}
+
+// AsDecimal formats a benchmark measure with a fixed precision.
+func AsDecimal(v float64) string {
+	return strconv.FormatFloat(v, 'f', 4, 64)
 }
 
 // BenchInfo represents benchmark run info.
@@ -0,0 +1,285 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	resourcehelper "k8s.io/kubectl/pkg/util/resource"
+	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+var defaultCAPHeader = model1.Header{
+	model1.HeaderColumn{Name: "NAME"},
+	model1.HeaderColumn{Name: "STATUS"},
+	model1.HeaderColumn{Name: "PODS", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "PODS/A", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "%PODS", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "CPU/R", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "CPU/L", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "CPU", Attrs: model1.Attrs{Align: tview.AlignRight, MX: true}},
+	model1.HeaderColumn{Name: "CPU/A", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "%CPU/R", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "%CPU/L", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "%CPU", Attrs: model1.Attrs{Align: tview.AlignRight, MX: true}},
+	model1.HeaderColumn{Name: "MEM/R", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "MEM/L", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "MEM", Attrs: model1.Attrs{Align: tview.AlignRight, MX: true}},
+	model1.HeaderColumn{Name: "MEM/A", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "%MEM/R", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "%MEM/L", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "%MEM", Attrs: model1.Attrs{Align: tview.AlignRight, MX: true}},
+	model1.HeaderColumn{Name: "EPH/R", Attrs: model1.Attrs{Align: tview.AlignRight, Wide: true}},
+	model1.HeaderColumn{Name: "EPH/L", Attrs: model1.Attrs{Align: tview.AlignRight, Wide: true}},
+	model1.HeaderColumn{Name: "EPH/A", Attrs: model1.Attrs{Align: tview.AlignRight, Wide: true}},
+	model1.HeaderColumn{Name: "GPU/R", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "GPU/L", Attrs: model1.Attrs{Align: tview.AlignRight, Wide: true}},
+	model1.HeaderColumn{Name: "GPU/A", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "OC/CPU", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "OC/MEM", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "VALID", Attrs: model1.Attrs{Wide: true}},
+	model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true}},
+}
+
+// Capacity renders a node capacity and bin-packing summary to screen.
+type Capacity struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (Capacity) ColorerFunc() model1.ColorerFunc {
+	return func(ns string, h model1.Header, re *model1.RowEvent) tcell.Color {
+		c := model1.DefaultColorer(ns, h, re)
+		for _, col := range []string{"OC/CPU", "OC/MEM"} {
+			idx, ok := h.IndexOf(col, true)
+			if !ok {
+				continue
+			}
+			if f, err := strconv.ParseFloat(re.Row.Fields[idx], 64); err == nil && f > 1 {
+				c = model1.PendingColor
+			}
+		}
+
+		return c
+	}
+}
+
+// Header returns a header row.
+func (c Capacity) Header(string) model1.Header {
+	return c.doHeader(defaultCAPHeader)
+}
+
+// Render renders a K8s resource to screen.
+func (c Capacity) Render(o any, _ string, r *model1.Row) error {
+	res, ok := o.(*NodeCapacityRes)
+	if !ok {
+		return fmt.Errorf("expected NodeCapacityRes, but got %T", o)
+	}
+
+	no := res.Node
+	statuses := make(sort.StringSlice, 10)
+	status(no.Status.Conditions, no.Spec.Unschedulable, statuses)
+	sort.Sort(statuses)
+
+	reqs, lims := res.RequestsAndLimits()
+	alloc := no.Status.Allocatable
+	var cpuU, memU int64
+	if res.MX != nil {
+		cpuU, memU = res.MX.Usage.Cpu().MilliValue(), res.MX.Usage.Memory().Value()
+	}
+	cpuA, memA := alloc.Cpu().MilliValue(), alloc.Memory().Value()
+	podA := alloc.Pods().Value()
+
+	r.ID = client.FQN("", no.Name)
+	r.Fields = model1.Fields{
+		no.Name,
+		join(statuses, ","),
+		strconv.Itoa(len(res.Pods)),
+		strconv.Itoa(int(podA)),
+		client.ToPercentageStr(int64(len(res.Pods)), podA),
+		toMc(reqs.Cpu().MilliValue()),
+		toMc(lims.Cpu().MilliValue()),
+		toMc(cpuU),
+		toMc(cpuA),
+		client.ToPercentageStr(reqs.Cpu().MilliValue(), cpuA),
+		client.ToPercentageStr(lims.Cpu().MilliValue(), cpuA),
+		client.ToPercentageStr(cpuU, cpuA),
+		toMi(reqs.Memory().Value()),
+		toMi(lims.Memory().Value()),
+		toMi(memU),
+		toMi(memA),
+		client.ToPercentageStr(reqs.Memory().Value(), memA),
+		client.ToPercentageStr(lims.Memory().Value(), memA),
+		client.ToPercentageStr(memU, memA),
+		toMi(reqs.StorageEphemeral().Value()),
+		toMi(lims.StorageEphemeral().Value()),
+		toMi(alloc.StorageEphemeral().Value()),
+		toMu(extractGPU(reqs).Value()),
+		toMu(extractGPU(lims).Value()),
+		toMu(extractGPU(alloc).Value()),
+		ToOvercommit(lims.Cpu().MilliValue(), cpuA),
+		ToOvercommit(lims.Memory().Value(), memA),
+		AsStatus(c.diagnose(res, reqs)),
+		ToAge(no.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+func (Capacity) diagnose(res *NodeCapacityRes, reqs v1.ResourceList) error {
+	alloc := res.Node.Status.Allocatable
+	switch {
+	case reqs.Cpu().Cmp(*alloc.Cpu()) > 0:
+		return errors.New("cpu requests exceed allocatable")
+	case reqs.Memory().Cmp(*alloc.Memory()) > 0:
+		return errors.New("memory requests exceed allocatable")
+	case int64(len(res.Pods)) > alloc.Pods().Value():
+		return errors.New("pod count exceeds allocatable")
+	}
+
+	return nil
+}
+
+// ToOvercommit computes an overcommit ratio ie limits over allocatable.
+func ToOvercommit(v, dv int64) string {
+	if dv == 0 {
+		return NAValue
+	}
+
+	return strconv.FormatFloat(float64(v)/float64(dv), 'f', 2, 64)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// NodeCapacityRes represents a node with its scheduled pods and metrics.
+type NodeCapacityRes struct {
+	Node *v1.Node
+	Pods []*v1.Pod
+	MX   *mv1beta1.NodeMetrics
+}
+
+// GetObjectKind returns a schema object.
+func (*NodeCapacityRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (n *NodeCapacityRes) DeepCopyObject() runtime.Object {
+	return n
+}
+
+// RequestsAndLimits returns the aggregated pods requests and limits on the node.
+func (n *NodeCapacityRes) RequestsAndLimits() (reqs, lims v1.ResourceList) {
+	return podsRequestsAndLimits(n.Pods)
+}
+
+func podsRequestsAndLimits(pp []*v1.Pod) (reqs, lims v1.ResourceList) {
+	reqs, lims = make(v1.ResourceList), make(v1.ResourceList)
+	for _, po := range pp {
+		rr, ll := resourcehelper.PodRequestsAndLimits(po)
+		addResources(reqs, rr)
+		addResources(lims, ll)
+	}
+
+	return
+}
+
+func addResources(acc, rl v1.ResourceList) {
+	for k, v := range rl {
+		if q, ok := acc[k]; ok {
+			q.Add(v)
+			acc[k] = q
+			continue
+		}
+		acc[k] = v.DeepCopy()
+	}
+}
+
+// PodCapacityRes represents a pod scheduled on a given node.
+type PodCapacityRes struct {
+	Pod         *v1.Pod
+	Allocatable v1.ResourceList
+}
+
+// GetObjectKind returns a schema object.
+func (*PodCapacityRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (p *PodCapacityRes) DeepCopyObject() runtime.Object {
+	return p
+}
+
+var defaultCAPPOHeader = model1.Header{
+	model1.HeaderColumn{Name: "NAMESPACE"},
+	model1.HeaderColumn{Name: "NAME"},
+	model1.HeaderColumn{Name: "STATUS"},
+	model1.HeaderColumn{Name: "QOS"},
+	model1.HeaderColumn{Name: "CPU/R", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "CPU/L", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "%CPU/R", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "MEM/R", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "MEM/L", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "%MEM/R", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "EPH/R", Attrs: model1.Attrs{Align: tview.AlignRight, Wide: true}},
+	model1.HeaderColumn{Name: "EPH/L", Attrs: model1.Attrs{Align: tview.AlignRight, Wide: true}},
+	model1.HeaderColumn{Name: "GPU/R", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "GPU/L", Attrs: model1.Attrs{Align: tview.AlignRight, Wide: true}},
+	model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true}},
+}
+
+// CapacityPod renders a pod resource footprint on a node to screen.
+type CapacityPod struct {
+	Base
+}
+
+// Header returns a header row.
+func (c CapacityPod) Header(string) model1.Header {
+	return c.doHeader(defaultCAPPOHeader)
+}
+
+// Render renders a K8s resource to screen.
+func (CapacityPod) Render(o any, _ string, r *model1.Row) error {
+	res, ok := o.(*PodCapacityRes)
+	if !ok {
+		return fmt.Errorf("expected PodCapacityRes, but got %T", o)
+	}
+
+	po := res.Pod
+	reqs, lims := resourcehelper.PodRequestsAndLimits(po)
+	cpuA, memA := res.Allocatable.Cpu().MilliValue(), res.Allocatable.Memory().Value()
+
+	r.ID = client.FQN(po.Namespace, po.Name)
+	r.Fields = model1.Fields{
+		po.Namespace,
+		po.Name,
+		string(po.Status.Phase),
+		string(po.Status.QOSClass),
+		toMc(reqs.Cpu().MilliValue()),
+		toMc(lims.Cpu().MilliValue()),
+		client.ToPercentageStr(reqs.Cpu().MilliValue(), cpuA),
+		toMi(reqs.Memory().Value()),
+		toMi(lims.Memory().Value()),
+		client.ToPercentageStr(reqs.Memory().Value(), memA),
+		toMi(reqs.StorageEphemeral().Value()),
+		toMi(lims.StorageEphemeral().Value()),
+		toMu(extractGPU(reqs).Value()),
+		toMu(extractGPU(lims).Value()),
+		ToAge(po.GetCreationTimestamp()),
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestCapacityRender(t *testing.T) {
+	var no v1.Node
+	require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(load(t, "no").Object, &no))
+	var po v1.Pod
+	require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(load(t, "po").Object, &po))
+
+	res := render.NodeCapacityRes{
+		Node: &no,
+		Pods: []*v1.Pod{&po, &po},
+		MX:   makeNodeMX("minikube", "10m", "20Mi"),
+	}
+
+	var c render.Capacity
+	r := model1.NewRow(29)
+	require.NoError(t, c.Render(&res, "", &r))
+
+	assert.Equal(t, "minikube", r.ID)
+	e := model1.Fields{"minikube", "Ready", "2", "110", "1", "200", "0", "10", "4000", "5", "0", "0", "140", "340", "20", "7874", "1", "4", "0"}
+	assert.Equal(t, e, r.Fields[:19])
+	assert.Equal(t, model1.Fields{"0.00", "0.04"}, r.Fields[25:27])
+	assert.Empty(t, r.Fields[27])
+}
+
+func TestCapacityPodRender(t *testing.T) {
+	var no v1.Node
+	require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(load(t, "no").Object, &no))
+	var po v1.Pod
+	require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(load(t, "po").Object, &po))
+
+	var c render.CapacityPod
+	r := model1.NewRow(15)
+	require.NoError(t, c.Render(&render.PodCapacityRes{Pod: &po, Allocatable: no.Status.Allocatable}, "", &r))
+
+	assert.Equal(t, "default/nginx", r.ID)
+	e := model1.Fields{"default", "nginx", "Running", "BestEffort", "100", "0", "2", "70", "170", "0"}
+	assert.Equal(t, e, r.Fields[:10])
+}
+
+func TestToOvercommit(t *testing.T) {
+	uu := map[string]struct {
+		v, dv int64
+		e     string
+	}{
+		"zero": {
+			e: render.NAValue,
+		},
+		"under": {
+			v: 50, dv: 100, e: "0.50",
+		},
+		"over": {
+			v: 250, dv: 100, e: "2.50",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.ToOvercommit(u.v, u.dv))
+		})
+	}
+}
@@ -0,0 +1,168 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"crypto/x509"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+const certExpired = "expired"
+
+var defaultCertHeader = model1.Header{
+	model1.HeaderColumn{Name: "NAMESPACE"},
+	model1.HeaderColumn{Name: "SOURCE"},
+	model1.HeaderColumn{Name: "NAME"},
+	model1.HeaderColumn{Name: "FIELD"},
+	model1.HeaderColumn{Name: "SUBJECT"},
+	model1.HeaderColumn{Name: "ISSUER"},
+	model1.HeaderColumn{Name: "SANS", Attrs: model1.Attrs{Wide: true}},
+	model1.HeaderColumn{Name: "NOT BEFORE", Attrs: model1.Attrs{Wide: true}},
+	model1.HeaderColumn{Name: "NOT AFTER"},
+	model1.HeaderColumn{Name: "EXPIRES", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "%LIFE", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "CHAIN"},
+}
+
+// Cert renders a TLS certificate to screen.
+type Cert struct {
+	Base
+}
+
+// CertColorer colors certificates based on their consumed lifetime.
+func CertColorer(sev *config.Severity) model1.ColorerFunc {
+	if sev == nil {
+		sev = config.NewSeverity()
+	}
+	return func(ns string, h model1.Header, re *model1.RowEvent) tcell.Color {
+		c := model1.DefaultColorer(ns, h, re)
+		if idx, ok := h.IndexOf("EXPIRES", true); ok && strings.TrimSpace(re.Row.Fields[idx]) == certExpired {
+			return model1.ErrColor
+		}
+		idx, ok := h.IndexOf("%LIFE", true)
+		if !ok {
+			return c
+		}
+		perc, err := strconv.Atoi(strings.TrimSpace(re.Row.Fields[idx]))
+		if err != nil {
+			return c
+		}
+		switch {
+		case perc >= sev.Critical:
+			return model1.ErrColor
+		case perc >= sev.Warn:
+			return model1.PendingColor
+		default:
+			return c
+		}
+	}
+}
+
+// Header returns a header row.
+func (c Cert) Header(string) model1.Header {
+	return c.doHeader(defaultCertHeader)
+}
+
+// Render renders a K8s resource to screen.
+func (Cert) Render(o any, _ string, r *model1.Row) error {
+	res, ok := o.(CertRes)
+	if !ok {
+		return fmt.Errorf("expected CertRes, but got %T", o)
+	}
+
+	now := time.Now()
+	r.ID = res.ID()
+	r.Fields = model1.Fields{
+		res.Namespace,
+		res.Source,
+		res.Name,
+		res.Field,
+		res.Cert.Subject.CommonName,
+		res.Cert.Issuer.CommonName,
+		strings.Join(CertSANs(res.Cert), ","),
+		res.Cert.NotBefore.UTC().Format(time.RFC3339),
+		res.Cert.NotAfter.UTC().Format(time.RFC3339),
+		CertExpiry(res.Cert, now),
+		strconv.Itoa(CertLifePerc(res.Cert, now)),
+		res.Chain,
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// CertSANs returns a certificate subject alternative names.
+func CertSANs(c *x509.Certificate) []string {
+	ss := make([]string, 0, len(c.DNSNames)+len(c.IPAddresses)+len(c.EmailAddresses)+len(c.URIs))
+	ss = append(ss, c.DNSNames...)
+	for _, ip := range c.IPAddresses {
+		ss = append(ss, ip.String())
+	}
+	ss = append(ss, c.EmailAddresses...)
+	for _, u := range c.URIs {
+		ss = append(ss, u.String())
+	}
+
+	return ss
+}
+
+// CertExpiry returns a human readable time left before a certificate expires.
+func CertExpiry(c *x509.Certificate, now time.Time) string {
+	if now.After(c.NotAfter) {
+		return certExpired
+	}
+
+	return duration.HumanDuration(c.NotAfter.Sub(now))
+}
+
+// CertLifePerc returns the percentage of a certificate lifetime already consumed.
+func CertLifePerc(c *x509.Certificate, now time.Time) int {
+	total := c.NotAfter.Sub(c.NotBefore)
+	if total <= 0 || now.After(c.NotAfter) {
+		return 100
+	}
+	if now.Before(c.NotBefore) {
+		return 0
+	}
+
+	return int(float64(now.Sub(c.NotBefore)) * 100 / float64(total))
+}
+
+// CertRes represents a certificate found in a cluster resource or kubeconfig.
+type CertRes struct {
+	Namespace string
+	Source    string
+	Name      string
+	Field     string
+	Cert      *x509.Certificate
+	Chain     string
+}
+
+// ID returns a certificate unique identifier.
+func (c CertRes) ID() string {
+	return strings.Join([]string{c.Namespace, c.Source, c.Name, c.Field}, "|")
+}
+
+// GetObjectKind returns a schema object.
+func (CertRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (c CertRes) DeepCopyObject() runtime.Object {
+	return c
+}
@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCertRender(t *testing.T) {
+	now := time.Now()
+	res := render.CertRes{
+		Namespace: "default",
+		Source:    "Secret",
+		Name:      "fred-tls",
+		Field:     "tls.crt#0",
+		Cert: &x509.Certificate{
+			Subject:     pkix.Name{CommonName: "fred.example.com"},
+			Issuer:      pkix.Name{CommonName: "blee-ca"},
+			DNSNames:    []string{"fred.example.com", "fred"},
+			IPAddresses: []net.IP{net.ParseIP("10.0.0.1")},
+			NotBefore:   now.Add(-80 * time.Hour),
+			NotAfter:    now.Add(20*time.Hour + 30*time.Minute),
+		},
+		Chain: "valid",
+	}
+
+	var c render.Cert
+	r := model1.NewRow(12)
+	require.NoError(t, c.Render(res, "", &r))
+
+	assert.Equal(t, "default|Secret|fred-tls|tls.crt#0", r.ID)
+	assert.Equal(t, "fred.example.com", r.Fields[4])
+	assert.Equal(t, "blee-ca", r.Fields[5])
+	assert.Equal(t, "fred.example.com,fred,10.0.0.1", r.Fields[6])
+	assert.Equal(t, "20h", r.Fields[9])
+	assert.Equal(t, "79", r.Fields[10])
+	assert.Equal(t, "valid", r.Fields[11])
+}
+
+func TestCertLifePerc(t *testing.T) {
+	now := time.Now()
+	uu := map[string]struct {
+		from, to time.Time
+		e        int
+	}{
+		"fresh":    {from: now, to: now.Add(10 * time.Hour), e: 0},
+		"half":     {from: now.Add(-5 * time.Hour), to: now.Add(5 * time.Hour), e: 50},
+		"expired":  {from: now.Add(-2 * time.Hour), to: now.Add(-time.Hour), e: 100},
+		"future":   {from: now.Add(time.Hour), to: now.Add(2 * time.Hour), e: 0},
+		"longLive": {from: now.Add(-50 * 365 * 24 * time.Hour), to: now.Add(50 * 365 * 24 * time.Hour), e: 50},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			c := x509.Certificate{NotBefore: u.from, NotAfter: u.to}
+			assert.Equal(t, u.e, render.CertLifePerc(&c, now))
+		})
+	}
+}
+
+func TestCertExpiry(t *testing.T) {
+	now := time.Now()
+	assert.Equal(t, "expired", render.CertExpiry(&x509.Certificate{NotAfter: now.Add(-time.Minute)}, now))
+	assert.Equal(t, "3d", render.CertExpiry(&x509.Certificate{NotAfter: now.Add(72*time.Hour + time.Minute)}, now))
+}
@@ -0,0 +1,208 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"slices"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	condTrue  = "True"
+	condFalse = "False"
+)
+
+// readyConditions lists conditions asserting a resource readiness in order of precedence.
+var readyConditions = []string{"Ready", "Available", "Healthy", "Succeeded", "Complete"}
+
+// ConditionsColumner represents a renderer showing resources readiness columns.
+type ConditionsColumner interface {
+	// SetConditionCols sets the readiness columns to render.
+	SetConditionCols([]string)
+}
+
+// Condition represents a resource status condition.
+type Condition struct {
+	Type           string
+	Status         string
+	Reason         string
+	Message        string
+	LastTransition time.Time
+}
+
+// Conditions represents a collection of status conditions.
+type Conditions []Condition
+
+// ObjectConditions extracts a resource status conditions if any.
+func ObjectConditions(o map[string]any) Conditions {
+	cc, _, _ := unstructured.NestedSlice(o, "status", "conditions")
+	conds := make(Conditions, 0, len(cc))
+	for _, c := range cc {
+		m, ok := c.(map[string]any)
+		if !ok {
+			continue
+		}
+		var cond Condition
+		cond.Type, _, _ = unstructured.NestedString(m, "type")
+		if cond.Type == "" {
+			continue
+		}
+		cond.Status, _, _ = unstructured.NestedString(m, "status")
+		cond.Reason, _, _ = unstructured.NestedString(m, "reason")
+		cond.Message, _, _ = unstructured.NestedString(m, "message")
+		if ts, _, _ := unstructured.NestedString(m, "lastTransitionTime"); ts != "" {
+			cond.LastTransition, _ = time.Parse(time.RFC3339, ts)
+		}
+		conds = append(conds, cond)
+	}
+
+	return conds
+}
+
+// Find returns a condition by type if present.
+func (cc Conditions) Find(t string) (Condition, bool) {
+	idx := slices.IndexFunc(cc, func(c Condition) bool {
+		return c.Type == t
+	})
+	if idx < 0 {
+		return Condition{}, false
+	}
+
+	return cc[idx], true
+}
+
+// IsTrue checks if a given condition is present and true.
+func (cc Conditions) IsTrue(t string) bool {
+	c, ok := cc.Find(t)
+
+	return ok && c.Status == condTrue
+}
+
+// IsFalse checks if a given condition is present and false.
+func (cc Conditions) IsFalse(t string) bool {
+	c, ok := cc.Find(t)
+
+	return ok && c.Status == condFalse
+}
+
+// Ready returns the resource readiness condition status if any.
+func (cc Conditions) Ready() (string, bool) {
+	for _, t := range readyConditions {
+		if c, ok := cc.Find(t); ok {
+			return c.Status, true
+		}
+	}
+
+	return "", false
+}
+
+// ObservedGeneration returns a resource observed and current generation.
+func ObservedGeneration(o map[string]any) (observed, current int64, ok bool) {
+	observed, ok, _ = unstructured.NestedInt64(o, "status", "observedGeneration")
+	current, _, _ = unstructured.NestedInt64(o, "metadata", "generation")
+
+	return
+}
+
+// ObjectReadiness derives a resource readiness and status from common operator
+// patterns ie Ready, Synced, Reconciling conditions, observed generations,
+// phases and health statuses.
+func ObjectReadiness(o map[string]any) (ready, status string, ok bool) {
+	cc := ObjectConditions(o)
+	ready, hasReady := cc.Ready()
+	if !hasReady {
+		ready = NAValue
+	}
+	if status, _, ok := cc.alert(o); ok {
+		return ready, status, true
+	}
+	for _, p := range [][]string{{"status", "phase"}, {"status", "state"}, {"status", "health", "status"}} {
+		if s, _, _ := unstructured.NestedString(o, p...); s != "" {
+			return ready, s, true
+		}
+	}
+	if status, _, ok := cc.readyStatus(); ok {
+		return ready, status, true
+	}
+	if len(cc) > 0 {
+		return ready, cc.latest().Type, true
+	}
+
+	return "", "", false
+}
+
+// ConditionsStatus derives a resource status from its status conditions and
+// checks if the resource is healthy. Transitional statuses ie reconciling or
+// progressing are deemed healthy.
+func ConditionsStatus(o map[string]any) (status string, healthy, ok bool) {
+	cc := ObjectConditions(o)
+	if status, healthy, ok := cc.alert(o); ok {
+		return status, healthy, true
+	}
+
+	return cc.readyStatus()
+}
+
+// alert returns a status when conditions flag a failing or transitional resource.
+func (cc Conditions) alert(o map[string]any) (status string, healthy, ok bool) {
+	switch {
+	case cc.IsTrue("Stalled"):
+		return reasonOr(cc, "Stalled", "Stalled"), false, true
+	case cc.IsFalse("Ready"):
+		return reasonOr(cc, "Ready", "NotReady"), false, true
+	case cc.IsFalse("Synced"):
+		return reasonOr(cc, "Synced", "NotSynced"), false, true
+	case cc.IsTrue("Reconciling"):
+		return "Reconciling", true, true
+	case cc.IsTrue("Progressing") && !cc.IsTrue("Available") && !cc.IsFalse("Available"):
+		return "Progressing", true, true
+	case isStale(o):
+		return "Reconciling", true, true
+	case cc.IsTrue("Failed"):
+		return reasonOr(cc, "Failed", "Failed"), false, true
+	default:
+		return "", false, false
+	}
+}
+
+// readyStatus returns a status from the first readiness condition if any.
+func (cc Conditions) readyStatus() (status string, healthy, ok bool) {
+	for _, t := range readyConditions {
+		if c, ok := cc.Find(t); ok {
+			if c.Status == condTrue {
+				return t, true, true
+			}
+			return reasonOr(cc, t, "Not"+t), false, true
+		}
+	}
+
+	return "", false, false
+}
+
+func (cc Conditions) latest() Condition {
+	l := cc[0]
+	for _, c := range cc[1:] {
+		if c.LastTransition.After(l.LastTransition) {
+			l = c
+		}
+	}
+
+	return l
+}
+
+func reasonOr(cc Conditions, t, dflt string) string {
+	if c, ok := cc.Find(t); ok && c.Reason != "" {
+		return c.Reason
+	}
+
+	return dflt
+}
+
+func isStale(o map[string]any) bool {
+	observed, current, ok := ObservedGeneration(o)
+
+	return ok && current > 0 && observed < current
+}
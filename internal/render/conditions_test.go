@@ -0,0 +1,179 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObjectReadiness(t *testing.T) {
+	uu := map[string]struct {
+		o             map[string]any
+		ready, status string
+		ok            bool
+	}{
+		"no-status": {
+			o: map[string]any{"spec": map[string]any{}},
+		},
+		"ready": {
+			o:      withConds(cond("Ready", "True", "Succeeded"), cond("Synced", "True", "")),
+			ready:  "True",
+			status: "Ready",
+			ok:     true,
+		},
+		"not-ready": {
+			o:      withConds(cond("Ready", "False", "ReconcileError"), cond("Synced", "True", "")),
+			ready:  "False",
+			status: "ReconcileError",
+			ok:     true,
+		},
+		"not-synced": {
+			o:      withConds(cond("Ready", "True", ""), cond("Synced", "False", "")),
+			ready:  "True",
+			status: "NotSynced",
+			ok:     true,
+		},
+		"stalled": {
+			o:      withConds(cond("Ready", "False", "Failing"), cond("Stalled", "True", "InvalidSpec")),
+			ready:  "False",
+			status: "InvalidSpec",
+			ok:     true,
+		},
+		"reconciling": {
+			o:      withConds(cond("Ready", "Unknown", ""), cond("Reconciling", "True", "Progressing")),
+			ready:  "Unknown",
+			status: "Reconciling",
+			ok:     true,
+		},
+		"stale": {
+			o: map[string]any{
+				"metadata": map[string]any{"generation": int64(3)},
+				"status": map[string]any{
+					"observedGeneration": int64(2),
+					"conditions":         []any{cond("Ready", "True", "")},
+				},
+			},
+			ready:  "True",
+			status: "Reconciling",
+			ok:     true,
+		},
+		"available": {
+			o:      withConds(cond("Available", "False", "")),
+			ready:  "False",
+			status: "NotAvailable",
+			ok:     true,
+		},
+		"phase": {
+			o: map[string]any{
+				"status": map[string]any{"phase": "Provisioning"},
+			},
+			ready:  render.NAValue,
+			status: "Provisioning",
+			ok:     true,
+		},
+		"health": {
+			o: map[string]any{
+				"status": map[string]any{
+					"health": map[string]any{"status": "Degraded"},
+				},
+			},
+			ready:  render.NAValue,
+			status: "Degraded",
+			ok:     true,
+		},
+		"custom-conditions": {
+			o: withConds(
+				map[string]any{"type": "Issued", "status": "True", "lastTransitionTime": "2024-01-01T00:00:00Z"},
+				map[string]any{"type": "Renewed", "status": "True", "lastTransitionTime": "2024-02-01T00:00:00Z"},
+			),
+			ready:  render.NAValue,
+			status: "Renewed",
+			ok:     true,
+		},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			ready, status, ok := render.ObjectReadiness(u.o)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.ready, ready)
+			assert.Equal(t, u.status, status)
+		})
+	}
+}
+
+func TestConditionsStatus(t *testing.T) {
+	uu := map[string]struct {
+		o           map[string]any
+		status      string
+		healthy, ok bool
+	}{
+		"none": {
+			o: map[string]any{"status": map[string]any{"phase": "Running"}},
+		},
+		"ready": {
+			o:       withConds(cond("Ready", "True", "")),
+			status:  "Ready",
+			healthy: true,
+			ok:      true,
+		},
+		"unavailable": {
+			o:      withConds(cond("Progressing", "True", ""), cond("Available", "False", "MinimumReplicasUnavailable")),
+			status: "MinimumReplicasUnavailable",
+			ok:     true,
+		},
+		"reconciling": {
+			o:       withConds(cond("Reconciling", "True", "")),
+			status:  "Reconciling",
+			healthy: true,
+			ok:      true,
+		},
+		"failed": {
+			o:      withConds(cond("Failed", "True", "BackoffLimitExceeded")),
+			status: "BackoffLimitExceeded",
+			ok:     true,
+		},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			status, healthy, ok := render.ConditionsStatus(u.o)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.status, status)
+			assert.Equal(t, u.healthy, healthy)
+		})
+	}
+}
+
+func TestObjectConditions(t *testing.T) {
+	cc := render.ObjectConditions(withConds(
+		map[string]any{"type": "Ready", "status": "True", "reason": "Done", "message": "All good", "lastTransitionTime": "2024-01-01T00:00:00Z"},
+		map[string]any{"status": "True"},
+		"bozo",
+	))
+
+	assert.Len(t, cc, 1)
+	assert.Equal(t, "Ready", cc[0].Type)
+	assert.Equal(t, "All good", cc[0].Message)
+	assert.Equal(t, 2024, cc[0].LastTransition.Year())
+	assert.True(t, cc.IsTrue("Ready"))
+	assert.False(t, cc.IsFalse("Ready"))
+	_, ok := cc.Find("Synced")
+	assert.False(t, ok)
+}
+
+// Helpers...
+
+func cond(t, s, reason string) map[string]any {
+	return map[string]any{"type": t, "status": s, "reason": reason}
+}
+
+func withConds(cc ...any) map[string]any {
+	return map[string]any{
+		"status": map[string]any{"conditions": cc},
+	}
+}
@@ -8,14 +8,28 @@ import (
 	"log/slog"
 	"os"
 	"strings"
+	"time"
 
+	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/model1"
 	"github.com/derailed/tcell/v2"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/duration"
 	"k8s.io/client-go/tools/clientcmd/api"
 )
 
+const (
+	// ContextReachable denotes a reachable api server.
+	ContextReachable = "yes"
+
+	// ContextUnreachable denotes an unreachable api server.
+	ContextUnreachable = "no"
+
+	// ContextChecking denotes an api server reachability check in progress.
+	ContextChecking = "checking"
+)
+
 // Context renders a K8s ConfigMap to screen.
 type Context struct {
 	Base
@@ -40,6 +54,10 @@ func (Context) Header(string) model1.Header {
 		model1.HeaderColumn{Name: "CLUSTER"},
 		model1.HeaderColumn{Name: "AUTHINFO"},
 		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "CREDENTIALS"},
+		model1.HeaderColumn{Name: "REACHABLE"},
+		model1.HeaderColumn{Name: "SERVER", Attrs: model1.Attrs{Wide: true}},
+		model1.HeaderColumn{Name: "VALID", Attrs: model1.Attrs{Wide: true}},
 	}
 }
 
@@ -61,18 +79,45 @@ func (Context) Render(o any, _ string, r *model1.Row) error {
 		ctx.Context.Cluster,
 		ctx.Context.AuthInfo,
 		ctx.Context.Namespace,
+		credentials(ctx.Creds, time.Now()),
+		ctx.reachable(),
+		ctx.Server,
+		ctx.issues(),
 	}
 
 	return nil
 }
 
+func credentials(s client.CredentialStatus, now time.Time) string {
+	if s.Kind == "" {
+		return NAValue
+	}
+	info := s.Kind
+	if s.Detail != "" {
+		info += ":" + s.Detail
+	}
+	switch {
+	case s.Expiry.IsZero():
+	case s.IsExpired(now):
+		info += " (expired)"
+	default:
+		info += " (" + duration.HumanDuration(s.Expiry.Sub(now)) + ")"
+	}
+
+	return info
+}
+
 // Helpers...
 
 // NamedContext represents a named cluster context.
 type NamedContext struct {
-	Name    string
-	Context *api.Context
-	Config  ContextNamer
+	Name       string
+	Context    *api.Context
+	Config     ContextNamer
+	Creds      client.CredentialStatus
+	Server     string
+	Reachable  string
+	ReachIssue string
 }
 
 // ContextNamer represents a named context.
@@ -95,6 +140,26 @@ func (c *NamedContext) IsCurrentContext(n string) bool {
 	return cl == n
 }
 
+func (c *NamedContext) reachable() string {
+	if c.Reachable == "" {
+		return NAValue
+	}
+
+	return c.Reachable
+}
+
+func (c *NamedContext) issues() string {
+	ii := make([]string, 0, 2)
+	if c.Creds.Issue != "" {
+		ii = append(ii, c.Creds.Issue)
+	}
+	if c.ReachIssue != "" {
+		ii = append(ii, "unreachable: "+c.ReachIssue)
+	}
+
+	return strings.Join(ii, ", ")
+}
+
 // GetObjectKind returns a schema object.
 func (*NamedContext) GetObjectKind() schema.ObjectKind {
 	return nil
@@ -5,7 +5,9 @@ package render_test
 
 import (
 	"testing"
+	"time"
 
+	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/model1"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/stretchr/testify/assert"
@@ -16,7 +18,7 @@ import (
 func TestContextHeader(t *testing.T) {
 	var c render.Context
 
-	assert.Len(t, c.Header(""), 4)
+	assert.Len(t, c.Header(""), 8)
 }
 
 func TestContextRender(t *testing.T) {
@@ -37,7 +39,36 @@ func TestContextRender(t *testing.T) {
 			},
 			e: model1.Row{
 				ID:     "c1",
-				Fields: model1.Fields{"c1", "c1", "u1", "ns1"},
+				Fields: model1.Fields{"c1", "c1", "u1", "ns1", "n/a", "n/a", "", ""},
+			},
+		},
+		"creds": {
+			ctx: &render.NamedContext{
+				Name: "c2",
+				Context: &api.Context{
+					Cluster:   "c2",
+					AuthInfo:  "u2",
+					Namespace: "ns2",
+				},
+				Config: &config{},
+				Creds: client.CredentialStatus{
+					Kind:   client.CredsCert,
+					Expiry: time.Now().Add(-time.Hour),
+					Issue:  "cert expired",
+				},
+				Server:     "https://localhost:3000",
+				Reachable:  render.ContextUnreachable,
+				ReachIssue: "connection refused",
+			},
+			e: model1.Row{
+				ID: "c2",
+				Fields: model1.Fields{
+					"c2", "c2", "u2", "ns2",
+					"cert (expired)",
+					"no",
+					"https://localhost:3000",
+					"cert expired, unreachable: connection refused",
+				},
 			},
 		},
 	}
@@ -46,7 +77,7 @@ func TestContextRender(t *testing.T) {
 	for k := range uu {
 		uc := uu[k]
 		t.Run(k, func(t *testing.T) {
-			row := model1.NewRow(4)
+			row := model1.NewRow(8)
 			err := r.Render(uc.ctx, "", &row)
 
 			require.NoError(t, err)
@@ -80,9 +80,12 @@ type colDef struct {
 func parse(s string) (colDef, error) {
 	mm := fullRX.FindStringSubmatch(s)
 	if len(mm) == 4 {
-		spec, err := get.RelaxedJSONPathExpression(mm[2])
-		if err != nil {
-			return colDef{idx: -1}, err
+		spec := mm[2]
+		if !isExprSpec(spec) {
+			var err error
+			if spec, err = get.RelaxedJSONPathExpression(spec); err != nil {
+				return colDef{idx: -1}, err
+			}
 		}
 		return colDef{
 			name:     mm[1],
@@ -13,6 +13,7 @@ import (
 
 	"github.com/derailed/k9s/internal/model1"
 	"github.com/derailed/k9s/internal/slogs"
+	"github.com/google/cel-go/cel"
 	"github.com/itchyny/gojq"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -28,7 +29,7 @@ func NewColsSpecs(cols ...string) ColsSpecs {
 	return ColsSpecs(cols)
 }
 
-func (cc ColsSpecs) parseSpecs() (ColumnSpecs, error) {
+func (cc ColsSpecs) parseSpecs(e *exprEvaluator) (ColumnSpecs, error) {
 	specs := make(ColumnSpecs, 0, len(cc))
 
 	for _, c := range cc {
@@ -36,10 +37,22 @@ func (cc ColsSpecs) parseSpecs() (ColumnSpecs, error) {
 		if err != nil {
 			return nil, err
 		}
-		specs = append(specs, ColumnSpec{
+		spec := ColumnSpec{
 			Header: def.toHeaderCol(),
 			Spec:   def.spec,
-		})
+		}
+		if isExprSpec(def.spec) {
+			if e == nil {
+				if e, err = newExprEvaluator(); err != nil {
+					return nil, err
+				}
+			}
+			if spec.expr, err = e.compile(def.spec); err != nil {
+				return nil, fmt.Errorf("invalid column expression %q: %w", def.name, err)
+			}
+			spec.eval = e
+		}
+		specs = append(specs, spec)
 	}
 
 	return specs, nil
@@ -82,6 +95,9 @@ func (r RenderedCol) has(n string) bool {
 type ColumnSpec struct {
 	Header model1.HeaderColumn
 	Spec   string
+
+	expr cel.Program
+	eval *exprEvaluator
 }
 
 // ColumnSpecs tracks a collection of column specs.
@@ -112,7 +128,7 @@ func (cc ColumnSpecs) Header(rh model1.Header) model1.Header {
 func (cc ColumnSpecs) realize(o runtime.Object, rh model1.Header, row *model1.Row) (RenderedCols, error) {
 	parsers := make([]*jsonpath.JSONPath, len(cc))
 	for ix := range cc {
-		if cc[ix].Spec == "" {
+		if cc[ix].Spec == "" || cc[ix].expr != nil {
 			parsers[ix] = nil
 			continue
 		}
@@ -147,7 +163,23 @@ func (cc ColumnSpecs) realize(o runtime.Object, rh model1.Header, row *model1.Ro
 
 func hydrate(o runtime.Object, cc ColumnSpecs, parsers []*jsonpath.JSONPath, rh model1.Header, row *model1.Row) (RenderedCols, error) {
 	cols := make(RenderedCols, len(parsers))
+	var fields map[string]string
 	for idx := range parsers {
+		if p := cc[idx].expr; p != nil {
+			if fields == nil {
+				fields = rowCols(rh, row)
+			}
+			v, err := cc[idx].eval.eval(p, o, fields)
+			if err != nil {
+				logExprErr(cc[idx].Header.Name, err)
+				v = MissingValue
+			}
+			cols[idx] = RenderedCol{
+				Header: cc[idx].Header,
+				Value:  v,
+			}
+			continue
+		}
 		parser := parsers[idx]
 		if parser == nil {
 			ix, ok := rh.IndexOf(cc[idx].Header.Name, true)
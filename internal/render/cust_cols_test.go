@@ -227,7 +227,7 @@ func TestParseSpecs(t *testing.T) {
 
 	for k, u := range uu {
 		t.Run(k, func(t *testing.T) {
-			cols, err := u.cols.parseSpecs()
+			cols, err := u.cols.parseSpecs(nil)
 			assert.Equal(t, u.err, err)
 			assert.Equal(t, u.e, cols)
 		})
@@ -0,0 +1,327 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/slogs"
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/common/types/traits"
+	"github.com/google/cel-go/ext"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+const (
+	// exprPrefix flags a custom column spec as an expression ie ZONE:=object.spec.nodeName.
+	exprPrefix = "="
+
+	// maxOwnerDepth caps owner references traversals.
+	maxOwnerDepth = 5
+
+	// exprCostLimit caps an expression runtime cost.
+	exprCostLimit = 1_000_000
+
+	// exprInterruptRate tracks how many comprehension iterations run between deadline checks.
+	exprInterruptRate = 100
+
+	// exprTimeout caps an expression evaluation time.
+	exprTimeout = 100 * time.Millisecond
+)
+
+var (
+	mapType  = reflect.TypeOf(map[string]any{})
+	emptyObj = types.DefaultTypeAdapter.NativeToValue(map[string]any{})
+)
+
+// LookupFunc fetches a related resource given its gvr and fully qualified name.
+type LookupFunc func(gvr, fqn string) (map[string]any, bool)
+
+// Lookuper represents a renderer that resolves related resources in custom columns.
+type Lookuper interface {
+	// SetLookup sets the related resources lookup.
+	SetLookup(LookupFunc)
+}
+
+// isExprSpec checks if a column spec is an expression.
+func isExprSpec(spec string) bool {
+	return strings.HasPrefix(spec, exprPrefix)
+}
+
+// exprEvaluator compiles and runs custom columns expressions. Lookups are
+// swapped on each refresh so related resources are only fetched once per cycle.
+type exprEvaluator struct {
+	env    *cel.Env
+	lookup atomic.Pointer[LookupFunc]
+	progs  sync.Map
+}
+
+func newExprEvaluator() (*exprEvaluator, error) {
+	e := new(exprEvaluator)
+	env, err := cel.NewEnv(
+		cel.Variable("object", cel.DynType),
+		cel.Variable("cols", cel.MapType(cel.StringType, cel.StringType)),
+		cel.OptionalTypes(),
+		ext.Strings(),
+		cel.Function("lookup",
+			cel.Overload("lookup_string_string",
+				[]*cel.Type{cel.StringType, cel.StringType}, cel.DynType,
+				cel.BinaryBinding(func(gvr, n ref.Val) ref.Val {
+					return e.fetch(gvr, client.FQN(client.ClusterScope, fmt.Sprintf("%v", n.Value())))
+				}),
+			),
+			cel.Overload("lookup_string_string_string",
+				[]*cel.Type{cel.StringType, cel.StringType, cel.StringType}, cel.DynType,
+				cel.FunctionBinding(func(args ...ref.Val) ref.Val {
+					return e.fetch(args[0], client.FQN(fmt.Sprintf("%v", args[1].Value()), fmt.Sprintf("%v", args[2].Value())))
+				}),
+			),
+		),
+		cel.Function("owner",
+			cel.Overload("owner_dyn",
+				[]*cel.Type{cel.DynType}, cel.DynType,
+				cel.UnaryBinding(func(o ref.Val) ref.Val {
+					return e.owner(o, "")
+				}),
+			),
+			cel.Overload("owner_dyn_string",
+				[]*cel.Type{cel.DynType, cel.StringType}, cel.DynType,
+				cel.BinaryBinding(func(o, kind ref.Val) ref.Val {
+					return e.owner(o, fmt.Sprintf("%v", kind.Value()))
+				}),
+			),
+		),
+		cel.Function("age",
+			cel.Overload("age_string", []*cel.Type{cel.StringType}, cel.StringType,
+				cel.UnaryBinding(func(v ref.Val) ref.Val {
+					t, err := time.Parse(time.RFC3339, fmt.Sprintf("%v", v.Value()))
+					if err != nil {
+						return types.NewErr("invalid timestamp %q", v.Value())
+					}
+					return types.String(ToAge(metav1.Time{Time: t}))
+				}),
+			),
+			cel.Overload("age_timestamp", []*cel.Type{cel.TimestampType}, cel.StringType,
+				cel.UnaryBinding(func(v ref.Val) ref.Val {
+					return types.String(ToAge(metav1.Time{Time: v.(types.Timestamp).Time}))
+				}),
+			),
+		),
+		cel.Function("human",
+			cel.Overload("human_duration", []*cel.Type{cel.DurationType}, cel.StringType,
+				cel.UnaryBinding(func(v ref.Val) ref.Val {
+					return types.String(duration.HumanDuration(v.(types.Duration).Duration))
+				}),
+			),
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+	e.env = env
+
+	return e, nil
+}
+
+// setLookup sets the related resources lookup for the current refresh.
+func (e *exprEvaluator) setLookup(f LookupFunc) {
+	e.lookup.Store(&f)
+}
+
+// compile checks an expression and caches the resulting program.
+func (e *exprEvaluator) compile(spec string) (cel.Program, error) {
+	expr := strings.TrimPrefix(spec, exprPrefix)
+	if p, ok := e.progs.Load(expr); ok {
+		return p.(cel.Program), nil
+	}
+	ast, iss := e.env.Compile(expr)
+	if iss.Err() != nil {
+		return nil, iss.Err()
+	}
+	p, err := e.env.Program(ast,
+		cel.CostLimit(exprCostLimit),
+		cel.InterruptCheckFrequency(exprInterruptRate),
+	)
+	if err != nil {
+		return nil, err
+	}
+	e.progs.Store(expr, p)
+
+	return p, nil
+}
+
+// eval runs an expression against a resource and the row default columns.
+func (e *exprEvaluator) eval(p cel.Program, o runtime.Object, cols map[string]string) (string, error) {
+	v, err := e.run(p, o, cols)
+	if err != nil {
+		return "", err
+	}
+
+	return exprString(v), nil
+}
+
+// test runs a boolean expression against a resource and the row columns.
+func (e *exprEvaluator) test(p cel.Program, o runtime.Object, cols map[string]string) (bool, error) {
+	v, err := e.run(p, o, cols)
+	if err != nil {
+		return false, err
+	}
+	b, ok := v.(types.Bool)
+	if !ok {
+		return false, fmt.Errorf("expecting a boolean expression but got %s", v.Type().TypeName())
+	}
+
+	return bool(b), nil
+}
+
+func (*exprEvaluator) run(p cel.Program, o runtime.Object, cols map[string]string) (ref.Val, error) {
+	m := make(map[string]any)
+	switch u := o.(type) {
+	case nil:
+	case runtime.Unstructured:
+		m = u.UnstructuredContent()
+	default:
+		var err error
+		if m, err = runtime.DefaultUnstructuredConverter.ToUnstructured(o); err != nil {
+			return nil, err
+		}
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), exprTimeout)
+	defer cancel()
+	v, _, err := p.ContextEval(ctx, map[string]any{"object": m, "cols": cols})
+
+	return v, err
+}
+
+func (e *exprEvaluator) fetch(gvr ref.Val, fqn string) ref.Val {
+	l := e.lookup.Load()
+	if l == nil || *l == nil {
+		return emptyObj
+	}
+	o, ok := (*l)(fmt.Sprintf("%v", gvr.Value()), fqn)
+	if !ok {
+		return emptyObj
+	}
+
+	return types.DefaultTypeAdapter.NativeToValue(o)
+}
+
+// owner walks up a resource owner references until kind matches. A blank kind
+// yields the immediate controller.
+func (e *exprEvaluator) owner(v ref.Val, kind string) ref.Val {
+	m, err := toMap(v)
+	if err != nil {
+		return emptyObj
+	}
+	for range maxOwnerDepth {
+		av, k, n, ok := controllerOf(m)
+		if !ok {
+			return emptyObj
+		}
+		gvr := schema.FromAPIVersionAndKind(av, k)
+		res, _ := meta.UnsafeGuessKindToResource(gvr)
+		ns, _, _ := unstructured.NestedString(m, "metadata", "namespace")
+		val := e.fetch(types.String(res.GroupVersion().String()+"/"+res.Resource), client.FQN(ns, n))
+		if kind == "" || strings.EqualFold(kind, k) {
+			return val
+		}
+		if m, err = toMap(val); err != nil || len(m) == 0 {
+			return emptyObj
+		}
+	}
+
+	return emptyObj
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// rowCols maps rendered column names to their values.
+func rowCols(h model1.Header, row *model1.Row) map[string]string {
+	cols := make(map[string]string, len(h))
+	for i, c := range h {
+		if i < len(row.Fields) {
+			cols[c.Name] = row.Fields[i]
+		}
+	}
+
+	return cols
+}
+
+func controllerOf(m map[string]any) (apiVersion, kind, name string, ok bool) {
+	refs, _, _ := unstructured.NestedSlice(m, "metadata", "ownerReferences")
+	if len(refs) == 0 {
+		return "", "", "", false
+	}
+	rm, _ := refs[0].(map[string]any)
+	for _, r := range refs {
+		if c, _, _ := unstructured.NestedBool(r.(map[string]any), "controller"); c {
+			rm = r.(map[string]any)
+			break
+		}
+	}
+	apiVersion, _, _ = unstructured.NestedString(rm, "apiVersion")
+	kind, _, _ = unstructured.NestedString(rm, "kind")
+	name, _, _ = unstructured.NestedString(rm, "name")
+
+	return apiVersion, kind, name, name != ""
+}
+
+func toMap(v ref.Val) (map[string]any, error) {
+	o, err := v.ConvertToNative(mapType)
+	if err != nil {
+		return nil, err
+	}
+
+	return o.(map[string]any), nil
+}
+
+// exprString renders an expression result as a column value.
+func exprString(v ref.Val) string {
+	switch t := v.(type) {
+	case types.Null:
+		return MissingValue
+	case types.Duration:
+		return duration.HumanDuration(t.Duration)
+	case types.Timestamp:
+		return ToAge(metav1.Time{Time: t.Time})
+	case traits.Lister:
+		ss := make([]string, 0, int(t.Size().(types.Int)))
+		for it := t.Iterator(); it.HasNext() == types.True; {
+			ss = append(ss, exprString(it.Next()))
+		}
+		return strings.Join(ss, ",")
+	}
+	if v.Type() == types.OptionalType {
+		if o := v.(*types.Optional); o.HasValue() {
+			return exprString(o.GetValue())
+		}
+		return MissingValue
+	}
+
+	return fmt.Sprintf("%v", v.Value())
+}
+
+func logExprErr(col string, err error) {
+	slog.Debug("Custom column expression failed",
+		slogs.Name, col,
+		slogs.Error, err,
+	)
+}
@@ -0,0 +1,154 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestExprColumns(t *testing.T) {
+	uu := map[string]struct {
+		spec string
+		e    string
+	}{
+		"arithmetic": {
+			spec: `C:=object.spec.containers.size() * 10 + 1`,
+			e:    "11",
+		},
+		"conditional": {
+			spec: `C:=object.spec.nodeName == "minikube" ? "local" : "remote"`,
+			e:    "local",
+		},
+		"strings": {
+			spec: `C:=object.metadata.name.upperAscii() + "-" + object.spec.containers[0].image.split(":")[0]`,
+			e:    "NGINX-nginx",
+		},
+		"cols": {
+			spec: `C:=cols["NAME"] + "/" + cols["STATUS"]`,
+			e:    "nginx/Running",
+		},
+		"duration": {
+			spec: `C:=human(duration("90m"))`,
+			e:    "90m",
+		},
+		"list": {
+			spec: `C:=object.spec.containers.map(c, c.name)`,
+			e:    "nginx",
+		},
+		"optional": {
+			spec: `C:=object.metadata.?labels[?"zorg"].orValue("n/a")`,
+			e:    "n/a",
+		},
+		"missing": {
+			spec: `C:=object.metadata.labels.zorg`,
+			e:    MissingValue,
+		},
+		"node-zone": {
+			spec: `C:=lookup("v1/nodes", object.spec.nodeName).metadata.labels["topology.kubernetes.io/zone"]`,
+			e:    "us-east-1a",
+		},
+		"namespaced-lookup": {
+			spec: `C:=lookup("v1/configmaps", object.metadata.namespace, "fred").data.blee`,
+			e:    "duh",
+		},
+		"owner": {
+			spec: `C:=owner(object).metadata.name`,
+			e:    "nginx-rs",
+		},
+		"owner-kind": {
+			spec: `C:=owner(object, "Deployment").metadata.annotations["team"]`,
+			e:    "blue",
+		},
+		"no-owner": {
+			spec: `C:=owner(object, "StatefulSet").?metadata.?name.orValue("none")`,
+			e:    "none",
+		},
+		"flags": {
+			spec: `C:=object.spec.containers.size() > 0 || false|R`,
+			e:    "true",
+		},
+	}
+
+	o := load(t, "po")
+	o.SetOwnerReferences([]metav1.OwnerReference{
+		{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "nginx-rs", Controller: ptr.To(true)},
+	})
+	lookup := func(gvr, fqn string) (map[string]any, bool) {
+		m, ok := map[string]map[string]any{
+			"v1/nodes|-/minikube": {
+				"metadata": map[string]any{
+					"name":   "minikube",
+					"labels": map[string]any{"topology.kubernetes.io/zone": "us-east-1a"},
+				},
+			},
+			"v1/configmaps|default/fred": {
+				"data": map[string]any{"blee": "duh"},
+			},
+			"apps/v1/replicasets|default/nginx-rs": {
+				"metadata": map[string]any{
+					"name":      "nginx-rs",
+					"namespace": "default",
+					"ownerReferences": []any{
+						map[string]any{"apiVersion": "apps/v1", "kind": "Deployment", "name": "nginx", "controller": true},
+					},
+				},
+			},
+			"apps/v1/deployments|default/nginx": {
+				"metadata": map[string]any{
+					"name":        "nginx",
+					"annotations": map[string]any{"team": "blue"},
+				},
+			},
+		}[gvr+"|"+fqn]
+		return m, ok
+	}
+	rh := model1.Header{{Name: "NAME"}, {Name: "STATUS"}}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			e, err := newExprEvaluator()
+			require.NoError(t, err)
+			e.setLookup(lookup)
+			cc, err := ColsSpecs{u.spec}.parseSpecs(e)
+			require.NoError(t, err)
+
+			row := model1.Row{Fields: model1.Fields{"nginx", "Running"}}
+			cols, err := cc.realize(o, rh, &row)
+			require.NoError(t, err)
+			assert.Equal(t, u.e, cols[0].Value)
+		})
+	}
+}
+
+func TestExprColumnsInvalid(t *testing.T) {
+	_, err := ColsSpecs{`C:=object.metadata.name +`}.parseSpecs(nil)
+
+	assert.ErrorContains(t, err, `invalid column expression "C"`)
+}
+
+func TestExprColumnsNoLookup(t *testing.T) {
+	cc, err := ColsSpecs{`C:=lookup("v1/nodes", object.spec.nodeName).?metadata.?name.orValue("n/a")`}.parseSpecs(nil)
+	require.NoError(t, err)
+
+	row := model1.Row{Fields: model1.Fields{"nginx"}}
+	cols, err := cc.realize(load(t, "po"), model1.Header{{Name: "NAME"}}, &row)
+	require.NoError(t, err)
+	assert.Equal(t, "n/a", cols[0].Value)
+}
+
+func TestExprCostLimit(t *testing.T) {
+	e, err := newExprEvaluator()
+	require.NoError(t, err)
+	p, err := e.compile(`=[1,2,3,4,5,6,7,8,9,10].all(a, [1,2,3,4,5,6,7,8,9,10].all(b, [1,2,3,4,5,6,7,8,9,10].all(c, [1,2,3,4,5,6,7,8,9,10].all(d, [1,2,3,4,5,6,7,8,9,10].all(f, [1,2,3,4,5,6,7,8,9,10].all(g, a+b+c+d+f+g > 0))))))`)
+	require.NoError(t, err)
+
+	_, err = e.eval(p, nil, nil)
+	assert.ErrorContains(t, err, "cost limit exceeded")
+}
@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	dataKeyPreviewSize = 60
+	dataKeyMask        = "********"
+
+	// DataKeyText tracks a text value.
+	DataKeyText = "text"
+
+	// DataKeyBinary tracks a binary value.
+	DataKeyBinary = "binary"
+)
+
+// DataKey renders a secret or configmap data key to screen.
+type DataKey struct {
+	Base
+}
+
+// Header returns a header row.
+func (DataKey) Header(string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "KEY"},
+		model1.HeaderColumn{Name: "TYPE"},
+		model1.HeaderColumn{Name: "SIZE", Attrs: model1.Attrs{Align: tview.AlignRight}},
+		model1.HeaderColumn{Name: "VALUE"},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (DataKey) Render(o any, _ string, r *model1.Row) error {
+	res, ok := o.(DataKeyRes)
+	if !ok {
+		return fmt.Errorf("expected DataKeyRes, but got %T", o)
+	}
+
+	r.ID = res.Key
+	r.Fields = model1.Fields{
+		res.Key,
+		res.Type(),
+		strconv.Itoa(len(res.Value)),
+		res.Preview(),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// DataKeyRes represents a secret or configmap data entry.
+type DataKeyRes struct {
+	Key    string
+	Value  []byte
+	Binary bool
+	Secret bool
+}
+
+// Type returns the value type.
+func (d DataKeyRes) Type() string {
+	if d.Binary {
+		return DataKeyBinary
+	}
+
+	return DataKeyText
+}
+
+// Preview returns a single line value excerpt. Secret values are masked.
+func (d DataKeyRes) Preview() string {
+	switch {
+	case d.Secret:
+		return dataKeyMask
+	case d.Binary:
+		return "<binary>"
+	}
+	s := strings.TrimSpace(string(d.Value))
+	if i := strings.IndexByte(s, '\n'); i >= 0 {
+		s = s[:i] + "…"
+	}
+
+	return Truncate(s, dataKeyPreviewSize)
+}
+
+// GetObjectKind returns a schema object.
+func (DataKeyRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (d DataKeyRes) DeepCopyObject() runtime.Object {
+	return d
+}
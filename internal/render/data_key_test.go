@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataKeyRender(t *testing.T) {
+	uu := map[string]struct {
+		res render.DataKeyRes
+		e   model1.Fields
+	}{
+		"text": {
+			res: render.DataKeyRes{Key: "app.conf", Value: []byte("port=80\nhost=fred")},
+			e:   model1.Fields{"app.conf", "text", "17", "port=80…"},
+		},
+		"binary": {
+			res: render.DataKeyRes{Key: "ks.jks", Value: []byte{0x0, 0xff}, Binary: true},
+			e:   model1.Fields{"ks.jks", "binary", "2", "<binary>"},
+		},
+		"secret": {
+			res: render.DataKeyRes{Key: "password", Value: []byte("s3cr3t"), Secret: true},
+			e:   model1.Fields{"password", "text", "6", "********"},
+		},
+	}
+
+	var d render.DataKey
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			r := model1.NewRow(4)
+			require.NoError(t, d.Render(u.res, "", &r))
+			assert.Equal(t, u.res.Key, r.ID)
+			assert.Equal(t, u.e, r.Fields)
+		})
+	}
+}
@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var defaultEvArchiveHeader = model1.Header{
+	model1.HeaderColumn{Name: "NAMESPACE"},
+	model1.HeaderColumn{Name: "LAST SEEN", Attrs: model1.Attrs{Time: true}},
+	model1.HeaderColumn{Name: "TYPE"},
+	model1.HeaderColumn{Name: "REASON"},
+	model1.HeaderColumn{Name: "OBJECT"},
+	model1.HeaderColumn{Name: "SOURCE"},
+	model1.HeaderColumn{Name: "MESSAGE"},
+	model1.HeaderColumn{Name: "COUNT", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "FIRST SEEN", Attrs: model1.Attrs{Time: true}},
+}
+
+// EventArchive renders an archived event to screen.
+type EventArchive struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (EventArchive) ColorerFunc() model1.ColorerFunc {
+	return func(ns string, h model1.Header, re *model1.RowEvent) tcell.Color {
+		c := model1.DefaultColorer(ns, h, re)
+		idx, ok := h.IndexOf("TYPE", true)
+		if ok && strings.TrimSpace(re.Row.Fields[idx]) != "Normal" {
+			return model1.ErrColor
+		}
+
+		return c
+	}
+}
+
+// Header returns a header row.
+func (e EventArchive) Header(string) model1.Header {
+	return e.doHeader(defaultEvArchiveHeader)
+}
+
+// Render renders a K8s resource to screen.
+func (EventArchive) Render(o any, _ string, r *model1.Row) error {
+	ev, ok := o.(ArchivedEvent)
+	if !ok {
+		return fmt.Errorf("expected ArchivedEvent, but got %T", o)
+	}
+
+	r.ID = client.FQN(ev.Namespace, ev.Name)
+	r.Fields = model1.Fields{
+		ev.Namespace,
+		ToAge(metav1.NewTime(ev.LastSeen)),
+		ev.Type,
+		ev.Reason,
+		ev.Object,
+		ev.Source,
+		ev.Message,
+		strconv.Itoa(int(ev.Count)),
+		ToAge(metav1.NewTime(ev.FirstSeen)),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// ArchivedEvent represents an event persisted beyond its api server retention.
+type ArchivedEvent struct {
+	UID       string    `json:"uid"`
+	Namespace string    `json:"namespace"`
+	Name      string    `json:"name"`
+	Type      string    `json:"type"`
+	Reason    string    `json:"reason"`
+	Object    string    `json:"object"`
+	Source    string    `json:"source"`
+	Message   string    `json:"message"`
+	Count     int32     `json:"count"`
+	FirstSeen time.Time `json:"firstSeen"`
+	LastSeen  time.Time `json:"lastSeen"`
+}
+
+// GetObjectKind returns a schema object.
+func (ArchivedEvent) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (e ArchivedEvent) DeepCopyObject() runtime.Object {
+	return e
+}
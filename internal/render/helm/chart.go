@@ -42,6 +42,7 @@ func (Chart) Header(_ string) model1.Header {
 		model1.HeaderColumn{Name: "NAME"},
 		model1.HeaderColumn{Name: "REVISION"},
 		model1.HeaderColumn{Name: "STATUS"},
+		model1.HeaderColumn{Name: "READY"},
 		model1.HeaderColumn{Name: "CHART"},
 		model1.HeaderColumn{Name: "APP VERSION"},
 		model1.HeaderColumn{Name: "VALID", Attrs: model1.Attrs{Wide: true}},
@@ -62,9 +63,10 @@ func (c Chart) Render(o any, _ string, r *model1.Row) error {
 		h.Release.Name,
 		strconv.Itoa(h.Release.Version),
 		h.Release.Info.Status.String(),
+		h.readiness(),
 		h.Release.Chart.Metadata.Name + "-" + h.Release.Chart.Metadata.Version,
 		h.Release.Chart.Metadata.AppVersion,
-		render.AsStatus(c.diagnose(h.Release.Info.Status.String())),
+		render.AsStatus(c.diagnose(h)),
 		render.ToAge(metav1.Time{Time: h.Release.Info.LastDeployed.Time}),
 	}
 
@@ -78,13 +80,16 @@ func (c Chart) Healthy(_ context.Context, o any) error {
 		slog.Error("Expected *ReleaseRes, but got", slogs.Type, fmt.Sprintf("%T", o))
 	}
 
-	return c.diagnose(h.Release.Info.Status.String())
+	return c.diagnose(*h)
 }
 
-func (Chart) diagnose(s string) error {
-	if s != "deployed" {
+func (Chart) diagnose(h ReleaseRes) error {
+	if h.Release.Info.Status.String() != "deployed" {
 		return fmt.Errorf("chart is in an invalid state")
 	}
+	if h.Ready < h.Total {
+		return fmt.Errorf("%d/%d resources not ready", h.Total-h.Ready, h.Total)
+	}
 
 	return nil
 }
@@ -95,6 +100,17 @@ func (Chart) diagnose(s string) error {
 // ReleaseRes represents a helm chart resource.
 type ReleaseRes struct {
 	Release *release.Release
+
+	// Ready and Total track the release resources health.
+	Ready, Total int
+}
+
+func (h ReleaseRes) readiness() string {
+	if h.Total == 0 {
+		return render.NAValue
+	}
+
+	return strconv.Itoa(h.Ready) + "/" + strconv.Itoa(h.Total)
 }
 
 // GetObjectKind returns a schema object.
@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package helm_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render/helm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/release"
+)
+
+func TestChartRender(t *testing.T) {
+	uu := map[string]struct {
+		ready, total int
+		status       release.Status
+		e            model1.Fields
+	}{
+		"healthy": {
+			ready:  3,
+			total:  3,
+			status: release.StatusDeployed,
+			e:      model1.Fields{"ns1", "fred", "2", "deployed", "3/3", "blee-0.1.0", "1.0", ""},
+		},
+		"not-ready": {
+			ready:  2,
+			total:  3,
+			status: release.StatusDeployed,
+			e:      model1.Fields{"ns1", "fred", "2", "deployed", "2/3", "blee-0.1.0", "1.0", "1/3 resources not ready"},
+		},
+		"failed": {
+			status: release.StatusFailed,
+			e:      model1.Fields{"ns1", "fred", "2", "failed", "n/a", "blee-0.1.0", "1.0", "chart is in an invalid state"},
+		},
+	}
+
+	var c helm.Chart
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			rel := release.Release{
+				Name:      "fred",
+				Namespace: "ns1",
+				Version:   2,
+				Info:      &release.Info{Status: u.status},
+				Chart:     &chart.Chart{Metadata: &chart.Metadata{Name: "blee", Version: "0.1.0", AppVersion: "1.0"}},
+			}
+			var row model1.Row
+			require.NoError(t, c.Render(helm.ReleaseRes{Release: &rel, Ready: u.ready, Total: u.total}, "", &row))
+			assert.Equal(t, "ns1/fred", row.ID)
+			assert.Equal(t, u.e, row.Fields[:len(row.Fields)-1])
+		})
+	}
+}
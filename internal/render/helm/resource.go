@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package helm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ResourceMissing denotes a release resource that no longer exists.
+const ResourceMissing = "Missing"
+
+// Resource renders a helm release resource to screen.
+type Resource struct{}
+
+// IsGeneric identifies a generic handler.
+func (Resource) IsGeneric() bool {
+	return false
+}
+
+func (Resource) SetViewSetting(*config.ViewSetting) {}
+
+// ColorerFunc colors a resource row.
+func (Resource) ColorerFunc() model1.ColorerFunc {
+	return model1.DefaultColorer
+}
+
+// Header returns a header row.
+func (Resource) Header(_ string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "KIND"},
+		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "STATUS"},
+		model1.HeaderColumn{Name: "READY"},
+		model1.HeaderColumn{Name: "GVR", Attrs: model1.Attrs{Wide: true}},
+		model1.HeaderColumn{Name: "VALID", Attrs: model1.Attrs{Wide: true}},
+		model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true}},
+	}
+}
+
+// Render renders a release resource to screen.
+func (Resource) Render(o any, _ string, r *model1.Row) error {
+	res, ok := o.(ResourceRes)
+	if !ok {
+		return fmt.Errorf("expected ResourceRes, but got %T", o)
+	}
+
+	ns, n := client.Namespaced(res.Path)
+	r.ID = ResourceID(res.GVR, res.Path)
+	r.Fields = model1.Fields{
+		res.Kind,
+		ns,
+		n,
+		res.Status,
+		res.Ready,
+		res.GVR,
+		res.Issue,
+		render.ToAge(metav1.NewTime(res.Created)),
+	}
+
+	return nil
+}
+
+// Healthy checks component health.
+func (Resource) Healthy(_ context.Context, o any) error {
+	res, ok := o.(ResourceRes)
+	if !ok || res.IsHealthy() {
+		return nil
+	}
+
+	return errors.New(res.Issue)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// ResourceID returns a release resource identifier.
+func ResourceID(gvr, path string) string {
+	return gvr + " " + path
+}
+
+// ResourceFromID returns a release resource gvr and path from its identifier.
+func ResourceFromID(id string) (gvr, path string) {
+	gvr, path, _ = strings.Cut(id, " ")
+
+	return
+}
+
+// ResourceRes represents a resource managed by a helm release.
+type ResourceRes struct {
+	GVR     string
+	Kind    string
+	Path    string
+	Status  string
+	Ready   string
+	Issue   string
+	Created time.Time
+}
+
+// IsHealthy checks if the resource is in good standing.
+func (r ResourceRes) IsHealthy() bool {
+	return r.Issue == ""
+}
+
+// GetObjectKind returns a schema object.
+func (ResourceRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (r ResourceRes) DeepCopyObject() runtime.Object {
+	return r
+}
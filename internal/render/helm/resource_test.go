@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package helm_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render/helm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceRender(t *testing.T) {
+	uu := map[string]struct {
+		res helm.ResourceRes
+		e   model1.Fields
+	}{
+		"healthy": {
+			res: helm.ResourceRes{
+				GVR:    "apps/v1/deployments",
+				Kind:   "Deployment",
+				Path:   "ns1/fred",
+				Status: "Available",
+				Ready:  "1/1",
+			},
+			e: model1.Fields{"Deployment", "ns1", "fred", "Available", "1/1", "apps/v1/deployments", ""},
+		},
+		"missing": {
+			res: helm.ResourceRes{
+				GVR:    "v1/configmaps",
+				Kind:   "ConfigMap",
+				Path:   "ns1/blee",
+				Status: helm.ResourceMissing,
+				Ready:  "n/a",
+				Issue:  "resource no longer exists",
+			},
+			e: model1.Fields{"ConfigMap", "ns1", "blee", "Missing", "n/a", "v1/configmaps", "resource no longer exists"},
+		},
+	}
+
+	var r helm.Resource
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var row model1.Row
+			require.NoError(t, r.Render(u.res, "", &row))
+			assert.Equal(t, helm.ResourceID(u.res.GVR, u.res.Path), row.ID)
+			assert.Equal(t, u.e, row.Fields[:len(row.Fields)-1])
+		})
+	}
+}
+
+func TestResourceFromID(t *testing.T) {
+	gvr, path := helm.ResourceFromID(helm.ResourceID("apps/v1/deployments", "ns1/fred"))
+
+	assert.Equal(t, "apps/v1/deployments", gvr)
+	assert.Equal(t, "ns1/fred", path)
+}
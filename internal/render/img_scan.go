@@ -5,11 +5,13 @@ package render
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/derailed/k9s/internal/model1"
 	"github.com/derailed/k9s/internal/vul"
 	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -63,6 +65,7 @@ func (ImageScan) Header(string) model1.Header {
 		model1.HeaderColumn{Name: "VERSION"},
 		model1.HeaderColumn{Name: "FIXED-IN"},
 		model1.HeaderColumn{Name: "TYPE"},
+		model1.HeaderColumn{Name: "DESCRIPTION", Attrs: model1.Attrs{Wide: true}},
 	}
 }
 
@@ -82,6 +85,7 @@ func (ImageScan) Render(o any, _ string, r *model1.Row) error {
 		res.Row.Version(),
 		res.Row.Fix(),
 		res.Row.Type(),
+		res.Description,
 	}
 
 	return nil
@@ -92,8 +96,9 @@ func (ImageScan) Render(o any, _ string, r *model1.Row) error {
 
 // ImageScanRes represents a container and its metrics.
 type ImageScanRes struct {
-	Image string
-	Row   vul.Row
+	Image       string
+	Row         vul.Row
+	Description string
 }
 
 // GetObjectKind returns a schema object.
@@ -105,3 +110,70 @@ func (ImageScanRes) GetObjectKind() schema.ObjectKind {
 func (is ImageScanRes) DeepCopyObject() runtime.Object {
 	return is
 }
+
+// SBOM renders an image packages inventory.
+type SBOM struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (SBOM) ColorerFunc() model1.ColorerFunc {
+	return func(ns string, h model1.Header, re *model1.RowEvent) tcell.Color {
+		c := model1.DefaultColorer(ns, h, re)
+		idx, ok := h.IndexOf("VULNS", true)
+		if ok && strings.TrimSpace(re.Row.Fields[idx]) != "0" {
+			return tcell.ColorDarkOrange
+		}
+
+		return c
+	}
+}
+
+// Header returns a header row.
+func (SBOM) Header(string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "VERSION"},
+		model1.HeaderColumn{Name: "TYPE"},
+		model1.HeaderColumn{Name: "VULNS", Attrs: model1.Attrs{Align: tview.AlignRight}},
+		model1.HeaderColumn{Name: "PURL", Attrs: model1.Attrs{Wide: true}},
+		model1.HeaderColumn{Name: "IMAGE", Attrs: model1.Attrs{Wide: true}},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (SBOM) Render(o any, _ string, r *model1.Row) error {
+	res, ok := o.(SBOMRes)
+	if !ok {
+		return fmt.Errorf("expected SBOMRes, but got %T", o)
+	}
+
+	r.ID = fmt.Sprintf("%s|%s|%s|%s", res.Image, res.Package.Name, res.Package.Version, res.Package.Type)
+	r.Fields = model1.Fields{
+		res.Package.Name,
+		res.Package.Version,
+		res.Package.Type,
+		strconv.Itoa(res.Vulns),
+		res.Package.PURL,
+		res.Image,
+	}
+
+	return nil
+}
+
+// SBOMRes represents an image cataloged package.
+type SBOMRes struct {
+	Image   string
+	Package vul.Package
+	Vulns   int
+}
+
+// GetObjectKind returns a schema object.
+func (SBOMRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (s SBOMRes) DeepCopyObject() runtime.Object {
+	return s
+}
@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tview"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// PinMissing denotes a pinned resource that no longer exists.
+const PinMissing = "Missing"
+
+// Pin renders a pinned resource to screen.
+type Pin struct {
+	Base
+}
+
+// Header returns a header row.
+func (Pin) Header(string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "RESOURCE"},
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "STATUS"},
+		model1.HeaderColumn{Name: "READY"},
+		model1.HeaderColumn{Name: "EVENTS", Attrs: model1.Attrs{Align: tview.AlignRight}},
+		model1.HeaderColumn{Name: "GVR", Attrs: model1.Attrs{Wide: true}},
+		model1.HeaderColumn{Name: "VALID", Attrs: model1.Attrs{Wide: true}},
+		model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true}},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Pin) Render(o any, _ string, r *model1.Row) error {
+	p, ok := o.(PinRes)
+	if !ok {
+		return fmt.Errorf("expected PinRes, but got %T", o)
+	}
+
+	r.ID = PinID(p.GVR, p.Path)
+	r.Fields = model1.Fields{
+		client.NewGVR(p.GVR).R(),
+		p.Path,
+		p.Status,
+		p.Ready,
+		strconv.Itoa(p.Events),
+		p.GVR,
+		p.Issue,
+		ToAge(metav1.NewTime(p.Created)),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// PinID returns a pinned resource identifier.
+func PinID(gvr, path string) string {
+	return gvr + " " + path
+}
+
+// PinFromID returns a pinned resource gvr and path from its identifier.
+func PinFromID(id string) (gvr, path string) {
+	gvr, path, _ = strings.Cut(id, " ")
+
+	return
+}
+
+// PinRes represents a pinned resource status.
+type PinRes struct {
+	GVR     string
+	Path    string
+	Status  string
+	Ready   string
+	Events  int
+	Issue   string
+	Created time.Time
+}
+
+// IsHealthy checks if the pinned resource is in good standing.
+func (p PinRes) IsHealthy() bool {
+	return p.Issue == ""
+}
+
+// GetObjectKind returns a schema object.
+func (PinRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (p PinRes) DeepCopyObject() runtime.Object {
+	return p
+}
@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPinRender(t *testing.T) {
+	uu := map[string]struct {
+		p  render.PinRes
+		ee model1.Fields
+	}{
+		"healthy": {
+			p: render.PinRes{
+				GVR:     "apps/v1/deployments",
+				Path:    "default/nginx",
+				Status:  "Available",
+				Ready:   "2/2",
+				Events:  3,
+				Created: time.Now().Add(-2 * time.Hour),
+			},
+			ee: model1.Fields{"deployments", "default/nginx", "Available", "2/2", "3", "apps/v1/deployments", "", "120m"},
+		},
+		"missing": {
+			p: render.PinRes{
+				GVR:    "v1/nodes",
+				Path:   "n1",
+				Status: render.PinMissing,
+				Ready:  render.NAValue,
+				Issue:  "resource no longer exists",
+			},
+			ee: model1.Fields{"nodes", "n1", "Missing", "n/a", "0", "v1/nodes", "resource no longer exists", render.UnknownValue},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r model1.Row
+			require.NoError(t, render.Pin{}.Render(u.p, "", &r))
+			assert.Equal(t, render.PinID(u.p.GVR, u.p.Path), r.ID)
+			assert.Equal(t, u.ee, r.Fields)
+
+			gvr, path := render.PinFromID(r.ID)
+			assert.Equal(t, u.p.GVR, gvr)
+			assert.Equal(t, u.p.Path, path)
+		})
+	}
+}
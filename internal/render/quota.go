@@ -0,0 +1,279 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var defaultRQHeader = model1.Header{
+	model1.HeaderColumn{Name: "NAMESPACE"},
+	model1.HeaderColumn{Name: "NAME"},
+	model1.HeaderColumn{Name: "RESOURCES", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "%MAX", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "MAX-RESOURCE"},
+	model1.HeaderColumn{Name: "USAGE", Attrs: model1.Attrs{Wide: true}},
+	model1.HeaderColumn{Name: "LABELS", Attrs: model1.Attrs{Wide: true}},
+	model1.HeaderColumn{Name: "VALID", Attrs: model1.Attrs{Wide: true}},
+	model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true}},
+}
+
+// ResourceQuota renders a K8s ResourceQuota to screen.
+type ResourceQuota struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (ResourceQuota) ColorerFunc() model1.ColorerFunc {
+	return QuotaColorer(config.NewSeverity())
+}
+
+// QuotaColorer colors quota rows whose consumption exceeds the given severity.
+func QuotaColorer(sev *config.Severity) model1.ColorerFunc {
+	if sev == nil {
+		sev = config.NewSeverity()
+	}
+	return func(ns string, h model1.Header, re *model1.RowEvent) tcell.Color {
+		c := model1.DefaultColorer(ns, h, re)
+		idx, ok := h.IndexOf("%MAX", true)
+		if !ok {
+			return c
+		}
+		perc, err := strconv.Atoi(strings.TrimSpace(re.Row.Fields[idx]))
+		if err != nil {
+			return c
+		}
+		switch {
+		case perc >= sev.Critical:
+			return model1.ErrColor
+		case perc >= sev.Warn:
+			return model1.PendingColor
+		default:
+			return c
+		}
+	}
+}
+
+// Header returns a header row.
+func (r ResourceQuota) Header(string) model1.Header {
+	return r.doHeader(defaultRQHeader)
+}
+
+// Render renders a K8s resource to screen.
+func (r ResourceQuota) Render(o any, _ string, row *model1.Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expected Unstructured, but got %T", o)
+	}
+	if err := r.defaultRow(raw, row); err != nil {
+		return err
+	}
+	if r.specs.isEmpty() {
+		return nil
+	}
+	cols, err := r.specs.realize(raw, defaultRQHeader, row)
+	if err != nil {
+		return err
+	}
+	cols.hydrateRow(row)
+
+	return nil
+}
+
+func (r ResourceQuota) defaultRow(raw *unstructured.Unstructured, row *model1.Row) error {
+	var q v1.ResourceQuota
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &q)
+	if err != nil {
+		return err
+	}
+
+	uu := QuotaUsages(&q)
+	top, ss := MaxQuotaUsage(uu), make([]string, 0, len(uu))
+	for _, u := range uu {
+		ss = append(ss, fmt.Sprintf("%s: %s/%s", u.Resource, u.Used.String(), u.Hard.String()))
+	}
+	perc, res := NAValue, NAValue
+	if top != nil {
+		perc, res = strconv.Itoa(top.Percent()), string(top.Resource)
+	}
+
+	row.ID = client.MetaFQN(&q.ObjectMeta)
+	row.Fields = model1.Fields{
+		q.Namespace,
+		q.Name,
+		strconv.Itoa(len(uu)),
+		perc,
+		res,
+		strings.Join(ss, ", "),
+		mapToStr(q.Labels),
+		AsStatus(r.diagnose(top)),
+		ToAge(q.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+func (ResourceQuota) diagnose(top *QuotaUsage) error {
+	if top == nil || top.Percent() < 100 {
+		return nil
+	}
+
+	return fmt.Errorf("quota exhausted for %s", top.Resource)
+}
+
+// QuotaUsage tracks a quota resource consumption.
+type QuotaUsage struct {
+	Quota    string
+	Resource v1.ResourceName
+	Used     resource.Quantity
+	Hard     resource.Quantity
+}
+
+// Percent returns the quota consumption as a percentage of its hard limit.
+func (q QuotaUsage) Percent() int {
+	if q.Hard.IsZero() {
+		if q.Used.IsZero() {
+			return 0
+		}
+		return 100
+	}
+
+	return client.ToPercentage(q.Used.MilliValue(), q.Hard.MilliValue())
+}
+
+// QuotaUsages returns a quota consumption sorted by resource names.
+func QuotaUsages(q *v1.ResourceQuota) []QuotaUsage {
+	uu := make([]QuotaUsage, 0, len(q.Status.Hard))
+	for k, hard := range q.Status.Hard {
+		uu = append(uu, QuotaUsage{
+			Quota:    q.Name,
+			Resource: k,
+			Used:     q.Status.Used[k],
+			Hard:     hard,
+		})
+	}
+	sort.Slice(uu, func(i, j int) bool {
+		return uu[i].Resource < uu[j].Resource
+	})
+
+	return uu
+}
+
+// MaxQuotaUsage returns the most consumed quota resource if any.
+func MaxQuotaUsage(uu []QuotaUsage) *QuotaUsage {
+	var top *QuotaUsage
+	for i := range uu {
+		if top == nil || uu[i].Percent() > top.Percent() {
+			top = &uu[i]
+		}
+	}
+
+	return top
+}
+
+var defaultLRHeader = model1.Header{
+	model1.HeaderColumn{Name: "NAMESPACE"},
+	model1.HeaderColumn{Name: "NAME"},
+	model1.HeaderColumn{Name: "TYPES"},
+	model1.HeaderColumn{Name: "DEFAULT-REQUEST"},
+	model1.HeaderColumn{Name: "DEFAULT-LIMIT"},
+	model1.HeaderColumn{Name: "MIN", Attrs: model1.Attrs{Wide: true}},
+	model1.HeaderColumn{Name: "MAX", Attrs: model1.Attrs{Wide: true}},
+	model1.HeaderColumn{Name: "LABELS", Attrs: model1.Attrs{Wide: true}},
+	model1.HeaderColumn{Name: "VALID", Attrs: model1.Attrs{Wide: true}},
+	model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true}},
+}
+
+// LimitRange renders a K8s LimitRange to screen.
+type LimitRange struct {
+	Base
+}
+
+// Header returns a header row.
+func (l LimitRange) Header(string) model1.Header {
+	return l.doHeader(defaultLRHeader)
+}
+
+// Render renders a K8s resource to screen.
+func (l LimitRange) Render(o any, _ string, row *model1.Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expected Unstructured, but got %T", o)
+	}
+	if err := l.defaultRow(raw, row); err != nil {
+		return err
+	}
+	if l.specs.isEmpty() {
+		return nil
+	}
+	cols, err := l.specs.realize(raw, defaultLRHeader, row)
+	if err != nil {
+		return err
+	}
+	cols.hydrateRow(row)
+
+	return nil
+}
+
+func (LimitRange) defaultRow(raw *unstructured.Unstructured, row *model1.Row) error {
+	var lr v1.LimitRange
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &lr)
+	if err != nil {
+		return err
+	}
+
+	tt := make([]string, 0, len(lr.Spec.Limits))
+	var defReq, defLim, lo, hi []string
+	for _, item := range lr.Spec.Limits {
+		tt = append(tt, string(item.Type))
+		defReq = append(defReq, resourceListToStr(item.DefaultRequest)...)
+		defLim = append(defLim, resourceListToStr(item.Default)...)
+		lo = append(lo, resourceListToStr(item.Min)...)
+		hi = append(hi, resourceListToStr(item.Max)...)
+	}
+
+	row.ID = client.MetaFQN(&lr.ObjectMeta)
+	row.Fields = model1.Fields{
+		lr.Namespace,
+		lr.Name,
+		naStrings(tt),
+		naStrings(defReq),
+		naStrings(defLim),
+		naStrings(lo),
+		naStrings(hi),
+		mapToStr(lr.Labels),
+		"",
+		ToAge(lr.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+func resourceListToStr(rl v1.ResourceList) []string {
+	kk := make([]string, 0, len(rl))
+	for k := range rl {
+		kk = append(kk, string(k))
+	}
+	sort.Strings(kk)
+	ss := make([]string, 0, len(kk))
+	for _, k := range kk {
+		q := rl[v1.ResourceName(k)]
+		ss = append(ss, k+"="+q.String())
+	}
+
+	return ss
+}
@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestResourceQuotaRender(t *testing.T) {
+	c := render.ResourceQuota{}
+	r := model1.NewRow(9)
+
+	require.NoError(t, c.Render(load(t, "rq"), "", &r))
+	assert.Equal(t, "team-a/compute", r.ID)
+	assert.Equal(t, model1.Fields{
+		"team-a",
+		"compute",
+		"3",
+		"90",
+		"requests.cpu",
+		"pods: 3/10, requests.cpu: 1800m/2, requests.memory: 1Gi/4Gi",
+	}, r.Fields[:6])
+	assert.Empty(t, r.Fields[7])
+}
+
+func TestLimitRangeRender(t *testing.T) {
+	c := render.LimitRange{}
+	r := model1.NewRow(10)
+
+	require.NoError(t, c.Render(load(t, "lr"), "", &r))
+	assert.Equal(t, "team-a/defaults", r.ID)
+	assert.Equal(t, model1.Fields{
+		"team-a",
+		"defaults",
+		"Container",
+		"cpu=100m,memory=128Mi",
+		"cpu=500m,memory=512Mi",
+		"cpu=50m",
+		"cpu=2",
+	}, r.Fields[:7])
+}
+
+func TestQuotaUsagePercent(t *testing.T) {
+	uu := map[string]struct {
+		u render.QuotaUsage
+		e int
+	}{
+		"empty": {},
+		"cpu": {
+			u: render.QuotaUsage{Used: resource.MustParse("500m"), Hard: resource.MustParse("2")},
+			e: 25,
+		},
+		"memory": {
+			u: render.QuotaUsage{Used: resource.MustParse("3Gi"), Hard: resource.MustParse("4Gi")},
+			e: 75,
+		},
+		"no-hard": {
+			u: render.QuotaUsage{Used: resource.MustParse("1")},
+			e: 100,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.u.Percent())
+		})
+	}
+}
@@ -0,0 +1,259 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"log/slog"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/slogs"
+	"github.com/google/cel-go/cel"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/jsonpath"
+	"k8s.io/kubectl/pkg/cmd/get"
+)
+
+const validCol = "VALID"
+
+// RowRules represents a collection of custom row rules. First match wins.
+type RowRules []RowRule
+
+// RowRule represents a compiled custom row rule.
+type RowRule struct {
+	config.RowRule
+
+	expr cel.Program
+	eval *exprEvaluator
+	path *jsonpath.JSONPath
+	rx   *regexp.Regexp
+}
+
+// NewRowRules compiles custom row rules.
+func NewRowRules(rr ...config.RowRule) (RowRules, error) {
+	return newRowRules(nil, rr...)
+}
+
+func newRowRules(e *exprEvaluator, rr ...config.RowRule) (RowRules, error) {
+	rules := make(RowRules, 0, len(rr))
+	for i, r := range rr {
+		rule := RowRule{RowRule: r}
+		var err error
+		if r.Match != "" {
+			if rule.rx, err = regexp.Compile(r.Match); err != nil {
+				return nil, fmt.Errorf("invalid rule #%d match %q: %w", i, r.Match, err)
+			}
+		}
+		switch {
+		case r.Expr != "":
+			if e == nil {
+				if e, err = newExprEvaluator(); err != nil {
+					return nil, err
+				}
+			}
+			if rule.expr, err = e.compile(r.Expr); err != nil {
+				return nil, fmt.Errorf("invalid rule #%d expression: %w", i, err)
+			}
+			rule.eval = e
+		case r.Path != "":
+			spec, err := get.RelaxedJSONPathExpression(r.Path)
+			if err != nil {
+				return nil, fmt.Errorf("invalid rule #%d path: %w", i, err)
+			}
+			rule.path = jsonpath.New(fmt.Sprintf("rule%d", i)).AllowMissingKeys(true)
+			if err := rule.path.Parse(spec); err != nil {
+				return nil, fmt.Errorf("invalid rule #%d path: %w", i, err)
+			}
+		case r.Column == "":
+			return nil, fmt.Errorf("invalid rule #%d: one of expr, path or column is required", i)
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+// SetLookup sets the related resources lookup used by rules expressions.
+func (rr RowRules) SetLookup(f LookupFunc) {
+	for _, r := range rr {
+		if r.eval != nil {
+			r.eval.setLookup(f)
+		}
+	}
+}
+
+// Header appends the rules bookkeeping columns to a given header.
+func (rr RowRules) Header(h model1.Header) model1.Header {
+	if len(rr) == 0 {
+		return h
+	}
+	if _, ok := h.IndexOf(validCol, true); !ok {
+		h = append(h, model1.HeaderColumn{Name: validCol, Attrs: model1.Attrs{Wide: true}})
+	}
+
+	return append(h, model1.HeaderColumn{Name: model1.SeverityCol, Attrs: model1.Attrs{Hide: true}})
+}
+
+// Match returns the first rule matching a resource if any.
+func (rr RowRules) Match(o runtime.Object, h model1.Header, row *model1.Row) (RowRule, bool) {
+	cols := rowCols(h, row)
+	for _, r := range rr {
+		ok, err := r.matches(o, cols)
+		if err != nil {
+			slog.Debug("Row rule evaluation failed",
+				slogs.RowID, row.ID,
+				slogs.Error, err,
+			)
+			continue
+		}
+		if ok {
+			return r, true
+		}
+	}
+
+	return RowRule{}, false
+}
+
+// MatchResource renders a resource and returns the first matching rule if any.
+func (rr RowRules) MatchResource(re model1.Renderer, ns string, o any) (RowRule, bool) {
+	var (
+		row model1.Row
+		h   model1.Header
+	)
+	if _, ok := o.(metav1.TableRow); !ok {
+		if err := re.Render(o, ns, &row); err == nil {
+			h = re.Header(ns)
+		}
+	}
+
+	return rr.Match(ruleObject(o), h, &row)
+}
+
+// Apply annotates a rendered row with the first matching rule. Faults are
+// surfaced in the VALID column so rules feed into the faults filter and health.
+func (rr RowRules) Apply(o runtime.Object, h model1.Header, row *model1.Row) {
+	for len(row.Fields) < len(h) {
+		row.Fields = append(row.Fields, "")
+	}
+	r, ok := rr.Match(o, h, row)
+	if !ok {
+		return
+	}
+	if idx, ok := h.IndexOf(model1.SeverityCol, true); ok {
+		row.Fields[idx] = r.Severity
+		if r.Color != "" {
+			row.Fields[idx] += ":" + r.Color
+		}
+	}
+	idx, ok := h.IndexOf(validCol, true)
+	if !ok {
+		return
+	}
+	switch {
+	case r.IsFault():
+		row.Fields[idx] = r.message()
+	case r.Severity == config.SeverityOK || r.Severity == config.SeverityCompleted:
+		row.Fields[idx] = ""
+	}
+}
+
+func (r RowRule) matches(o runtime.Object, cols map[string]string) (bool, error) {
+	switch {
+	case r.expr != nil:
+		return r.eval.test(r.expr, o, cols)
+	case r.path != nil:
+		if o == nil {
+			return false, nil
+		}
+		var (
+			vals [][]reflect.Value
+			err  error
+		)
+		if u, ok := o.(runtime.Unstructured); ok {
+			vals, err = r.path.FindResults(u.UnstructuredContent())
+		} else {
+			vals, err = r.path.FindResults(reflect.ValueOf(o).Elem().Interface())
+		}
+		if err != nil {
+			return false, err
+		}
+		ss := make([]string, 0, len(vals))
+		for i := range vals {
+			for j := range vals[i] {
+				ss = append(ss, fmt.Sprintf("%v", vals[i][j].Interface()))
+			}
+		}
+		return r.test(strings.Join(ss, ",")), nil
+	default:
+		v, ok := cols[r.Column]
+		if !ok {
+			return false, fmt.Errorf("no column named %q", r.Column)
+		}
+		return r.test(v), nil
+	}
+}
+
+func (r RowRule) test(v string) bool {
+	if r.rx == nil {
+		return v != ""
+	}
+
+	return r.rx.MatchString(v)
+}
+
+func (r RowRule) message() string {
+	if r.Message != "" {
+		return r.Message
+	}
+	cond := r.Expr
+	if cond == "" {
+		cond = r.Path + r.Column
+		if r.Match != "" {
+			cond += " ~ " + r.Match
+		}
+	}
+
+	return "rule " + cond
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// ruleObject extracts the underlying resource from a rendered object.
+func ruleObject(o any) runtime.Object {
+	switch t := o.(type) {
+	case *PodWithMetrics:
+		if t.Raw != nil {
+			return t.Raw
+		}
+		return nil
+	case *NodeWithMetrics:
+		if t.Raw != nil {
+			return t.Raw
+		}
+		return nil
+	case metav1.TableRow:
+		if t.Object.Object != nil {
+			return t.Object.Object
+		}
+		if t.Object.Raw == nil {
+			return nil
+		}
+		var u unstructured.Unstructured
+		if err := u.UnmarshalJSON(t.Object.Raw); err != nil {
+			return nil
+		}
+		return &u
+	case runtime.Object:
+		return t
+	default:
+		return nil
+	}
+}
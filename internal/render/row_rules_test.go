@@ -0,0 +1,165 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestNewRowRules(t *testing.T) {
+	uu := map[string]struct {
+		rr  []config.RowRule
+		err string
+	}{
+		"empty": {},
+		"ok": {
+			rr: []config.RowRule{
+				{Expr: `object.status.phase == "Failed"`},
+				{Path: ".status.phase", Match: "^Failed$"},
+				{Column: "STATUS"},
+			},
+		},
+		"no-condition": {
+			rr:  []config.RowRule{{Severity: config.SeverityError}},
+			err: "invalid rule #0: one of expr, path or column is required",
+		},
+		"bad-expr": {
+			rr:  []config.RowRule{{Expr: `object.status ==`}},
+			err: "invalid rule #0 expression",
+		},
+		"bad-path": {
+			rr:  []config.RowRule{{Path: "{.status"}},
+			err: "invalid rule #0 path",
+		},
+		"bad-match": {
+			rr:  []config.RowRule{{Column: "A"}, {Column: "A", Match: "("}},
+			err: `invalid rule #1 match "("`,
+		},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			rr, err := NewRowRules(u.rr...)
+			if u.err != "" {
+				assert.ErrorContains(t, err, u.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, rr, len(u.rr))
+		})
+	}
+}
+
+func TestRowRulesApply(t *testing.T) {
+	uu := map[string]struct {
+		rr       []config.RowRule
+		valid    string
+		sev, err string
+	}{
+		"no-match": {
+			rr: []config.RowRule{{Path: ".status.phase", Match: "^Failed$", Severity: config.SeverityError}},
+		},
+		"path": {
+			rr:  []config.RowRule{{Path: ".status.phase", Match: "^Running$", Severity: config.SeverityError, Message: "boom"}},
+			sev: "error",
+			err: "boom",
+		},
+		"expr": {
+			rr:  []config.RowRule{{Expr: `object.spec.nodeName == "minikube" && cols["STATUS"] == "Running"`, Severity: config.SeverityWarn}},
+			sev: "warn",
+			err: `rule object.spec.nodeName == "minikube" && cols["STATUS"] == "Running"`,
+		},
+		"column": {
+			rr:  []config.RowRule{{Column: "STATUS", Match: "Run", Severity: config.SeverityError}},
+			sev: "error",
+			err: "rule STATUS ~ Run",
+		},
+		"first-match": {
+			rr: []config.RowRule{
+				{Column: "STATUS", Match: "Zorg", Severity: config.SeverityError},
+				{Column: "STATUS", Match: "Running", Severity: config.SeverityCompleted, Color: "gray"},
+				{Column: "STATUS", Severity: config.SeverityError},
+			},
+			sev: "completed:gray",
+		},
+		"ok-clears": {
+			rr:    []config.RowRule{{Column: "NAME", Severity: config.SeverityOK}},
+			valid: "container not ready",
+			sev:   "ok",
+		},
+		"color-only": {
+			rr:    []config.RowRule{{Column: "NAME", Color: "blue"}},
+			valid: "container not ready",
+			sev:   ":blue",
+			err:   "container not ready",
+		},
+		"bad-column": {
+			rr: []config.RowRule{{Column: "ZORG", Severity: config.SeverityError}},
+		},
+	}
+
+	o := load(t, "po")
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			rr, err := NewRowRules(u.rr...)
+			require.NoError(t, err)
+
+			h := rr.Header(model1.Header{{Name: "NAME"}, {Name: "STATUS"}})
+			assert.Equal(t, model1.Header{
+				{Name: "NAME"},
+				{Name: "STATUS"},
+				{Name: "VALID", Attrs: model1.Attrs{Wide: true}},
+				{Name: "SEVERITY", Attrs: model1.Attrs{Hide: true}},
+			}, h)
+
+			row := model1.Row{ID: "default/nginx", Fields: model1.Fields{"nginx", "Running", u.valid}}
+			rr.Apply(o, h, &row)
+			assert.Equal(t, model1.Fields{"nginx", "Running", u.err, u.sev}, row.Fields)
+		})
+	}
+}
+
+func TestRowRulesHydrate(t *testing.T) {
+	var cm ConfigMap
+	cm.SetViewSetting(&config.ViewSetting{
+		Rules: []config.RowRule{
+			{Path: ".data.blee", Severity: config.SeverityError},
+			{Expr: `has(object.data) && object.data.size() > 0`, Severity: config.SeverityWarn, Color: "orange"},
+		},
+	})
+	assert.True(t, cm.HasRules())
+
+	o := load(t, "cm")
+	rows := make(model1.Rows, 1)
+	require.NoError(t, model1.Hydrate("", []runtime.Object{o}, rows, &cm))
+
+	h := cm.Header("")
+	idx, ok := h.IndexOf(model1.SeverityCol, true)
+	require.True(t, ok)
+	assert.Len(t, rows[0].Fields, len(h))
+	assert.Equal(t, "warn:orange", rows[0].Fields[idx])
+	assert.False(t, model1.IsValid("", h, rows[0]))
+}
+
+func TestRuleObject(t *testing.T) {
+	po := load(t, "po")
+
+	assert.Equal(t, po, ruleObject(po))
+	assert.Equal(t, po, ruleObject(&PodWithMetrics{Raw: po}))
+	assert.Nil(t, ruleObject(&PodWithMetrics{}))
+	assert.Equal(t, po, ruleObject(metav1.TableRow{Object: runtime.RawExtension{Object: po}}))
+	assert.Nil(t, ruleObject(metav1.TableRow{}))
+
+	raw, err := po.MarshalJSON()
+	require.NoError(t, err)
+	u := ruleObject(metav1.TableRow{Object: runtime.RawExtension{Raw: raw}})
+	assert.Equal(t, po.Object, u.(runtime.Unstructured).UnstructuredContent())
+}
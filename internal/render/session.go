@@ -0,0 +1,223 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+const (
+	// SessionCommand tracks a navigation command entry.
+	SessionCommand = "command"
+
+	// SessionFilter tracks a view filter entry.
+	SessionFilter = "filter"
+
+	// SessionDelete tracks a resource deletion entry.
+	SessionDelete = "delete"
+
+	// SessionScale tracks a resource scale entry.
+	SessionScale = "scale"
+
+	// SessionRestart tracks a resource restart entry.
+	SessionRestart = "restart"
+
+	// SessionExec tracks a container shell entry.
+	SessionExec = "exec"
+
+	sessionOK     = "OK"
+	sessionFailed = "FAILED"
+)
+
+// Session renders a recorded session to screen.
+type Session struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (Session) ColorerFunc() model1.ColorerFunc {
+	return func(ns string, h model1.Header, re *model1.RowEvent) tcell.Color {
+		c := model1.DefaultColorer(ns, h, re)
+		idx, ok := h.IndexOf("FAILED", true)
+		if ok && re.Row.Fields[idx] != "0" {
+			return model1.ErrColor
+		}
+
+		return c
+	}
+}
+
+// Header returns a header row.
+func (Session) Header(string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "CONTEXT"},
+		model1.HeaderColumn{Name: "STARTED"},
+		model1.HeaderColumn{Name: "DURATION"},
+		model1.HeaderColumn{Name: "COMMANDS", Attrs: model1.Attrs{Align: tview.AlignRight}},
+		model1.HeaderColumn{Name: "ACTIONS", Attrs: model1.Attrs{Align: tview.AlignRight}},
+		model1.HeaderColumn{Name: "FAILED", Attrs: model1.Attrs{Align: tview.AlignRight}},
+		model1.HeaderColumn{Name: "PATH", Attrs: model1.Attrs{Wide: true}},
+		model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true}},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Session) Render(o any, _ string, r *model1.Row) error {
+	s, ok := o.(SessionRes)
+	if !ok {
+		return fmt.Errorf("expected SessionRes, but got %T", o)
+	}
+
+	r.ID = s.Path
+	r.Fields = model1.Fields{
+		s.Name,
+		s.Context,
+		s.Started.Local().Format(time.DateTime),
+		duration.HumanDuration(s.Ended.Sub(s.Started)),
+		strconv.Itoa(s.Commands),
+		strconv.Itoa(s.Actions),
+		strconv.Itoa(s.Failures),
+		s.Path,
+		ToAge(metav1.NewTime(s.Ended)),
+	}
+
+	return nil
+}
+
+// SessionEntry renders a recorded session entry to screen.
+type SessionEntry struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (SessionEntry) ColorerFunc() model1.ColorerFunc {
+	return func(ns string, h model1.Header, re *model1.RowEvent) tcell.Color {
+		c := model1.DefaultColorer(ns, h, re)
+		if idx, ok := h.IndexOf("RESULT", true); ok && re.Row.Fields[idx] != sessionOK {
+			return model1.ErrColor
+		}
+		if idx, ok := h.IndexOf("KIND", true); ok && IsSessionAction(re.Row.Fields[idx]) {
+			return model1.HighlightColor
+		}
+
+		return c
+	}
+}
+
+// Header returns a header row.
+func (SessionEntry) Header(string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "SEQ", Attrs: model1.Attrs{Align: tview.AlignRight}},
+		model1.HeaderColumn{Name: "TIME"},
+		model1.HeaderColumn{Name: "KIND"},
+		model1.HeaderColumn{Name: "COMMAND"},
+		model1.HeaderColumn{Name: "TARGET"},
+		model1.HeaderColumn{Name: "RESULT"},
+		model1.HeaderColumn{Name: "ERROR"},
+		model1.HeaderColumn{Name: "CONTEXT", Attrs: model1.Attrs{Wide: true}},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (SessionEntry) Render(o any, _ string, r *model1.Row) error {
+	e, ok := o.(SessionEntryRes)
+	if !ok {
+		return fmt.Errorf("expected SessionEntryRes, but got %T", o)
+	}
+
+	r.ID = e.ID()
+	r.Fields = model1.Fields{
+		strconv.Itoa(e.Seq),
+		e.Time.Local().Format(time.TimeOnly),
+		e.Kind,
+		e.Command,
+		e.Target,
+		e.Result(),
+		e.Error,
+		e.Context,
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// IsSessionAction checks if a session entry kind mutates cluster resources.
+func IsSessionAction(kind string) bool {
+	switch kind {
+	case SessionDelete, SessionScale, SessionRestart, SessionExec:
+		return true
+	default:
+		return false
+	}
+}
+
+// SessionRes represents a recorded session summary.
+type SessionRes struct {
+	Path     string
+	Name     string
+	Context  string
+	Started  time.Time
+	Ended    time.Time
+	Commands int
+	Actions  int
+	Failures int
+}
+
+// GetObjectKind returns a schema object.
+func (SessionRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (s SessionRes) DeepCopyObject() runtime.Object {
+	return s
+}
+
+// SessionEntryRes represents a recorded operator interaction.
+type SessionEntryRes struct {
+	Seq     int       `json:"-"`
+	Time    time.Time `json:"time"`
+	Context string    `json:"context"`
+	Kind    string    `json:"kind"`
+	Command string    `json:"command"`
+	Target  string    `json:"target,omitempty"`
+	Error   string    `json:"error,omitempty"`
+}
+
+// ID returns the entry identifier.
+func (e SessionEntryRes) ID() string {
+	return fmt.Sprintf("%06d", e.Seq)
+}
+
+// Result returns the entry outcome.
+func (e SessionEntryRes) Result() string {
+	if e.Error != "" {
+		return sessionFailed
+	}
+
+	return sessionOK
+}
+
+// GetObjectKind returns a schema object.
+func (SessionEntryRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (e SessionEntryRes) DeepCopyObject() runtime.Object {
+	return e
+}
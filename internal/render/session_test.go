@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionRender(t *testing.T) {
+	t0 := time.Now().Add(-10 * time.Minute)
+	s := render.SessionRes{
+		Path:     "/tmp/sessions/20261019-100000-1.jsonl",
+		Name:     "20261019-100000-1",
+		Context:  "ct-1",
+		Started:  t0,
+		Ended:    t0.Add(5 * time.Minute),
+		Commands: 3,
+		Actions:  2,
+		Failures: 1,
+	}
+
+	var r model1.Row
+	require.NoError(t, render.Session{}.Render(s, "", &r))
+	assert.Equal(t, s.Path, r.ID)
+	assert.Equal(t, model1.Fields{"20261019-100000-1", "ct-1", "5m", "3", "2", "1", s.Path, "5m"}, append(r.Fields[:2:2], r.Fields[3:]...))
+}
+
+func TestSessionEntryRender(t *testing.T) {
+	uu := map[string]struct {
+		e  render.SessionEntryRes
+		id string
+		ee []string
+	}{
+		"command": {
+			e:  render.SessionEntryRes{Seq: 1, Context: "ct-1", Kind: render.SessionCommand, Command: "pods"},
+			id: "000001",
+			ee: []string{"1", "command", "pods", "", "OK", "", "ct-1"},
+		},
+		"failed": {
+			e:  render.SessionEntryRes{Seq: 12, Context: "ct-1", Kind: render.SessionDelete, Command: "delete", Target: "v1/pods:default/p1", Error: "boom"},
+			id: "000012",
+			ee: []string{"12", "delete", "delete", "v1/pods:default/p1", "FAILED", "boom", "ct-1"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r model1.Row
+			require.NoError(t, render.SessionEntry{}.Render(u.e, "", &r))
+			assert.Equal(t, u.id, r.ID)
+			assert.Equal(t, u.ee, append([]string{r.Fields[0]}, r.Fields[2:]...))
+		})
+	}
+}
+
+func TestIsSessionAction(t *testing.T) {
+	assert.False(t, render.IsSessionAction(render.SessionCommand))
+	assert.False(t, render.IsSessionAction(render.SessionFilter))
+	assert.True(t, render.IsSessionAction(render.SessionDelete))
+	assert.True(t, render.IsSessionAction(render.SessionExec))
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/derailed/k9s/internal/model1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 )
 
@@ -27,6 +28,7 @@ type Table struct {
 	table    *metav1.Table
 	header   model1.Header
 	ageIndex int
+	condCols []string
 	mx       sync.RWMutex
 }
 
@@ -52,6 +54,19 @@ func (t *Table) SetTable(ns string, table *metav1.Table) {
 	t.header = t.Header(ns)
 }
 
+// SetConditionCols sets the readiness columns derived from resources status conditions.
+func (t *Table) SetConditionCols(cc []string) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+	t.condCols = cc
+}
+
+func (t *Table) getCondCols() []string {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+	return t.condCols
+}
+
 // ColorerFunc colors a resource row.
 func (*Table) ColorerFunc() model1.ColorerFunc {
 	return model1.DefaultColorer
@@ -73,8 +88,16 @@ func (t *Table) defaultHeader() model1.Header {
 			t.setAgeIndex(i)
 			continue
 		}
-		timeCol := ageCols.Has(c.Name)
-		h = append(h, model1.HeaderColumn{Name: strings.ToUpper(c.Name), Attrs: model1.Attrs{Time: timeCol}})
+		h = append(h, model1.HeaderColumn{
+			Name: strings.ToUpper(c.Name),
+			Attrs: model1.Attrs{
+				Time: ageCols.Has(c.Name),
+				Wide: c.Priority > 0 && t.vs.IsServerPrinted(),
+			},
+		})
+	}
+	for _, c := range t.getCondCols() {
+		h = append(h, model1.HeaderColumn{Name: c})
 	}
 	if t.getAgeIndex() > 0 {
 		h = append(h, model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true}})
@@ -147,6 +170,9 @@ func (t *Table) defaultRow(row *metav1.TableRow, ns string, r *model1.Row) error
 		}
 		r.Fields = append(r.Fields, fmt.Sprintf("%v", c))
 	}
+	if cc := t.getCondCols(); len(cc) > 0 {
+		r.Fields = append(r.Fields, conditionFields(row, cc)...)
+	}
 	if d, ok := age.(string); ok {
 		r.Fields = append(r.Fields, d)
 	} else if ageIdx > 0 {
@@ -156,3 +182,22 @@ func (t *Table) defaultRow(row *metav1.TableRow, ns string, r *model1.Row) error
 
 	return nil
 }
+
+func conditionFields(row *metav1.TableRow, cols []string) []string {
+	ff := make([]string, 0, len(cols))
+	ready, status := NAValue, NAValue
+	if u, ok := row.Object.Object.(runtime.Unstructured); ok {
+		if r, s, ok := ObjectReadiness(u.UnstructuredContent()); ok {
+			ready, status = r, s
+		}
+	}
+	for _, c := range cols {
+		if c == "READY" {
+			ff = append(ff, ready)
+			continue
+		}
+		ff = append(ff, status)
+	}
+
+	return ff
+}
@@ -19,11 +19,13 @@ import (
 
 func TestGenericRender(t *testing.T) {
 	uu := map[string]struct {
-		ns      string
-		table   *metav1beta1.Table
-		eID     string
-		eFields model1.Fields
-		eHeader model1.Header
+		ns       string
+		table    *metav1beta1.Table
+		vs       *cfg.ViewSetting
+		condCols []string
+		eID      string
+		eFields  model1.Fields
+		eHeader  model1.Header
 	}{
 		"withNS": {
 			ns:      "ns1",
@@ -74,6 +76,66 @@ func TestGenericRender(t *testing.T) {
 				model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true}},
 			},
 		},
+
+		"wide": {
+			ns:      client.ClusterScope,
+			table:   makeWideGeneric(),
+			vs:      &cfg.ViewSetting{Printer: cfg.PrinterServer},
+			eID:     "-/fred",
+			eFields: model1.Fields{"c1", "c2"},
+			eHeader: model1.Header{
+				model1.HeaderColumn{Name: "A"},
+				model1.HeaderColumn{Name: "B", Attrs: model1.Attrs{Wide: true}},
+			},
+		},
+
+		"wide-client": {
+			ns:      client.ClusterScope,
+			table:   makeWideGeneric(),
+			eID:     "-/fred",
+			eFields: model1.Fields{"c1", "c2"},
+			eHeader: model1.Header{
+				model1.HeaderColumn{Name: "A"},
+				model1.HeaderColumn{Name: "B"},
+			},
+		},
+
+		"conditions": {
+			ns:       client.ClusterScope,
+			table:    makeCondGeneric("A", "Age"),
+			condCols: []string{"READY", "STATUS"},
+			eID:      "-/fred",
+			eFields:  model1.Fields{"c1", "False", "Unavailable", "2d"},
+			eHeader: model1.Header{
+				model1.HeaderColumn{Name: "A"},
+				model1.HeaderColumn{Name: "READY"},
+				model1.HeaderColumn{Name: "STATUS"},
+				model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true}},
+			},
+		},
+
+		"conditions-printed": {
+			ns:       client.ClusterScope,
+			table:    makeCondGeneric("Ready"),
+			condCols: []string{"STATUS"},
+			eID:      "-/fred",
+			eFields:  model1.Fields{"c1", "Unavailable"},
+			eHeader: model1.Header{
+				model1.HeaderColumn{Name: "READY"},
+				model1.HeaderColumn{Name: "STATUS"},
+			},
+		},
+
+		"conditions-not-custom": {
+			ns:      client.ClusterScope,
+			table:   makeCondGeneric("A", "Age"),
+			eID:     "-/fred",
+			eFields: model1.Fields{"c1", "2d"},
+			eHeader: model1.Header{
+				model1.HeaderColumn{Name: "A"},
+				model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true}},
+			},
+		},
 	}
 
 	for k := range uu {
@@ -81,6 +143,8 @@ func TestGenericRender(t *testing.T) {
 		u := uu[k]
 		t.Run(k, func(t *testing.T) {
 			var r model1.Row
+			re.SetViewSetting(u.vs)
+			re.SetConditionCols(u.condCols)
 			re.SetTable(u.ns, u.table)
 
 			assert.Equal(t, u.eHeader, re.Header(u.ns))
@@ -141,6 +205,42 @@ func TestGenericCustRender(t *testing.T) {
 // ----------------------------------------------------------------------------
 // Helpers...
 
+func makeCondGeneric(cols ...string) *metav1beta1.Table {
+	cc := make([]metav1beta1.TableColumnDefinition, 0, len(cols))
+	for _, c := range cols {
+		cc = append(cc, metav1beta1.TableColumnDefinition{Name: c})
+	}
+	cells := []any{"c1"}
+	if len(cols) > 1 {
+		cells = append(cells, "2d")
+	}
+
+	return &metav1beta1.Table{
+		ColumnDefinitions: cc,
+		Rows: []metav1beta1.TableRow{
+			{
+				Object: runtime.RawExtension{
+					Object: &unstructured.Unstructured{
+						Object: map[string]any{
+							"kind":       "fred",
+							"apiVersion": "v1",
+							"metadata": map[string]any{
+								"name": "fred",
+							},
+							"status": map[string]any{
+								"conditions": []any{
+									map[string]any{"type": "Available", "status": "False", "reason": "Unavailable"},
+								},
+							},
+						},
+					},
+				},
+				Cells: cells,
+			},
+		},
+	}
+}
+
 func makeNSGeneric() *metav1beta1.Table {
 	return &metav1beta1.Table{
 		ColumnDefinitions: []metav1beta1.TableColumnDefinition{
@@ -233,3 +333,31 @@ func makeAgeGeneric() *metav1beta1.Table {
 		},
 	}
 }
+
+func makeWideGeneric() *metav1beta1.Table {
+	return &metav1beta1.Table{
+		ColumnDefinitions: []metav1beta1.TableColumnDefinition{
+			{Name: "a"},
+			{Name: "b", Priority: 1},
+		},
+		Rows: []metav1beta1.TableRow{
+			{
+				Object: runtime.RawExtension{
+					Object: &unstructured.Unstructured{
+						Object: map[string]any{
+							"kind":       "fred",
+							"apiVersion": "v1",
+							"metadata": map[string]any{
+								"name": "fred",
+							},
+						},
+					},
+				},
+				Cells: []any{
+					"c1",
+					"c2",
+				},
+			},
+		},
+	}
+}
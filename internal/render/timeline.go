@@ -0,0 +1,163 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// TimelineNormal tracks a normal event.
+	TimelineNormal = "Normal"
+
+	// TimelineWarning tracks a warning event.
+	TimelineWarning = "Warning"
+
+	// TimelineCondition tracks a pod condition transition.
+	TimelineCondition = "Condition"
+
+	// TimelineRestart tracks a container restart.
+	TimelineRestart = "Restart"
+
+	timelineBarWidth = 30
+	timelineIDSep    = "|"
+)
+
+var defaultTimelineHeader = model1.Header{
+	model1.HeaderColumn{Name: "TIME"},
+	model1.HeaderColumn{Name: "ELAPSED", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "TIMELINE"},
+	model1.HeaderColumn{Name: "KIND"},
+	model1.HeaderColumn{Name: "OBJECT"},
+	model1.HeaderColumn{Name: "TYPE"},
+	model1.HeaderColumn{Name: "REASON"},
+	model1.HeaderColumn{Name: "MESSAGE"},
+}
+
+// Timeline renders an object timeline entry to screen.
+type Timeline struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (Timeline) ColorerFunc() model1.ColorerFunc {
+	return func(ns string, h model1.Header, re *model1.RowEvent) tcell.Color {
+		c := model1.DefaultColorer(ns, h, re)
+		tIdx, ok := h.IndexOf("TYPE", true)
+		if !ok {
+			return c
+		}
+		switch strings.TrimSpace(re.Row.Fields[tIdx]) {
+		case TimelineWarning, TimelineRestart:
+			return model1.ErrColor
+		case TimelineCondition:
+			rIdx, ok := h.IndexOf("REASON", true)
+			if ok && strings.HasSuffix(re.Row.Fields[rIdx], "=False") {
+				return model1.PendingColor
+			}
+		}
+
+		return c
+	}
+}
+
+// Header returns a header row.
+func (t Timeline) Header(string) model1.Header {
+	return t.doHeader(defaultTimelineHeader)
+}
+
+// Render renders a K8s resource to screen.
+func (Timeline) Render(o any, _ string, r *model1.Row) error {
+	res, ok := o.(TimelineRes)
+	if !ok {
+		return fmt.Errorf("expected TimelineRes, but got %T", o)
+	}
+	e := res.Entry
+
+	r.ID = TimelineID(&e)
+	r.Fields = model1.Fields{
+		e.Time.UTC().Format(time.RFC3339),
+		"+" + e.Time.Sub(res.Start).Truncate(time.Second).String(),
+		TimelineBar(e.Time, res.Start, res.End, timelineBarWidth),
+		e.Kind,
+		e.Path,
+		e.Type,
+		e.Reason,
+		e.Message,
+	}
+
+	return nil
+}
+
+// TimelineID returns a unique entry id from which the entry resource can be retrieved.
+func TimelineID(e *TimelineEntry) string {
+	return strings.Join([]string{
+		e.GVR,
+		e.Path,
+		e.Reason,
+		fmt.Sprintf("%d", e.Time.UnixNano()),
+	}, timelineIDSep)
+}
+
+// TimelineResource returns the entry resource gvr and path from a timeline id.
+func TimelineResource(id string) (gvr, path string) {
+	tokens := strings.Split(id, timelineIDSep)
+	if len(tokens) < 2 {
+		return "", ""
+	}
+
+	return tokens[0], tokens[1]
+}
+
+// TimelineBar returns a bar depicting the relative position of t within the [start, end] window.
+func TimelineBar(t, start, end time.Time, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	var pos int
+	if span := end.Sub(start); span > 0 {
+		pos = int(float64(t.Sub(start)) / float64(span) * float64(width-1))
+	}
+	pos = max(0, min(pos, width-1))
+
+	return strings.Repeat("·", pos) + "█" + strings.Repeat("·", width-pos-1)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// TimelineEntry represents an occurrence in an object lifecycle.
+type TimelineEntry struct {
+	Time    time.Time
+	GVR     string
+	Kind    string
+	Path    string
+	Type    string
+	Reason  string
+	Message string
+}
+
+// TimelineRes represents a timeline entry along with the timeline window.
+type TimelineRes struct {
+	Entry      TimelineEntry
+	Start, End time.Time
+}
+
+// GetObjectKind returns a schema object.
+func (TimelineRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (t TimelineRes) DeepCopyObject() runtime.Object {
+	return t
+}
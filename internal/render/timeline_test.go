@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimelineRender(t *testing.T) {
+	start := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	res := render.TimelineRes{
+		Entry: render.TimelineEntry{
+			Time:    start.Add(90 * time.Second),
+			GVR:     "v1/pods",
+			Kind:    "Pod",
+			Path:    "default/nginx",
+			Type:    render.TimelineWarning,
+			Reason:  "BackOff",
+			Message: "Back-off restarting failed container",
+		},
+		Start: start,
+		End:   start.Add(3 * time.Minute),
+	}
+
+	var c render.Timeline
+	r := model1.NewRow(8)
+	require.NoError(t, c.Render(res, "", &r))
+
+	gvr, path := render.TimelineResource(r.ID)
+	assert.Equal(t, "v1/pods", gvr)
+	assert.Equal(t, "default/nginx", path)
+	assert.Equal(t, model1.Fields{
+		"2024-01-01T10:01:30Z",
+		"+1m30s",
+		render.TimelineBar(res.Entry.Time, res.Start, res.End, 30),
+		"Pod",
+		"default/nginx",
+		"Warning",
+		"BackOff",
+		"Back-off restarting failed container",
+	}, r.Fields)
+}
+
+func TestTimelineBar(t *testing.T) {
+	start := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	end := start.Add(10 * time.Second)
+
+	uu := map[string]struct {
+		t     time.Time
+		start time.Time
+		end   time.Time
+		e     string
+	}{
+		"start": {
+			t: start, start: start, end: end,
+			e: "█··········",
+		},
+		"middle": {
+			t: start.Add(5 * time.Second), start: start, end: end,
+			e: "·····█·····",
+		},
+		"end": {
+			t: end, start: start, end: end,
+			e: "··········█",
+		},
+		"no-span": {
+			t: start, start: start, end: start,
+			e: "█··········",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.TimelineBar(u.t, u.start, u.end, 11))
+		})
+	}
+}
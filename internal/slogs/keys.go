@@ -221,4 +221,7 @@ const (
 
 	// Type tracks a type logger key.
 	Type = "type"
+
+	// Subject tracks an rbac subject logger key.
+	Subject = "subject"
 )
@@ -0,0 +1,201 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/watch"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	kwatch "k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+)
+
+// Factory serves resources off a snapshot store in lieu of live informers.
+type Factory struct {
+	store      *Store
+	client     client.Connection
+	informers  map[*client.GVR]informers.GenericInformer
+	stopChan   chan struct{}
+	forwarders watch.Forwarders
+	mx         sync.RWMutex
+}
+
+// NewFactory returns a new snapshot factory.
+func NewFactory(clt client.Connection, s *Store) *Factory {
+	return &Factory{
+		store:      s,
+		client:     clt,
+		informers:  make(map[*client.GVR]informers.GenericInformer),
+		forwarders: watch.NewForwarders(),
+	}
+}
+
+// Start initializes the factory.
+func (f *Factory) Start(string) {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	f.stopChan = make(chan struct{})
+	for _, inf := range f.informers {
+		go inf.Informer().Run(f.stopChan)
+	}
+}
+
+// Terminate stops all informers.
+func (f *Factory) Terminate() {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	if f.stopChan != nil {
+		close(f.stopChan)
+		f.stopChan = nil
+	}
+	clear(f.informers)
+}
+
+// Client returns the factory connection.
+func (f *Factory) Client() client.Connection {
+	return f.client
+}
+
+// List returns a resource collection.
+func (f *Factory) List(gvr *client.GVR, ns string, _ bool, sel labels.Selector) ([]runtime.Object, error) {
+	return f.store.List(gvr, ns, sel), nil
+}
+
+// Get retrieves a given resource.
+func (f *Factory) Get(gvr *client.GVR, fqn string, _ bool, _ labels.Selector) (runtime.Object, error) {
+	return f.store.Get(gvr, fqn)
+}
+
+// CanForResource returns an informer for read only access.
+func (f *Factory) CanForResource(ns string, gvr *client.GVR, verbs []string) (informers.GenericInformer, error) {
+	for _, v := range verbs {
+		if !slices.Contains(client.ReadAllAccess, v) {
+			return nil, fmt.Errorf("%v access denied on resource %q:%q. Snapshots are read-only", verbs, ns, gvr)
+		}
+	}
+
+	return f.ForResource(ns, gvr)
+}
+
+// ForResource returns an informer for a given resource. Snapshots are static so
+// informers are namespace agnostic and never see changes.
+func (f *Factory) ForResource(_ string, gvr *client.GVR) (informers.GenericInformer, error) {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	if inf, ok := f.informers[gvr]; ok {
+		return inf, nil
+	}
+	inf := f.newInformer(gvr)
+	f.informers[gvr] = inf
+	if f.stopChan != nil {
+		go inf.Informer().Run(f.stopChan)
+	}
+
+	return inf, nil
+}
+
+// WaitForCacheSync waits for all informers to be synced.
+func (f *Factory) WaitForCacheSync() {
+	f.mx.RLock()
+	stop, ss := f.stopChan, make([]cache.InformerSynced, 0, len(f.informers))
+	for _, inf := range f.informers {
+		ss = append(ss, inf.Informer().HasSynced)
+	}
+	f.mx.RUnlock()
+
+	if stop != nil {
+		cache.WaitForCacheSync(stop, ss...)
+	}
+}
+
+// SetActiveNS sets the active namespace.
+func (*Factory) SetActiveNS(string) error {
+	return nil
+}
+
+// AddForwarder registers a new portforward.
+func (f *Factory) AddForwarder(pf watch.Forwarder) {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	f.forwarders[pf.ID()] = pf
+}
+
+// DeleteForwarder deletes a portforward.
+func (f *Factory) DeleteForwarder(path string) {
+	f.forwarders.Kill(path)
+}
+
+// Forwarders returns all portforwards.
+func (f *Factory) Forwarders() watch.Forwarders {
+	f.mx.RLock()
+	defer f.mx.RUnlock()
+
+	return f.forwarders
+}
+
+// ForwarderFor returns a portforward for a given container.
+func (f *Factory) ForwarderFor(path string) (watch.Forwarder, bool) {
+	f.mx.RLock()
+	defer f.mx.RUnlock()
+
+	fwd, ok := f.forwarders[path]
+
+	return fwd, ok
+}
+
+// ValidatePortForwards checks portforwards. Snapshots never change so this is a noop.
+func (*Factory) ValidatePortForwards() {}
+
+func (f *Factory) newInformer(gvr *client.GVR) informers.GenericInformer {
+	lw := cache.ListWatch{
+		ListWithContextFunc: func(context.Context, metav1.ListOptions) (runtime.Object, error) {
+			var l unstructured.UnstructuredList
+			for _, o := range f.store.List(gvr, client.BlankNamespace, labels.Everything()) {
+				l.Items = append(l.Items, *o.(*unstructured.Unstructured))
+			}
+			l.SetResourceVersion("1")
+
+			return &l, nil
+		},
+		WatchFuncWithContext: func(context.Context, metav1.ListOptions) (kwatch.Interface, error) {
+			return kwatch.NewFake(), nil
+		},
+	}
+	inf := cache.NewSharedIndexInformer(
+		&lw,
+		&unstructured.Unstructured{},
+		0,
+		cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
+	)
+
+	return &informer{
+		inf:    inf,
+		lister: cache.NewGenericLister(inf.GetIndexer(), *gvr.GR()),
+	}
+}
+
+// informer represents a snapshot resource informer.
+type informer struct {
+	inf    cache.SharedIndexInformer
+	lister cache.GenericLister
+}
+
+// Informer returns the shared informer.
+func (i *informer) Informer() cache.SharedIndexInformer { return i.inf }
+
+// Lister returns the informer lister.
+func (i *informer) Lister() cache.GenericLister { return i.lister }
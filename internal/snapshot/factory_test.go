@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package snapshot_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/snapshot"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+var _ dao.Factory = (*snapshot.Factory)(nil)
+
+func TestFactory(t *testing.T) {
+	s, err := snapshot.Load("testdata/dump")
+	require.NoError(t, err)
+	f := snapshot.NewFactory(nil, s)
+	f.Start(client.NamespaceAll)
+	defer f.Terminate()
+
+	oo, err := f.List(client.PodGVR, "default", true, labels.Everything())
+	require.NoError(t, err)
+	assert.Len(t, oo, 2)
+
+	_, err = f.Get(client.DpGVR, "fred/d1", true, labels.Everything())
+	require.NoError(t, err)
+
+	_, err = f.CanForResource("default", client.PodGVR, []string{client.DeleteVerb})
+	require.Error(t, err)
+
+	inf, err := f.CanForResource("default", client.PodGVR, client.MonitorAccess)
+	require.NoError(t, err)
+	f.WaitForCacheSync()
+	oo, err = inf.Lister().ByNamespace("default").List(labels.Everything())
+	require.NoError(t, err)
+	assert.Len(t, oo, 2)
+}
@@ -0,0 +1,360 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package snapshot
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/big"
+	"net"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/slogs"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/clientcmd/api"
+)
+
+const (
+	// Version represents the reported server version when the snapshot has no nodes.
+	Version = "v0.0.0-snapshot"
+
+	localAddr = "127.0.0.1:0"
+)
+
+var errReadOnly = errors.New("snapshots are read-only")
+
+// Server serves a snapshot store as a read-only api server so discovery, describe
+// and access reviews work off the dump. Dumps may hold secrets, so requests must
+// carry the server bearer token found in the generated kubeconfig. The token is
+// only sent by clients over TLS, so the server uses a throw away self-signed cert.
+type Server struct {
+	store *Store
+	srv   *http.Server
+	token string
+	ca    []byte
+}
+
+// NewServer returns a new snapshot api server.
+func NewServer(s *Store) *Server {
+	return &Server{store: s, token: rand.Text()}
+}
+
+// Start serves the snapshot over TLS on a local port and returns the server url.
+func (s *Server) Start() (string, error) {
+	cert, ca, err := selfSignedCert()
+	if err != nil {
+		return "", err
+	}
+	s.ca = ca
+	l, err := net.Listen("tcp", localAddr)
+	if err != nil {
+		return "", err
+	}
+	l = tls.NewListener(l, &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12})
+	s.srv = &http.Server{Handler: s, ReadHeaderTimeout: client.DefaultCallTimeoutDuration}
+	go func() {
+		if err := s.srv.Serve(l); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			slog.Error("Snapshot server failed", slogs.Error, err)
+		}
+	}()
+
+	return "https://" + l.Addr().String(), nil
+}
+
+// Stop stops the server and closes all watches.
+func (s *Server) Stop() {
+	if s.srv != nil {
+		_ = s.srv.Close()
+	}
+}
+
+// KubeConfig returns a kubeconfig targeting the snapshot server.
+func (s *Server) KubeConfig(server string) *api.Config {
+	n := "snapshot-" + s.store.Name()
+	cfg := api.NewConfig()
+	cfg.Clusters[n] = &api.Cluster{Server: server, CertificateAuthorityData: s.ca}
+	cfg.AuthInfos[n] = &api.AuthInfo{Token: s.token}
+	cfg.Contexts[n] = &api.Context{Cluster: n, AuthInfo: n}
+	cfg.CurrentContext = n
+
+	return cfg
+}
+
+// ServeHTTP serves api requests off the snapshot.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !s.authorized(r) {
+		writeError(w, apierrors.NewUnauthorized("invalid snapshot token"))
+		return
+	}
+	segs := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/apis/authorization.k8s.io/v1/selfsubjectaccessreviews":
+		s.review(w, r)
+	case r.Method != http.MethodGet:
+		writeError(w, apierrors.NewForbidden(schema.GroupResource{}, r.URL.Path, errReadOnly))
+	case r.URL.Path == "/version":
+		writeJSON(w, http.StatusOK, s.version())
+	case r.URL.Path == "/api":
+		writeJSON(w, http.StatusOK, &metav1.APIVersions{
+			TypeMeta: metav1.TypeMeta{Kind: "APIVersions"},
+			Versions: []string{"v1"},
+		})
+	case r.URL.Path == "/apis":
+		writeJSON(w, http.StatusOK, s.groups())
+	case segs[0] == "api" && len(segs) >= 2:
+		s.serveResource(w, r, segs[1], segs[2:])
+	case segs[0] == "apis" && len(segs) >= 3:
+		s.serveResource(w, r, segs[1]+"/"+segs[2], segs[3:])
+	default:
+		writeError(w, apierrors.NewNotFound(schema.GroupResource{}, r.URL.Path))
+	}
+}
+
+func (s *Server) serveResource(w http.ResponseWriter, r *http.Request, gv string, segs []string) {
+	if len(segs) == 0 {
+		for _, l := range s.store.Resources() {
+			if l.GroupVersion == gv {
+				l.Kind, l.APIVersion = "APIResourceList", "v1"
+				writeJSON(w, http.StatusOK, l)
+				return
+			}
+		}
+		writeError(w, apierrors.NewNotFound(schema.GroupResource{}, gv))
+		return
+	}
+
+	var ns string
+	if len(segs) >= 3 && segs[0] == "namespaces" {
+		ns, segs = segs[1], segs[2:]
+	}
+	gvr := client.FromGVAndR(gv, segs[0])
+	res, ok := s.store.Resource(gvr)
+	if !ok || len(segs) > 2 {
+		writeError(w, apierrors.NewNotFound(*gvr.GR(), strings.Join(segs, "/")))
+		return
+	}
+	if len(segs) == 2 {
+		o, err := s.store.Get(gvr, client.FQN(ns, segs[1]))
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, o)
+		return
+	}
+
+	if r.URL.Query().Get("watch") == "true" {
+		idle(w, r)
+		return
+	}
+	l, err := s.list(gvr, res, ns, r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, l)
+}
+
+func (s *Server) list(gvr *client.GVR, res *metav1.APIResource, ns string, r *http.Request) (*unstructured.UnstructuredList, error) {
+	lsel, err := labels.Parse(r.URL.Query().Get("labelSelector"))
+	if err != nil {
+		return nil, apierrors.NewBadRequest(err.Error())
+	}
+	fsel, err := fields.ParseSelector(r.URL.Query().Get("fieldSelector"))
+	if err != nil {
+		return nil, apierrors.NewBadRequest(err.Error())
+	}
+	if ns == "" {
+		ns = client.BlankNamespace
+	}
+
+	var l unstructured.UnstructuredList
+	l.SetAPIVersion(gvr.GV().String())
+	l.SetKind(res.Kind + "List")
+	l.SetResourceVersion("1")
+	l.Items = []unstructured.Unstructured{}
+	for _, o := range s.store.List(gvr, ns, lsel) {
+		u := o.(*unstructured.Unstructured)
+		if !fsel.Empty() && !fsel.Matches(fieldSet(u, fsel)) {
+			continue
+		}
+		l.Items = append(l.Items, *u)
+	}
+
+	return &l, nil
+}
+
+// selfSignedCert returns a local serving cert and its pem encoding.
+func selfSignedCert() (tls.Certificate, []byte, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return tls.Certificate{}, nil, err
+	}
+	now := time.Now()
+	tmpl := x509.Certificate{
+		SerialNumber:          big.NewInt(now.UnixNano()),
+		Subject:               pkix.Name{CommonName: "k9s-snapshot"},
+		NotBefore:             now.Add(-time.Minute),
+		NotAfter:              now.AddDate(1, 0, 0),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		IPAddresses:           []net.IP{net.IPv4(127, 0, 0, 1)},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &tmpl, &tmpl, &key.PublicKey, key)
+	if err != nil {
+		return tls.Certificate{}, nil, err
+	}
+
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key},
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		nil
+}
+
+// authorized checks the request carries the server bearer token.
+func (s *Server) authorized(r *http.Request) bool {
+	tok, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+
+	return ok && subtle.ConstantTimeCompare([]byte(tok), []byte(s.token)) == 1
+}
+
+// review grants read access only.
+func (*Server) review(w http.ResponseWriter, r *http.Request) {
+	var sar authorizationv1.SelfSubjectAccessReview
+	raw, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, apierrors.NewBadRequest(err.Error()))
+		return
+	}
+	// Clients may post protobuf payloads.
+	if _, _, err := scheme.Codecs.UniversalDeserializer().Decode(raw, nil, &sar); err != nil {
+		writeError(w, apierrors.NewBadRequest(err.Error()))
+		return
+	}
+	sar.Kind, sar.APIVersion = "SelfSubjectAccessReview", authorizationv1.SchemeGroupVersion.String()
+	if ra := sar.Spec.ResourceAttributes; ra != nil && slices.Contains(client.ReadAllAccess, ra.Verb) {
+		sar.Status.Allowed = true
+	} else {
+		sar.Status.Reason = errReadOnly.Error()
+	}
+	writeJSON(w, http.StatusCreated, &sar)
+}
+
+// version reports the kubelet version of the snapshot nodes if any.
+func (s *Server) version() *version.Info {
+	info := version.Info{GitVersion: Version}
+	for _, o := range s.store.List(client.NodeGVR, client.ClusterScope, labels.Everything()) {
+		u := o.(*unstructured.Unstructured)
+		if v, ok, _ := unstructured.NestedString(u.Object, "status", "nodeInfo", "kubeletVersion"); ok && v != "" {
+			info.GitVersion = v
+			break
+		}
+	}
+	tokens := strings.SplitN(strings.TrimPrefix(info.GitVersion, "v"), ".", 3)
+	if len(tokens) >= 2 {
+		info.Major, info.Minor = tokens[0], tokens[1]
+	}
+
+	return &info
+}
+
+func (s *Server) groups() *metav1.APIGroupList {
+	gg := make(map[string]*metav1.APIGroup)
+	for _, l := range s.store.Resources() {
+		gv, err := schema.ParseGroupVersion(l.GroupVersion)
+		if err != nil || gv.Group == "" {
+			continue
+		}
+		g, ok := gg[gv.Group]
+		if !ok {
+			g = &metav1.APIGroup{Name: gv.Group}
+			gg[gv.Group] = g
+		}
+		g.Versions = append(g.Versions, metav1.GroupVersionForDiscovery{
+			GroupVersion: gv.String(),
+			Version:      gv.Version,
+		})
+	}
+
+	ll := metav1.APIGroupList{TypeMeta: metav1.TypeMeta{Kind: "APIGroupList", APIVersion: "v1"}}
+	for _, g := range gg {
+		g.PreferredVersion = g.Versions[0]
+		ll.Groups = append(ll.Groups, *g)
+	}
+	sort.Slice(ll.Groups, func(i, j int) bool {
+		return ll.Groups[i].Name < ll.Groups[j].Name
+	})
+
+	return &ll
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// fieldSet extracts the selector fields from a resource ie involvedObject.name.
+func fieldSet(u *unstructured.Unstructured, sel fields.Selector) fields.Set {
+	set := make(fields.Set)
+	for _, req := range sel.Requirements() {
+		v, ok, _ := unstructured.NestedFieldNoCopy(u.Object, strings.Split(req.Field, ".")...)
+		if ok {
+			set[req.Field] = fmt.Sprintf("%v", v)
+		}
+	}
+
+	return set
+}
+
+// idle keeps a watch open until the client goes away since snapshots never change.
+func idle(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if f, ok := w.(http.Flusher); ok {
+		f.Flush()
+	}
+	<-r.Context().Done()
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	var status metav1.Status
+	if s, ok := err.(apierrors.APIStatus); ok {
+		status = s.Status()
+	} else {
+		status = apierrors.NewInternalError(err).ErrStatus
+	}
+	status.Kind, status.APIVersion = "Status", "v1"
+	writeJSON(w, int(status.Code), &status)
+}
+
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		slog.Warn("Snapshot response failed", slogs.Error, err)
+	}
+}
@@ -0,0 +1,171 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package snapshot_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/derailed/k9s/internal/snapshot"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/kubectl/pkg/describe"
+)
+
+func TestServerDiscovery(t *testing.T) {
+	c := newClientset(t)
+
+	info, err := c.Discovery().ServerVersion()
+	require.NoError(t, err)
+	assert.Equal(t, "v1.31.2", info.GitVersion)
+	assert.Equal(t, "31", info.Minor)
+
+	rr, err := c.Discovery().ServerPreferredResources()
+	require.NoError(t, err)
+	assert.Len(t, rr, 4)
+}
+
+func TestServerResources(t *testing.T) {
+	c, ctx := newClientset(t), context.Background()
+
+	po, err := c.CoreV1().Pods("default").Get(ctx, "p1", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "nginx", po.Spec.Containers[0].Image)
+
+	_, err = c.CoreV1().Pods("default").Get(ctx, "zorg", metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err))
+
+	pp, err := c.CoreV1().Pods("").List(ctx, metav1.ListOptions{LabelSelector: "app=zorg"})
+	require.NoError(t, err)
+	assert.Len(t, pp.Items, 1)
+
+	ee, err := c.CoreV1().Events("default").List(ctx, metav1.ListOptions{FieldSelector: "involvedObject.name=p2,involvedObject.kind=Pod"})
+	require.NoError(t, err)
+	require.Len(t, ee.Items, 1)
+	assert.Equal(t, "FailedScheduling", ee.Items[0].Reason)
+
+	ns, err := c.CoreV1().Namespaces().Get(ctx, "fred", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, corev1.NamespaceActive, ns.Status.Phase)
+
+	err = c.CoreV1().Pods("default").Delete(ctx, "p1", metav1.DeleteOptions{})
+	assert.True(t, apierrors.IsForbidden(err))
+}
+
+func TestServerDynamic(t *testing.T) {
+	cfg := newRestConfig(t)
+	dial, err := dynamic.NewForConfig(cfg)
+	require.NoError(t, err)
+
+	l, err := dial.Resource(schema.GroupVersionResource{Group: "zoo.io", Version: "v1", Resource: "mice"}).
+		Namespace("fred").
+		List(context.Background(), metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, l.Items, 1)
+	assert.Equal(t, "m1", l.Items[0].GetName())
+}
+
+func TestServerAccessReview(t *testing.T) {
+	c := newClientset(t)
+
+	uu := map[string]struct {
+		verb string
+		e    bool
+	}{
+		"get":    {verb: "get", e: true},
+		"list":   {verb: "list", e: true},
+		"watch":  {verb: "watch", e: true},
+		"delete": {verb: "delete"},
+		"patch":  {verb: "patch"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			sar := authorizationv1.SelfSubjectAccessReview{
+				Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+					ResourceAttributes: &authorizationv1.ResourceAttributes{Verb: u.verb, Resource: "pods"},
+				},
+			}
+			resp, err := c.AuthorizationV1().SelfSubjectAccessReviews().Create(context.Background(), &sar, metav1.CreateOptions{})
+			require.NoError(t, err)
+			assert.Equal(t, u.e, resp.Status.Allowed)
+		})
+	}
+}
+
+func TestServerUnauthorized(t *testing.T) {
+	s, err := snapshot.Load("testdata/dump")
+	require.NoError(t, err)
+	srv := snapshot.NewServer(s)
+	url, err := srv.Start()
+	require.NoError(t, err)
+	defer srv.Stop()
+	cfg, err := clientcmd.NewDefaultClientConfig(*srv.KubeConfig(url), nil).ClientConfig()
+	require.NoError(t, err)
+	cfg.BearerToken = ""
+	c, err := rest.HTTPClientFor(cfg)
+	require.NoError(t, err)
+
+	uu := map[string]string{
+		"none":  "",
+		"wrong": "Bearer fred",
+	}
+	for k := range uu {
+		auth := uu[k]
+		t.Run(k, func(t *testing.T) {
+			req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, url+"/api/v1/secrets", http.NoBody)
+			require.NoError(t, err)
+			if auth != "" {
+				req.Header.Set("Authorization", auth)
+			}
+			resp, err := c.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+		})
+	}
+}
+
+func TestServerDescribe(t *testing.T) {
+	d := describe.PodDescriber{Interface: newClientset(t)}
+
+	s, err := d.Describe("default", "p2", describe.DescriberSettings{ShowEvents: true})
+	require.NoError(t, err)
+	assert.Contains(t, s, "redis")
+	assert.Contains(t, s, "FailedScheduling")
+}
+
+// Helpers...
+
+func newRestConfig(t *testing.T) *rest.Config {
+	s, err := snapshot.Load("testdata/dump")
+	require.NoError(t, err)
+	srv := snapshot.NewServer(s)
+	url, err := srv.Start()
+	require.NoError(t, err)
+	t.Cleanup(srv.Stop)
+
+	cfg, err := clientcmd.NewDefaultClientConfig(*srv.KubeConfig(url), nil).ClientConfig()
+	require.NoError(t, err)
+
+	return cfg
+}
+
+func newClientset(t *testing.T) *kubernetes.Clientset {
+	c, err := kubernetes.NewForConfig(newRestConfig(t))
+	require.NoError(t, err)
+
+	return c
+}
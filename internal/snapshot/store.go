@@ -0,0 +1,364 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package snapshot
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/slogs"
+	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+const maxDocSize = 4 * 1024 * 1024
+
+var manifestExts = sets.New(".yaml", ".yml", ".json")
+
+// shortNames tracks well known resources short names as reported by the api server.
+var shortNames = map[string][]string{
+	"v1/configmaps":                                     {"cm"},
+	"v1/endpoints":                                      {"ep"},
+	"v1/events":                                         {"ev"},
+	"v1/limitranges":                                    {"limits"},
+	"v1/namespaces":                                     {"ns"},
+	"v1/nodes":                                          {"no"},
+	"v1/persistentvolumeclaims":                         {"pvc"},
+	"v1/persistentvolumes":                              {"pv"},
+	"v1/pods":                                           {"po"},
+	"v1/replicationcontrollers":                         {"rc"},
+	"v1/resourcequotas":                                 {"quota"},
+	"v1/serviceaccounts":                                {"sa"},
+	"v1/services":                                       {"svc"},
+	"apps/v1/daemonsets":                                {"ds"},
+	"apps/v1/deployments":                               {"deploy"},
+	"apps/v1/replicasets":                               {"rs"},
+	"apps/v1/statefulsets":                              {"sts"},
+	"batch/v1/cronjobs":                                 {"cj"},
+	"autoscaling/v2/horizontalpodautoscalers":           {"hpa"},
+	"networking.k8s.io/v1/ingresses":                    {"ing"},
+	"networking.k8s.io/v1/networkpolicies":              {"netpol"},
+	"policy/v1/poddisruptionbudgets":                    {"pdb"},
+	"storage.k8s.io/v1/storageclasses":                  {"sc"},
+	"scheduling.k8s.io/v1/priorityclasses":              {"pc"},
+	"apiextensions.k8s.io/v1/customresourcedefinitions": {"crd", "crds"},
+}
+
+// Store tracks a cluster snapshot resources in memory.
+type Store struct {
+	dir       string
+	resources map[*client.GVR]*metav1.APIResource
+	objects   map[*client.GVR]map[string]*unstructured.Unstructured
+}
+
+// NewStore returns a new empty store.
+func NewStore(dir string) *Store {
+	return &Store{
+		dir:       dir,
+		resources: make(map[*client.GVR]*metav1.APIResource),
+		objects:   make(map[*client.GVR]map[string]*unstructured.Unstructured),
+	}
+}
+
+// Load hydrates a store from a directory of yaml/json manifests ie a cluster dump.
+func Load(dir string) (*Store, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("snapshot %q is not a directory", dir)
+	}
+
+	var oo []*unstructured.Unstructured
+	err = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !manifestExts.Has(strings.ToLower(filepath.Ext(path))) {
+			return nil
+		}
+		uu, err := readManifests(path)
+		if err != nil {
+			slog.Warn("Skipping snapshot file", slogs.Path, path, slogs.Error, err)
+			return nil
+		}
+		oo = append(oo, uu...)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s := NewStore(dir)
+	s.Add(oo...)
+	if s.Count() == 0 {
+		return nil, fmt.Errorf("no resources found in snapshot %q", dir)
+	}
+
+	return s, nil
+}
+
+// Name returns the snapshot name.
+func (s *Store) Name() string {
+	return filepath.Base(filepath.Clean(s.dir))
+}
+
+// Add indexes a collection of resources. CRDs are registered first so custom resources
+// resolve to their declared plural names and scope.
+func (s *Store) Add(oo ...*unstructured.Unstructured) {
+	crds := make(map[schema.GroupKind]apiext.CustomResourceDefinition)
+	for _, o := range oo {
+		if o.GroupVersionKind().GroupKind() != (schema.GroupKind{Group: apiext.GroupName, Kind: "CustomResourceDefinition"}) {
+			continue
+		}
+		var crd apiext.CustomResourceDefinition
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.Object, &crd); err != nil {
+			slog.Warn("Skipping invalid snapshot CRD", slogs.ResName, o.GetName(), slogs.Error, err)
+			continue
+		}
+		crds[schema.GroupKind{Group: crd.Spec.Group, Kind: crd.Spec.Names.Kind}] = crd
+	}
+
+	for _, o := range oo {
+		gvk := o.GroupVersionKind()
+		if gvk.Kind == "" || gvk.Version == "" || o.GetName() == "" {
+			continue
+		}
+		gvr, res := resourceFor(gvk, o.GetNamespace() != "", crds)
+		s.register(gvr, res)
+		s.objects[gvr][client.FQN(o.GetNamespace(), o.GetName())] = o
+	}
+	s.ensureNamespaces()
+}
+
+func (s *Store) register(gvr *client.GVR, res *metav1.APIResource) {
+	if _, ok := s.resources[gvr]; ok {
+		return
+	}
+	s.resources[gvr] = res
+	s.objects[gvr] = make(map[string]*unstructured.Unstructured)
+}
+
+// ensureNamespaces synthesizes namespaces referenced by the snapshot resources
+// since dumps usually do not include them.
+func (s *Store) ensureNamespaces() {
+	nss := sets.New[string]()
+	for _, oo := range s.objects {
+		for _, o := range oo {
+			if ns := o.GetNamespace(); ns != "" {
+				nss.Insert(ns)
+			}
+		}
+	}
+	if nss.Len() == 0 {
+		return
+	}
+
+	gvr := client.NsGVR
+	s.register(gvr, apiResource(gvr, "Namespace", false))
+	for ns := range nss {
+		if _, ok := s.objects[gvr][ns]; ok {
+			continue
+		}
+		var o unstructured.Unstructured
+		o.SetAPIVersion("v1")
+		o.SetKind("Namespace")
+		o.SetName(ns)
+		_ = unstructured.SetNestedField(o.Object, "Active", "status", "phase")
+		s.objects[gvr][ns] = &o
+	}
+}
+
+// Count returns the number of resources in the snapshot.
+func (s *Store) Count() int {
+	var n int
+	for _, oo := range s.objects {
+		n += len(oo)
+	}
+
+	return n
+}
+
+// Resource returns a resource metadata.
+func (s *Store) Resource(gvr *client.GVR) (*metav1.APIResource, bool) {
+	res, ok := s.resources[gvr]
+
+	return res, ok
+}
+
+// Resources returns the snapshot resources metadata grouped by group version.
+func (s *Store) Resources() []*metav1.APIResourceList {
+	gvs := make(map[string]*metav1.APIResourceList)
+	for gvr, res := range s.resources {
+		gv := gvr.GV().String()
+		l, ok := gvs[gv]
+		if !ok {
+			l = &metav1.APIResourceList{GroupVersion: gv}
+			gvs[gv] = l
+		}
+		l.APIResources = append(l.APIResources, *res)
+	}
+
+	ll := make([]*metav1.APIResourceList, 0, len(gvs))
+	for _, l := range gvs {
+		sort.Slice(l.APIResources, func(i, j int) bool {
+			return l.APIResources[i].Name < l.APIResources[j].Name
+		})
+		ll = append(ll, l)
+	}
+	sort.Slice(ll, func(i, j int) bool {
+		return ll[i].GroupVersion < ll[j].GroupVersion
+	})
+
+	return ll
+}
+
+// List returns the resources matching a namespace and a selector.
+func (s *Store) List(gvr *client.GVR, ns string, sel labels.Selector) []runtime.Object {
+	objs := s.objects[gvr]
+	if sel == nil {
+		sel = labels.Everything()
+	}
+	if client.IsAllNamespaces(ns) || client.IsClusterScoped(ns) {
+		ns = client.BlankNamespace
+	}
+
+	kk := make([]string, 0, len(objs))
+	for k, o := range objs {
+		if ns != client.BlankNamespace && o.GetNamespace() != ns {
+			continue
+		}
+		if !sel.Matches(labels.Set(o.GetLabels())) {
+			continue
+		}
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+
+	oo := make([]runtime.Object, 0, len(kk))
+	for _, k := range kk {
+		oo = append(oo, objs[k].DeepCopy())
+	}
+
+	return oo
+}
+
+// Get returns a resource given its fully qualified name.
+func (s *Store) Get(gvr *client.GVR, fqn string) (runtime.Object, error) {
+	ns, n := client.Namespaced(fqn)
+	if client.IsClusterScoped(ns) || client.IsAllNamespaces(ns) {
+		ns = client.BlankNamespace
+	}
+	if o, ok := s.objects[gvr][client.FQN(ns, n)]; ok {
+		return o.DeepCopy(), nil
+	}
+
+	return nil, apierrors.NewNotFound(*gvr.GR(), n)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// resourceFor resolves a kind to its resource. Defined CRDs take precedence over
+// the plural naming conventions.
+func resourceFor(gvk schema.GroupVersionKind, namespaced bool, crds map[schema.GroupKind]apiext.CustomResourceDefinition) (*client.GVR, *metav1.APIResource) {
+	if crd, ok := crds[gvk.GroupKind()]; ok {
+		gvr := client.FromGVAndR(gvk.GroupVersion().String(), crd.Spec.Names.Plural)
+		res := apiResource(gvr, gvk.Kind, crd.Spec.Scope == apiext.NamespaceScoped)
+		res.SingularName, res.ShortNames = crd.Spec.Names.Singular, crd.Spec.Names.ShortNames
+		res.Categories = crd.Spec.Names.Categories
+
+		return gvr, res
+	}
+
+	plural, _ := meta.UnsafeGuessKindToResource(gvk)
+	gvr := client.FromGVAndR(gvk.GroupVersion().String(), plural.Resource)
+
+	return gvr, apiResource(gvr, gvk.Kind, namespaced)
+}
+
+func apiResource(gvr *client.GVR, kind string, namespaced bool) *metav1.APIResource {
+	return &metav1.APIResource{
+		Name:         gvr.R(),
+		SingularName: strings.ToLower(kind),
+		Group:        gvr.G(),
+		Version:      gvr.V(),
+		Kind:         kind,
+		Namespaced:   namespaced,
+		ShortNames:   shortNames[gvr.String()],
+		Verbs:        metav1.Verbs{"get", "list", "watch"},
+	}
+}
+
+// readManifests decodes all resources in a yaml/json file, expanding lists.
+func readManifests(path string) ([]*unstructured.Unstructured, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var oo []*unstructured.Unstructured
+	dec := yaml.NewYAMLOrJSONDecoder(bufio.NewReader(f), maxDocSize)
+	for {
+		var raw map[string]any
+		if err := dec.Decode(&raw); err != nil {
+			if errors.Is(err, io.EOF) {
+				return oo, nil
+			}
+			return oo, err
+		}
+		if len(raw) == 0 {
+			continue
+		}
+		oo = append(oo, expand(&unstructured.Unstructured{Object: raw})...)
+	}
+}
+
+// expand flattens a list into its items. Typed lists ie kubectl cluster-info dump
+// omit the items kind so it is derived from the list kind.
+func expand(o *unstructured.Unstructured) []*unstructured.Unstructured {
+	if !o.IsList() {
+		return []*unstructured.Unstructured{o}
+	}
+
+	l, err := o.ToList()
+	if err != nil {
+		slog.Warn("Invalid snapshot list", slogs.Error, err)
+		return nil
+	}
+	kind := strings.TrimSuffix(o.GetKind(), "List")
+	oo := make([]*unstructured.Unstructured, 0, len(l.Items))
+	for i := range l.Items {
+		it := &l.Items[i]
+		if it.GetAPIVersion() == "" {
+			it.SetAPIVersion(o.GetAPIVersion())
+		}
+		if it.GetKind() == "" && kind != "" {
+			it.SetKind(kind)
+		}
+		oo = append(oo, expand(it)...)
+	}
+
+	return oo
+}
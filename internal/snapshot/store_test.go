@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package snapshot_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/snapshot"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+func TestLoad(t *testing.T) {
+	s, err := snapshot.Load("testdata/dump")
+	require.NoError(t, err)
+
+	assert.Equal(t, "dump", s.Name())
+	assert.Equal(t, 11, s.Count())
+
+	uu := map[string]struct {
+		gvr        *client.GVR
+		ns         string
+		sel        string
+		count      int
+		namespaced bool
+	}{
+		"pods": {
+			gvr:        client.PodGVR,
+			ns:         client.NamespaceAll,
+			count:      2,
+			namespaced: true,
+		},
+		"pods-selector": {
+			gvr:        client.PodGVR,
+			ns:         "default",
+			sel:        "app=zorg",
+			count:      1,
+			namespaced: true,
+		},
+		"pods-ns": {
+			gvr:        client.PodGVR,
+			ns:         "fred",
+			namespaced: true,
+		},
+		"nodes": {
+			gvr:   client.NodeGVR,
+			ns:    client.ClusterScope,
+			count: 1,
+		},
+		"namespaces": {
+			gvr:   client.NsGVR,
+			ns:    client.ClusterScope,
+			count: 2,
+		},
+		"deployments": {
+			gvr:        client.DpGVR,
+			ns:         "fred",
+			count:      1,
+			namespaced: true,
+		},
+		"crd": {
+			gvr:        client.NewGVR("zoo.io/v1/mice"),
+			ns:         client.NamespaceAll,
+			count:      1,
+			namespaced: true,
+		},
+		"missing": {
+			gvr: client.NewGVR("zoo.io/v1/cats"),
+			ns:  client.NamespaceAll,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			sel, err := labels.Parse(u.sel)
+			require.NoError(t, err)
+			assert.Len(t, s.List(u.gvr, u.ns, sel), u.count)
+			if res, ok := s.Resource(u.gvr); ok {
+				assert.Equal(t, u.namespaced, res.Namespaced)
+			}
+		})
+	}
+}
+
+func TestLoadFail(t *testing.T) {
+	_, err := snapshot.Load("testdata/bozo")
+	require.Error(t, err)
+
+	_, err = snapshot.Load(t.TempDir())
+	require.Error(t, err)
+}
+
+func TestStoreGet(t *testing.T) {
+	s, err := snapshot.Load("testdata/dump")
+	require.NoError(t, err)
+
+	o, err := s.Get(client.PodGVR, "default/p1")
+	require.NoError(t, err)
+	u := o.(*unstructured.Unstructured)
+	assert.Equal(t, "Pod", u.GetKind())
+	assert.Equal(t, "v1", u.GetAPIVersion())
+
+	o, err = s.Get(client.NsGVR, "-/fred")
+	require.NoError(t, err)
+	assert.Equal(t, "fred", o.(*unstructured.Unstructured).GetName())
+
+	_, err = s.Get(client.PodGVR, "default/zorg")
+	require.Error(t, err)
+}
+
+func TestStoreResources(t *testing.T) {
+	s, err := snapshot.Load("testdata/dump")
+	require.NoError(t, err)
+
+	gg := make(map[string][]string)
+	for _, l := range s.Resources() {
+		for _, r := range l.APIResources {
+			gg[l.GroupVersion] = append(gg[l.GroupVersion], r.Name)
+		}
+	}
+	assert.Equal(t, map[string][]string{
+		"apiextensions.k8s.io/v1": {"customresourcedefinitions"},
+		"apps/v1":                 {"deployments"},
+		"v1":                      {"configmaps", "events", "namespaces", "nodes", "pods"},
+		"zoo.io/v1":               {"mice"},
+	}, gg)
+
+	res, ok := s.Resource(client.NewGVR("zoo.io/v1/mice"))
+	require.True(t, ok)
+	assert.Equal(t, []string{"ms"}, res.ShortNames)
+	assert.Equal(t, "mouse", res.SingularName)
+
+	res, ok = s.Resource(client.PodGVR)
+	require.True(t, ok)
+	assert.Equal(t, []string{"po"}, res.ShortNames)
+}
@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package tchart
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+var bars = []rune{'▏', '▎', '▍', '▌', '▋', '▊', '▉', '█'}
+
+// Bin represents a histogram bin.
+type Bin struct {
+	Label string
+	Count int
+}
+
+// Histogram represents a horizontal bars histogram component.
+type Histogram struct {
+	*Component
+
+	bins []Bin
+}
+
+// NewHistogram returns a new histogram.
+func NewHistogram(id string) *Histogram {
+	return &Histogram{
+		Component: NewComponent(id),
+	}
+}
+
+// SetBins sets the histogram bins.
+func (h *Histogram) SetBins(bb []Bin) {
+	h.mx.Lock()
+	defer h.mx.Unlock()
+	h.bins = bb
+}
+
+// GetBins returns the histogram bins.
+func (h *Histogram) GetBins() []Bin {
+	h.mx.RLock()
+	defer h.mx.RUnlock()
+
+	return h.bins
+}
+
+// Draw draws the histogram.
+func (h *Histogram) Draw(screen tcell.Screen) {
+	h.Component.Draw(screen)
+
+	h.mx.RLock()
+	defer h.mx.RUnlock()
+
+	rect := h.asRect()
+	if rect.Dx() <= 0 || rect.Dy() <= 0 || len(h.bins) == 0 {
+		return
+	}
+
+	var labelW, countW, maxC int
+	for _, b := range h.bins {
+		labelW = max(labelW, len(b.Label))
+		countW = max(countW, len(strconv.Itoa(b.Count)))
+		maxC = max(maxC, b.Count)
+	}
+	barW := rect.Dx() - labelW - countW - 5
+	if barW <= 0 {
+		return
+	}
+
+	pad := 0
+	if h.legend != "" {
+		pad++
+	}
+	colors := h.colorForSeries()
+	style := tcell.StyleDefault.Foreground(colors[0]).Background(h.bgColor)
+	axis := tcell.StyleDefault.Foreground(tcell.GetColor(axisColor)).Background(h.bgColor)
+	for i, b := range h.bins {
+		y := rect.Min.Y + i
+		if y >= rect.Max.Y-pad {
+			break
+		}
+		tview.Print(screen, b.Label, rect.Min.X, y, labelW, tview.AlignRight, tcell.ColorOrange)
+		x := rect.Min.X + labelW + 1
+		screen.SetContent(x, y, tview.BoxDrawingsLightVertical, nil, axis)
+		x += 2
+		full, partial := makeBar(b.Count, maxC, barW)
+		for range full {
+			screen.SetContent(x, y, bars[len(bars)-1], nil, style)
+			x++
+		}
+		if partial != 0 {
+			screen.SetContent(x, y, partial, nil, style)
+			x++
+		}
+		tview.Print(screen, fmt.Sprintf(" %d", b.Count), x, y, countW+1, tview.AlignLeft, tcell.ColorWhite)
+	}
+
+	if h.legend != "" {
+		legend := h.legend
+		if h.HasFocus() {
+			legend = fmt.Sprintf("[%s:%s:]", h.focusFgColor, h.focusBgColor) + h.legend + "[::]"
+		}
+		tview.Print(screen, legend, rect.Min.X, rect.Max.Y-1, rect.Dx(), tview.AlignCenter, tcell.ColorWhite)
+	}
+}
+
+// makeBar computes a bar full cells and trailing partial cell for a given count.
+func makeBar(count, maxCount, width int) (int, rune) {
+	if count <= 0 || maxCount <= 0 || width <= 0 {
+		return 0, 0
+	}
+
+	eighths := int(math.Round(float64(count) * float64(width*len(bars)) / float64(maxCount)))
+	full, p := eighths/len(bars), eighths%len(bars)
+	if full == 0 && p == 0 {
+		return 0, bars[0]
+	}
+	if p == 0 {
+		return full, 0
+	}
+
+	return full, bars[p-1]
+}
@@ -0,0 +1,58 @@
+package tchart
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMakeBar(t *testing.T) {
+	uu := map[string]struct {
+		count, max, width int
+		full              int
+		partial           rune
+	}{
+		"empty": {
+			max:   10,
+			width: 10,
+		},
+		"full": {
+			count: 10,
+			max:   10,
+			width: 10,
+			full:  10,
+		},
+		"half": {
+			count: 5,
+			max:   10,
+			width: 10,
+			full:  5,
+		},
+		"partial": {
+			count:   3,
+			max:     8,
+			width:   3,
+			full:    1,
+			partial: '▏',
+		},
+		"tiny": {
+			count:   1,
+			max:     1000,
+			width:   10,
+			partial: '▏',
+		},
+		"no-width": {
+			count: 1,
+			max:   1,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			full, partial := makeBar(u.count, u.max, u.width)
+			assert.Equal(t, u.full, full)
+			assert.Equal(t, u.partial, partial)
+		})
+	}
+}
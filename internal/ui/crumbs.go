@@ -5,6 +5,7 @@ package ui
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/derailed/k9s/internal/config"
@@ -16,8 +17,10 @@ import (
 type Crumbs struct {
 	*tview.TextView
 
-	styles *config.Styles
-	stack  *model.Stack
+	styles    *config.Styles
+	stack     *model.Stack
+	tabs      []string
+	activeTab int
 }
 
 // NewCrumbs returns a new breadcrumb view.
@@ -58,9 +61,25 @@ func (c *Crumbs) StackPopped(_, _ model.Component) {
 // StackTop indicates the top of the stack.
 func (*Crumbs) StackTop(model.Component) {}
 
+// SetTabs updates the workspace tabs titles.
+func (c *Crumbs) SetTabs(tt []string, active int) {
+	c.tabs, c.activeTab = tt, active
+	c.refresh(c.stack.Flatten())
+}
+
+// Reset replaces the breadcrumbs with the given components.
+func (c *Crumbs) Reset(cc []model.Component) {
+	c.stack = model.NewStack()
+	for _, comp := range cc {
+		c.stack.Push(comp)
+	}
+	c.refresh(c.stack.Flatten())
+}
+
 // Refresh updates view with new crumbs.
 func (c *Crumbs) refresh(crumbs []string) {
 	c.Clear()
+	c.refreshTabs()
 	last, bgColor := len(crumbs)-1, c.styles.Frame().Crumb.BgColor
 	for i, crumb := range crumbs {
 		if i == last {
@@ -72,3 +91,24 @@ func (c *Crumbs) refresh(crumbs []string) {
 			c.styles.Body().BgColor)
 	}
 }
+
+func (c *Crumbs) refreshTabs() {
+	if len(c.tabs) < 2 {
+		return
+	}
+	for i, tab := range c.tabs {
+		bgColor := c.styles.Frame().Crumb.BgColor
+		if i == c.activeTab {
+			bgColor = c.styles.Frame().Crumb.ActiveColor
+		}
+		title := strconv.Itoa(i + 1)
+		if tab != "" {
+			title += ":" + tab
+		}
+		_, _ = fmt.Fprintf(c, "[%s:%s:b] %s [-:%s:-] ",
+			c.styles.Frame().Crumb.FgColor,
+			bgColor, title,
+			c.styles.Body().BgColor)
+	}
+	_, _ = fmt.Fprint(c, "| ")
+}
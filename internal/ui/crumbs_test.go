@@ -31,6 +31,17 @@ func TestNewCrumbs(t *testing.T) {
 	assert.Equal(t, "[#000000:#00ffff:b] <c1> [-:#000000:-] [#000000:#00ffff:b] <c2> [-:#000000:-] [#000000:#ffa500:b] <c3> [-:#000000:-] \n", v.GetText(false))
 }
 
+func TestCrumbsTabs(t *testing.T) {
+	v := ui.NewCrumbs(config.NewStyles())
+	v.StackPushed(makeComponent("c1"))
+	v.SetTabs([]string{"main"}, 0)
+	assert.Equal(t, "[#000000:#ffa500:b] <c1> [-:#000000:-] \n", v.GetText(false))
+
+	v.SetTabs([]string{"", "logs"}, 1)
+	v.Reset([]model.Component{makeComponent("c2")})
+	assert.Equal(t, "[#000000:#00ffff:b] 1 [-:#000000:-] [#000000:#ffa500:b] 2:logs [-:#000000:-] | [#000000:#ffa500:b] <c2> [-:#000000:-] \n", v.GetText(false))
+}
+
 // Helpers...
 
 type c struct {
@@ -0,0 +1,35 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectionDialog(t *testing.T) {
+	a := tview.NewApplication()
+	p := ui.NewPages()
+	a.SetRoot(p, false)
+
+	sel := -1
+	ShowSelection(new(config.Dialog), p, "Blee", []string{"a", "b"}, func(i int) {
+		sel = i
+	})
+
+	d := p.GetPrimitive(dialogKey).(*ui.ModalList)
+	assert.NotNil(t, d)
+	a.SetFocus(d)
+
+	h := d.InputHandler()
+	h(tcell.NewEventKey(tcell.KeyEnd, 0, tcell.ModNone), func(tview.Primitive) {})
+	h(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone), func(tview.Primitive) {})
+	assert.Equal(t, 1, sel)
+	assert.Nil(t, p.GetPrimitive(dialogKey))
+}
@@ -83,6 +83,8 @@ const (
 	KeyDash         = 45
 	KeyLeftBracket  = 91
 	KeyRightBracket = 93
+	KeyLeftBrace    = 123
+	KeyRightBrace   = 125
 )
 
 // Define Shift Keys.
@@ -14,6 +14,7 @@ type SelectTable struct {
 
 	model      Tabular
 	selectedFn func(string) string
+	cursorFn   func(string)
 	marks      map[string]struct{}
 	selFgColor tcell.Color
 	selBgColor tcell.Color
@@ -96,6 +97,11 @@ func (s *SelectTable) SetSelectedFn(f func(string) string) {
 	s.selectedFn = f
 }
 
+// SetCursorFn defines a function called when the selected row changes.
+func (s *SelectTable) SetCursorFn(f func(string)) {
+	s.cursorFn = f
+}
+
 // GetSelectedRowIndex fetch the currently selected row index.
 func (s *SelectTable) GetSelectedRowIndex() int {
 	r, _ := s.GetSelection()
@@ -129,6 +135,9 @@ func (s *SelectTable) selectionChanged(r, c int) {
 			tcell.StyleDefault.Foreground(s.selFgColor).
 				Background(cell.Color).Attributes(tcell.AttrBold))
 	}
+	if s.cursorFn != nil && s.model != nil {
+		s.cursorFn(s.GetSelectedItem())
+	}
 }
 
 // ClearMarks delete all marked items.
@@ -33,10 +33,10 @@ const (
 	ascIndicator  = "↑"
 
 	// FullFmat specifies a namespaced dump file name.
-	FullFmat = "%s-%s-%d.csv"
+	FullFmat = "%s-%s-%d.%s"
 
 	// NoNSFmat specifies a cluster wide dump file name.
-	NoNSFmat = "%s-%d.csv"
+	NoNSFmat = "%s-%d.%s"
 )
 
 func mustExtractStyles(ctx context.Context) *config.Styles {
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
 	"strings"
 
 	"github.com/derailed/k9s/internal/config"
@@ -114,6 +115,74 @@ func hotKeyActions(r Runner, aa *ui.KeyActions) error {
 	return errs
 }
 
+func keymapActions(r Runner, aa *ui.KeyActions) error {
+	return remapActions(aa, r.App().keymap.BindingsFor(r.Aliases()))
+}
+
+type actionMove struct {
+	binding config.KeyBinding
+	key     tcell.Key
+	from    []tcell.Key
+	action  ui.KeyAction
+}
+
+// remapActions rebinds named actions to their configured keys. User bindings clashing
+// with an existing action are reported while presets bindings are skipped.
+func remapActions(aa *ui.KeyActions, bb config.KeyBindings) error {
+	var (
+		errs error
+		mm   = make([]actionMove, 0, len(bb))
+	)
+	for _, b := range bb {
+		key, err := asKey(b.Key)
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("keymap %s: %w", b, err))
+			continue
+		}
+		from, action, ok := findAction(aa, b.Action)
+		if !ok {
+			continue
+		}
+		mm = append(mm, actionMove{binding: b, key: key, from: from, action: action})
+	}
+	for _, m := range mm {
+		aa.Delete(m.from...)
+	}
+	for _, m := range mm {
+		if a, ok := aa.Get(m.key); ok {
+			if !m.binding.Preset {
+				errs = errors.Join(errs, fmt.Errorf("keymap %s conflicts with action %q", m.binding, a.Description))
+			}
+			for _, k := range m.from {
+				if _, ok := aa.Get(k); !ok {
+					aa.Add(k, m.action)
+				}
+			}
+			continue
+		}
+		aa.Add(m.key, m.action)
+	}
+
+	return errs
+}
+
+// findAction returns all keys bound to a named action.
+func findAction(aa *ui.KeyActions, name string) ([]tcell.Key, ui.KeyAction, bool) {
+	var kk []tcell.Key
+	aa.Range(func(k tcell.Key, a ui.KeyAction) {
+		if !a.Opts.Plugin && !a.Opts.HotKey && strings.EqualFold(a.Description, name) {
+			kk = append(kk, k)
+		}
+	})
+	if len(kk) == 0 {
+		return nil, ui.KeyAction{}, false
+	}
+	slices.Sort(kk)
+	a, _ := aa.Get(kk[0])
+
+	return kk, a, true
+}
+
 func gotoCmd(r Runner, cmd, path string, clearStack bool) ui.ActionHandler {
 	return func(*tcell.EventKey) *tcell.EventKey {
 		r.App().gotoResource(cmd, path, clearStack, true)
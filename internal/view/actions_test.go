@@ -7,6 +7,9 @@ import (
 	"log/slog"
 	"testing"
 
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
 	"github.com/stretchr/testify/assert"
 	"k8s.io/apimachinery/pkg/util/sets"
 )
@@ -106,3 +109,85 @@ func TestInScope(t *testing.T) {
 		})
 	}
 }
+
+func TestRemapActions(t *testing.T) {
+	uu := map[string]struct {
+		bb  config.KeyBindings
+		e   map[tcell.Key]string
+		err string
+	}{
+		"none": {
+			e: map[tcell.Key]string{
+				ui.KeyD: "Describe", ui.KeyE: "Edit", ui.KeyL: "Logs", tcell.KeyCtrlU: "Clear Filter", tcell.KeyCtrlQ: "Clear Filter",
+			},
+		},
+		"move": {
+			bb: config.KeyBindings{
+				{Scope: "all", Action: "describe", Key: "Shift-K"},
+				{Scope: "all", Action: "Clear Filter", Key: "Ctrl-X"},
+				{Scope: "all", Action: "Bozo", Key: "Ctrl-Y"},
+			},
+			e: map[tcell.Key]string{
+				ui.KeyShiftK: "Describe", ui.KeyE: "Edit", ui.KeyL: "Logs", tcell.KeyCtrlX: "Clear Filter",
+			},
+		},
+		"swap": {
+			bb: config.KeyBindings{
+				{Scope: "all", Action: "Describe", Key: "e"},
+				{Scope: "all", Action: "Edit", Key: "d"},
+			},
+			e: map[tcell.Key]string{
+				ui.KeyE: "Describe", ui.KeyD: "Edit", ui.KeyL: "Logs", tcell.KeyCtrlU: "Clear Filter", tcell.KeyCtrlQ: "Clear Filter",
+			},
+		},
+		"conflict": {
+			bb: config.KeyBindings{
+				{Scope: "all", Action: "Describe", Key: "l"},
+			},
+			e: map[tcell.Key]string{
+				ui.KeyD: "Describe", ui.KeyE: "Edit", ui.KeyL: "Logs", tcell.KeyCtrlU: "Clear Filter", tcell.KeyCtrlQ: "Clear Filter",
+			},
+			err: `keymap all:Describe[l] conflicts with action "Logs"`,
+		},
+		"preset-conflict": {
+			bb: config.KeyBindings{
+				{Scope: "all", Action: "Describe", Key: "l", Preset: true},
+			},
+			e: map[tcell.Key]string{
+				ui.KeyD: "Describe", ui.KeyE: "Edit", ui.KeyL: "Logs", tcell.KeyCtrlU: "Clear Filter", tcell.KeyCtrlQ: "Clear Filter",
+			},
+		},
+		"bad-key": {
+			bb: config.KeyBindings{
+				{Scope: "pods", Action: "Describe", Key: "Hyper-K"},
+			},
+			e: map[tcell.Key]string{
+				ui.KeyD: "Describe", ui.KeyE: "Edit", ui.KeyL: "Logs", tcell.KeyCtrlU: "Clear Filter", tcell.KeyCtrlQ: "Clear Filter",
+			},
+			err: `keymap pods:Describe[Hyper-K]: invalid key specified: "Hyper-K"`,
+		},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			aa := ui.NewKeyActionsFromMap(ui.KeyMap{
+				ui.KeyD:        ui.NewKeyAction("Describe", nil, true),
+				ui.KeyE:        ui.NewKeyAction("Edit", nil, true),
+				ui.KeyL:        ui.NewKeyAction("Logs", nil, true),
+				tcell.KeyCtrlU: ui.NewSharedKeyAction("Clear Filter", nil, false),
+				tcell.KeyCtrlQ: ui.NewSharedKeyAction("Clear Filter", nil, false),
+			})
+			err := remapActions(aa, u.bb)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+			} else {
+				assert.NoError(t, err)
+			}
+			e := make(map[tcell.Key]string, aa.Len())
+			aa.Range(func(k tcell.Key, a ui.KeyAction) {
+				e[k] = a.Description
+			})
+			assert.Equal(t, u.e, e)
+		})
+	}
+}
@@ -0,0 +1,192 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+const alertTitle = "Alerts"
+
+// Alert presents the raised alerts.
+type Alert struct {
+	ResourceViewer
+}
+
+// NewAlert returns a new viewer.
+func NewAlert(gvr *client.GVR) ResourceViewer {
+	a := Alert{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	a.GetTable().SetBorderFocusColor(tcell.ColorSteelBlue)
+	a.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorRoyalBlue).Attributes(tcell.AttrNone))
+	a.GetTable().SetSortCol(ageCol, true)
+	a.GetTable().SetEnterFn(a.gotoAlert)
+	a.SetContextFn(a.alertsContext)
+	a.AddBindKeysFn(a.bindKeys)
+
+	return &a
+}
+
+// Init initializes the view.
+func (a *Alert) Init(ctx context.Context) error {
+	if err := a.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	a.GetTable().GetModel().SetNamespace(client.BlankNamespace)
+
+	return nil
+}
+
+// Name returns the component name.
+func (*Alert) Name() string { return alertTitle }
+
+func (a *Alert) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace, tcell.KeyCtrlD, ui.KeyE)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyD:      ui.NewKeyAction("Describe", a.describeCmd, true),
+		ui.KeyShiftV: ui.NewKeyAction("Sort Severity", a.GetTable().SortColCmd("SEVERITY", true), false),
+		ui.KeyShiftR: ui.NewKeyAction("Sort Rule", a.GetTable().SortColCmd("RULE", true), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort State", a.GetTable().SortColCmd("STATE", true), false),
+	})
+}
+
+func (a *Alert) alertsContext(ctx context.Context) context.Context {
+	aa := []render.AlertRes{}
+	if m := a.App().alerts; m != nil {
+		aa = m.Alerts()
+	}
+
+	return context.WithValue(ctx, internal.KeyAlerts, aa)
+}
+
+func (*Alert) gotoAlert(app *App, _ ui.Tabular, _ *client.GVR, id string) {
+	gvr, path := render.AlertFromID(id)
+	app.gotoResource(gvr, path, false, true)
+}
+
+func (a *Alert) describeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	id := a.GetTable().GetSelectedItem()
+	if id == "" {
+		return evt
+	}
+	gvr, path := render.AlertFromID(id)
+	describeResource(a.App(), nil, client.NewGVR(gvr), path)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Alert notifications...
+
+// alertNotifier surfaces alerts via flash messages, terminal bell and desktop notifications.
+type alertNotifier struct {
+	app     *App
+	bell    bool
+	desktop bool
+	out     io.Writer
+}
+
+func newAlertNotifier(app *App, cfg config.Alerts) *alertNotifier {
+	return &alertNotifier{
+		app:     app,
+		bell:    cfg.Bell,
+		desktop: cfg.Desktop,
+		out:     os.Stdout,
+	}
+}
+
+// AlertFired notifies an alert fired.
+func (n *alertNotifier) AlertFired(a render.AlertRes) {
+	msg := fmt.Sprintf("🚨 [%s] %s %s %s", a.Rule, client.NewGVR(a.GVR).R(), a.Path, a.Value)
+	n.app.QueueUpdateDraw(func() {
+		switch a.Severity {
+		case config.AlertError:
+			n.app.Flash().Err(fmt.Errorf("%s", msg))
+		case config.AlertInfo:
+			n.app.Flash().Info(msg)
+		default:
+			n.app.Flash().Warn(msg)
+		}
+		n.notify("k9s alert", msg)
+	})
+}
+
+// AlertResolved notifies an alert resolved.
+func (n *alertNotifier) AlertResolved(a render.AlertRes) {
+	n.app.QueueUpdateDraw(func() {
+		n.app.Flash().Infof("✅ [%s] %s %s resolved", a.Rule, client.NewGVR(a.GVR).R(), a.Path)
+	})
+}
+
+// notify rings the terminal bell and raises a desktop notification via OSC sequences.
+// Writes happen on the ui thread so they do not interleave with screen updates.
+func (n *alertNotifier) notify(title, msg string) {
+	if n.bell {
+		_, _ = io.WriteString(n.out, "\a")
+	}
+	if n.desktop {
+		title, msg = oscSanitize(title), oscSanitize(msg)
+		_, _ = fmt.Fprintf(n.out, "\x1b]777;notify;%s;%s\x1b\\", title, msg)
+		_, _ = fmt.Fprintf(n.out, "\x1b]9;%s: %s\x1b\\", title, msg)
+	}
+}
+
+// oscSanitize strips control characters and separators from OSC payloads.
+func oscSanitize(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r < 0x20 || r == 0x7f || r == ';' {
+			return ' '
+		}
+		return r
+	}, s)
+}
+
+// ----------------------------------------------------------------------------
+// App alerts management...
+
+func (a *App) stopAlerts() {
+	if a.alerts != nil {
+		a.alerts.Stop()
+		a.alerts = nil
+	}
+}
+
+func (a *App) initAlerts() {
+	a.stopAlerts()
+	cfg := a.Config.K9s.Alerts
+	if !cfg.Enable || len(cfg.Rules) == 0 {
+		return
+	}
+
+	m := model.NewAlertMonitor(a.factory, a.Config.ActiveContextName(), cfg, a.resolveGVR)
+	m.AddListener(newAlertNotifier(a, cfg))
+	if cfg.Webhook != "" {
+		m.AddListener(model.NewAlertWebhook(cfg.Webhook))
+	}
+	m.Start(context.Background())
+	a.alerts = m
+}
+
+func (a *App) resolveGVR(s string) *client.GVR {
+	if a.command != nil {
+		if gvr, _, ok := a.command.alias.AsGVR(s); ok {
+			return gvr
+		}
+	}
+
+	return client.NewGVR(s)
+}
@@ -0,0 +1,38 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlertNotifierNotify(t *testing.T) {
+	uu := map[string]struct {
+		bell, desktop bool
+		e             string
+	}{
+		"none": {},
+		"bell": {
+			bell: true,
+			e:    "\a",
+		},
+		"desktop": {
+			desktop: true,
+			e:       "\x1b]777;notify;k9s alert;crash  p1\x1b\\\x1b]9;k9s alert: crash  p1\x1b\\",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var out bytes.Buffer
+			n := alertNotifier{bell: u.bell, desktop: u.desktop, out: &out}
+			n.notify("k9s alert", "crash;\np1")
+			assert.Equal(t, u.e, out.String())
+		})
+	}
+}
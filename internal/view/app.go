@@ -22,8 +22,10 @@ import (
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/slogs"
+	"github.com/derailed/k9s/internal/snapshot"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/derailed/k9s/internal/view/cmd"
@@ -31,6 +33,7 @@ import (
 	"github.com/derailed/k9s/internal/watch"
 	"github.com/derailed/tcell/v2"
 	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/util/sets"
 )
 
 // ExitStatus indicates UI exit conditions.
@@ -47,31 +50,42 @@ const (
 type App struct {
 	version string
 	*ui.App
-	Content       *PageStack
-	command       *Command
-	factory       *watch.Factory
-	cancelFn      context.CancelFunc
-	clusterModel  *model.ClusterInfo
-	cmdHistory    *model.History
-	filterHistory *model.History
-	conRetry      int32
-	showHeader    bool
-	showLogo      bool
-	showCrumbs    bool
+	Content        *PageStack
+	tabs           *Workspaces
+	command        *Command
+	factory        Factory
+	snapshot       *snapshot.Store
+	cancelFn       context.CancelFunc
+	clusterModel   *model.ClusterInfo
+	cmdHistory     *model.History
+	filterHistory  *model.History
+	replayCancelFn context.CancelFunc
+	alerts         *model.AlertMonitor
+	keymap         *config.Keymap
+	globalActions  *ui.KeyActions
+	conRetry       int32
+	showHeader     bool
+	showLogo       bool
+	showCrumbs     bool
 }
 
 // NewApp returns a K9s app instance.
 func NewApp(cfg *config.Config) *App {
+	ws := NewWorkspace("")
 	a := App{
 		App:           ui.NewApp(cfg, cfg.K9s.ActiveContextName()),
-		cmdHistory:    model.NewHistory(model.MaxHistory),
+		cmdHistory:    ws.cmdHistory,
 		filterHistory: model.NewHistory(model.MaxHistory),
-		Content:       NewPageStack(),
+		Content:       ws.content,
+		keymap:        config.NewKeymap(),
+		tabs:          NewWorkspaces(),
 	}
+	a.tabs.Add(ws)
 	a.ReloadStyles()
 
 	a.Views()["statusIndicator"] = ui.NewStatusIndicator(a.App, a.Styles)
 	a.Views()["clusterInfo"] = NewClusterInfo(&a)
+	a.Views()["pins"] = NewPinPanel(&a)
 
 	return &a
 }
@@ -88,6 +102,19 @@ func (a *App) UpdateClusterInfo() {
 	}
 }
 
+// UseSnapshot serves resources off a cluster snapshot in lieu of live informers.
+func (a *App) UseSnapshot(s *snapshot.Store) {
+	a.snapshot = s
+}
+
+func (a *App) newFactory() Factory {
+	if a.snapshot != nil {
+		return snapshot.NewFactory(a.Conn(), a.snapshot)
+	}
+
+	return watch.NewFactory(a.Conn())
+}
+
 // ConOK checks the connection is cool, returns false otherwise.
 func (a *App) ConOK() bool {
 	return atomic.LoadInt32(&a.conRetry) == 0
@@ -107,13 +134,17 @@ func (a *App) Init(version string, _ int) error {
 	a.App.Init()
 	a.SetInputCapture(a.keyboard)
 	a.bindKeys()
+	a.globalActions = ui.NewKeyActions()
+	a.globalActions.Merge(a.GetActions())
 	if a.Conn() == nil {
 		return errors.New("no client connection detected")
 	}
 	ns := a.Config.ActiveNamespace()
 
-	a.factory = watch.NewFactory(a.Conn())
+	a.factory = a.newFactory()
 	a.initFactory(ns)
+	a.initEventArchiver()
+	a.initSessionRecorder()
 
 	a.clusterModel = model.NewClusterInfo(a.factory, a.version, a.Config.K9s)
 	a.clusterModel.AddListener(a.clusterInfo())
@@ -128,9 +159,11 @@ func (a *App) Init(version string, _ int) error {
 		return err
 	}
 	a.CmdBuff().SetSuggestionFn(a.suggestCommand())
+	a.initAlerts()
 
 	a.layout(ctx)
 	a.initSignals()
+	a.initKeymap()
 
 	if a.Config.K9s.ImageScans.Enable {
 		a.initImgScanner(version)
@@ -155,13 +188,123 @@ func (a *App) initImgScanner(version string) {
 	go vul.ImgScanner.Init("k9s", version)
 }
 
+func (*App) stopEventArchiver() {
+	if ar := dao.SetEvArchiver(nil); ar != nil {
+		ar.Stop()
+	}
+}
+
+func (a *App) initEventArchiver() {
+	a.stopEventArchiver()
+	if !a.Config.K9s.EventArchive.Enable {
+		return
+	}
+	path, err := a.Config.ContextEventsPath()
+	if err != nil {
+		slog.Warn("Unable to locate events archive", slogs.Error, err)
+		return
+	}
+	ar := dao.NewEventArchiver(path, a.Config.K9s.EventArchive)
+	if err := ar.Load(); err != nil {
+		slog.Warn("Unable to load events archive", slogs.Error, err)
+	}
+	if err := ar.Start(a.factory); err != nil {
+		slog.Warn("Unable to start events archiver", slogs.Error, err)
+		a.Flash().Warnf("Event archive disabled: %s", err)
+		return
+	}
+	dao.SetEvArchiver(ar)
+}
+
+func (*App) stopSessionRecorder() {
+	if r := dao.SetSessRecorder(nil); r != nil {
+		r.Stop()
+	}
+}
+
+func (a *App) initSessionRecorder() {
+	a.stopSessionRecorder()
+	if !a.Config.K9s.SessionRecording.Enable {
+		return
+	}
+	dir, err := a.Config.ContextSessionsDir()
+	if err != nil {
+		slog.Warn("Unable to locate sessions dir", slogs.Error, err)
+		return
+	}
+	r := dao.NewSessionRecorder(dir, a.Config.ActiveContextName(), a.Config.K9s.SessionRecording)
+	if err := r.Start(time.Now()); err != nil {
+		slog.Warn("Unable to start session recorder", slogs.Error, err)
+		a.Flash().Warnf("Session recording disabled: %s", err)
+		return
+	}
+	dao.SetSessRecorder(r)
+}
+
+func (a *App) stopReplay() {
+	if a.replayCancelFn != nil {
+		a.replayCancelFn()
+		a.replayCancelFn = nil
+	}
+}
+
+// loadKeymap loads the context keymap used to remap views actions.
+func (a *App) loadKeymap() bool {
+	a.keymap = config.NewKeymap()
+	if err := a.keymap.Load(a.Config.ContextKeymapPath()); err != nil {
+		slog.Warn("Keymap load failed", slogs.Error, err)
+		a.Logo().Warn("Keymap load failed!")
+		a.keymap = config.NewKeymap()
+		return false
+	}
+
+	return true
+}
+
+// initKeymap remaps global actions and reports keymap conflicts with hotkeys and plugins.
+// Global actions are first reset to their defaults to drop prior context remaps.
+func (a *App) initKeymap() {
+	a.GetActions().Reset(a.globalActions)
+	if !a.loadKeymap() {
+		return
+	}
+	km := a.keymap
+	if err := remapActions(a.GetActions(), km.BindingsFor(nil)); err != nil {
+		slog.Warn("Keymap remap failed", slogs.Error, err)
+	}
+
+	hh := config.NewHotKeys()
+	if err := hh.Load(a.Config.ContextHotkeysPath()); err != nil {
+		slog.Warn("Hotkeys load failed", slogs.Error, err)
+	}
+	pp := config.NewPlugins()
+	if path, err := a.Config.ContextPluginsPath(); err == nil {
+		if err := pp.Load(path, true); err != nil {
+			slog.Warn("Plugins load failed", slogs.Error, err)
+		}
+	}
+	cc := km.Conflicts(hh, pp)
+	if len(cc) == 0 {
+		return
+	}
+	for _, c := range cc {
+		slog.Warn("Keymap conflict detected", slogs.Message, c)
+	}
+	a.Logo().Warn("Keymap conflicts detected!")
+}
+
 func (a *App) layout(ctx context.Context) {
 	flash := ui.NewFlash(a.App)
 	go flash.Watch(ctx, a.Flash().Channel())
 
 	main := tview.NewFlex().SetDirection(tview.FlexRow)
 	main.AddItem(a.statusIndicator(), 1, 1, false)
-	main.AddItem(a.Content, 0, 10, true)
+	body := tview.NewFlex().SetDirection(tview.FlexColumn)
+	body.AddItem(a.tabs, 0, 1, true)
+	if a.Config.K9s.UI.ShowPins {
+		body.AddItem(a.pinPanel(), pinPanelWidth, 1, false)
+	}
+	main.AddItem(body, 0, 10, true)
 	if !a.Config.K9s.IsCrumbsless() {
 		main.AddItem(a.Crumbs(), 1, 1, false)
 	}
@@ -248,6 +391,9 @@ func (a *App) bindKeys() {
 		ui.KeyLeftBracket:  ui.NewSharedKeyAction("Go Back", a.previousCommand, false),
 		ui.KeyRightBracket: ui.NewSharedKeyAction("Go Forward", a.nextCommand, false),
 		ui.KeyDash:         ui.NewSharedKeyAction("Last View", a.lastCommand, false),
+		ui.KeyLeftBrace:    ui.NewSharedKeyAction("Previous Tab", a.prevTabCmd, false),
+		ui.KeyRightBrace:   ui.NewSharedKeyAction("Next Tab", a.nextTabCmd, false),
+		tcell.KeyCtrlT:     ui.NewSharedKeyAction("New Tab", a.newTabCmd, false),
 		tcell.KeyCtrlA:     ui.NewSharedKeyAction("Aliases", a.aliasCmd, false),
 		tcell.KeyEnter:     ui.NewKeyAction("Goto", a.gotoCmd, false),
 		tcell.KeyCtrlC:     ui.NewKeyAction("Quit", a.quitCmd, false),
@@ -342,6 +488,7 @@ func (a *App) Resume() {
 	ctx, a.cancelFn = context.WithCancel(context.Background())
 
 	go a.clusterUpdater(ctx)
+	go a.pinPanel().watch(ctx)
 
 	if a.Config.K9s.UI.Reactive {
 		if err := a.ConfigWatcher(ctx, a); err != nil {
@@ -481,9 +628,13 @@ func (a *App) switchContext(ci *cmd.Interpreter, force bool) error {
 			slog.Error("Fail to save config to disk", slogs.Subsys, "config", slogs.Error, err)
 		}
 		a.initFactory(ns)
+		a.initEventArchiver()
+		a.initSessionRecorder()
+		a.initKeymap()
 		if err := a.command.Reset(a.Config.ContextAliasesPath(), true); err != nil {
 			return err
 		}
+		a.initAlerts()
 
 		slog.Debug("Switching Context",
 			slogs.Context, contextName,
@@ -492,6 +643,9 @@ func (a *App) switchContext(ci *cmd.Interpreter, force bool) error {
 		)
 		a.Flash().Infof("Switching context to %q::%q", contextName, ns)
 		a.ReloadStyles()
+		if err := a.tabs.ResetInactive(context.WithValue(context.Background(), internal.KeyApp, a)); err != nil {
+			return err
+		}
 		a.gotoResource(a.Config.ActiveView(), "", true, true)
 		a.clusterModel.Reset(a.factory)
 	}
@@ -517,6 +671,10 @@ func (a *App) BailOut(exitCode int) {
 	}
 
 	a.stopImgScanner()
+	a.stopEventArchiver()
+	a.stopReplay()
+	a.stopSessionRecorder()
+	a.stopAlerts()
 	a.factory.Terminate()
 	a.App.BailOut(exitCode)
 }
@@ -780,6 +938,9 @@ func (a *App) inject(c model.Component, clearStack bool) error {
 		)
 		return err
 	}
+	if _, ok := c.(ResourceViewer); !ok {
+		a.remapView(c)
+	}
 	if clearStack {
 		a.Content.Clear()
 	}
@@ -788,6 +949,22 @@ func (a *App) inject(c model.Component, clearStack bool) error {
 	return nil
 }
 
+// remapView applies the keymap to a view actions. Resource viewers remap their
+// actions whenever they are refreshed.
+func (a *App) remapView(c model.Component) {
+	v, ok := c.(actioner)
+	if !ok {
+		return
+	}
+	var aliases sets.Set[string]
+	if al, ok := c.(aliaser); ok {
+		aliases = al.Aliases()
+	}
+	if err := remapActions(v.Actions(), a.keymap.BindingsFor(aliases)); err != nil {
+		slog.Warn("Keymap remap failed", slogs.Error, err, slogs.CompName, c.Name())
+	}
+}
+
 func (a *App) clusterInfo() *ClusterInfo {
 	return a.Views()["clusterInfo"].(*ClusterInfo)
 }
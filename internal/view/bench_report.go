@@ -0,0 +1,272 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/tchart"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/view/cmd"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	benchReportTitle = "Report"
+	benchTotalStep   = "TOTAL"
+)
+
+var benchReportHeader = []string{
+	"STEP", "REQS", "TIME", "REQ/S", "AVG", "FASTEST", "SLOWEST", "P50", "P90", "P99", "2XX", "4XX/5XX", "ERRORS", "CODES",
+}
+
+// BenchReport represents a benchmark report view.
+type BenchReport struct {
+	*tview.Flex
+
+	app      *App
+	subject  string
+	raw      string
+	report   *render.BenchReport
+	table    *tview.Table
+	histo    *tchart.Histogram
+	actions  *ui.KeyActions
+	stepRows []render.BenchStep
+}
+
+// NewBenchReport returns a new benchmark report view.
+func NewBenchReport(app *App, subject, raw string) *BenchReport {
+	return &BenchReport{
+		Flex:    tview.NewFlex(),
+		app:     app,
+		subject: subject,
+		raw:     raw,
+		report:  render.ParseBenchReport(raw),
+		table:   tview.NewTable(),
+		histo:   tchart.NewHistogram("latency"),
+		actions: ui.NewKeyActions(),
+	}
+}
+
+func (*BenchReport) SetCommand(*cmd.Interpreter)      {}
+func (*BenchReport) SetFilter(string)                 {}
+func (*BenchReport) SetLabelSelector(labels.Selector) {}
+
+// Init initializes the view.
+func (b *BenchReport) Init(context.Context) error {
+	b.SetDirection(tview.FlexRow)
+	b.SetBorder(true)
+	b.SetBorderPadding(0, 0, 1, 1)
+	styles := b.app.Styles.Frame()
+	b.SetTitle(ui.SkinTitle(fmt.Sprintf(detailsTitleFmt, benchReportTitle, b.subject), &styles))
+
+	b.table.SetSelectable(true, false)
+	b.table.SetFixed(1, 1)
+	b.table.SetSelectionChangedFunc(func(r, _ int) {
+		b.showHistogram(r)
+	})
+	b.histo.SetBorderPadding(1, 0, 0, 0)
+
+	b.AddItem(b.table, len(b.report.Steps)+3, 0, true)
+	b.AddItem(b.histo, 0, 1, false)
+
+	b.bindKeys()
+	b.SetInputCapture(b.keyboard)
+	b.app.Styles.AddListener(b)
+	b.StylesChanged(b.app.Styles)
+	b.refresh()
+
+	return nil
+}
+
+// StylesChanged notifies the skin changed.
+func (b *BenchReport) StylesChanged(s *config.Styles) {
+	b.SetBackgroundColor(s.BgColor())
+	b.SetBorderFocusColor(s.Frame().Border.FocusColor.Color())
+	b.table.SetBackgroundColor(s.BgColor())
+	b.table.SetSelectedStyle(tcell.StyleDefault.
+		Foreground(s.Table().CursorFgColor.Color()).
+		Background(s.Table().CursorBgColor.Color()))
+	b.histo.SetBackgroundColor(s.BgColor())
+	if cc := s.Charts().DefaultChartColors; len(cc) > 0 {
+		b.histo.SetSeriesColors(cc[0].Color())
+	}
+}
+
+func (b *BenchReport) bindKeys() {
+	b.actions.Bulk(ui.KeyMap{
+		tcell.KeyEscape: ui.NewKeyAction("Back", b.app.PrevCmd, false),
+		ui.KeyR:         ui.NewKeyAction("Raw Report", b.rawCmd, true),
+	})
+}
+
+func (b *BenchReport) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	if a, ok := b.actions.Get(ui.AsKey(evt)); ok {
+		return a.Action(evt)
+	}
+
+	return evt
+}
+
+func (b *BenchReport) rawCmd(*tcell.EventKey) *tcell.EventKey {
+	details := NewDetails(b.app, "Results", b.subject, contentYAML, false).Update(b.raw)
+	if err := b.app.inject(details, false); err != nil {
+		b.app.Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (b *BenchReport) refresh() {
+	b.table.Clear()
+	th := b.app.Styles.Table().Header
+	for c, h := range benchReportHeader {
+		b.table.SetCell(0, c, tview.NewTableCell(h).
+			SetTextColor(th.FgColor.Color()).
+			SetBackgroundColor(th.BgColor.Color()).
+			SetSelectable(false).
+			SetExpansion(1))
+	}
+
+	b.stepRows = benchReportRows(b.report)
+	fg := b.app.Styles.Table().FgColor.Color()
+	for r, s := range b.stepRows {
+		color := fg
+		if s.Failed() || s.CodesIn(4, 5) > 0 {
+			color = tcell.ColorOrangeRed
+		}
+		for c, f := range benchStepFields(s) {
+			align := tview.AlignRight
+			if c == 0 || c == len(benchReportHeader)-1 {
+				align = tview.AlignLeft
+			}
+			b.table.SetCell(r+1, c, tview.NewTableCell(f).SetTextColor(color).SetAlign(align).SetExpansion(1))
+		}
+	}
+	if len(b.stepRows) > 0 {
+		b.table.Select(len(b.stepRows), 0)
+		b.showHistogram(len(b.stepRows))
+	}
+}
+
+func (b *BenchReport) showHistogram(row int) {
+	if row < 1 || row > len(b.stepRows) {
+		return
+	}
+	s := b.stepRows[row-1]
+	bb := make([]tchart.Bin, 0, len(s.Histogram))
+	for _, h := range s.Histogram {
+		bb = append(bb, tchart.Bin{Label: render.AsDecimal(h.Mark), Count: h.Count})
+	}
+	b.histo.SetBins(bb)
+	b.histo.SetLegend(fmt.Sprintf("Latency Histogram (secs) [%s]", benchStepName(s)))
+}
+
+// Actions returns menu actions.
+func (b *BenchReport) Actions() *ui.KeyActions {
+	return b.actions
+}
+
+// Name returns the component name.
+func (*BenchReport) Name() string { return benchReportTitle }
+
+// Start starts the view.
+func (*BenchReport) Start() {}
+
+// Stop terminates the view.
+func (b *BenchReport) Stop() {
+	b.app.Styles.RemoveListener(b)
+}
+
+// Hints returns menu hints.
+func (b *BenchReport) Hints() model.MenuHints {
+	return b.actions.Hints()
+}
+
+// ExtraHints returns additional hints.
+func (*BenchReport) ExtraHints() map[string]string {
+	return nil
+}
+
+// InCmdMode checks if prompt is active.
+func (*BenchReport) InCmdMode() bool {
+	return false
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// benchReportRows returns the report steps and a total row for scenarios.
+func benchReportRows(r *render.BenchReport) []render.BenchStep {
+	rows := make([]render.BenchStep, 0, len(r.Steps)+1)
+	rows = append(rows, r.Steps...)
+	if len(r.Steps) > 1 {
+		sum := r.Summary()
+		sum.Name = benchTotalStep
+		rows = append(rows, sum)
+	}
+
+	return rows
+}
+
+func benchStepName(s render.BenchStep) string {
+	if s.Name == "" {
+		return "all"
+	}
+
+	return s.Name
+}
+
+func benchStepFields(s render.BenchStep) []string {
+	ff := []string{
+		benchStepName(s),
+		strconv.Itoa(s.Requests()),
+		render.AsDecimal(s.Total),
+		render.AsDecimal(s.RPS),
+		render.AsDecimal(s.Average),
+		render.AsDecimal(s.Fastest),
+		render.AsDecimal(s.Slowest),
+	}
+	for _, p := range render.BenchPercentiles {
+		ff = append(ff, benchLatency(s, p))
+	}
+
+	return append(ff,
+		strconv.Itoa(s.CodesIn(2)),
+		strconv.Itoa(s.CodesIn(4, 5)),
+		strconv.Itoa(s.Errors),
+		benchCodes(s),
+	)
+}
+
+func benchLatency(s render.BenchStep, p int) string {
+	if l, ok := s.Latencies[p]; ok {
+		return render.AsDecimal(l)
+	}
+
+	return render.NAValue
+}
+
+func benchCodes(s render.BenchStep) string {
+	cc := make([]int, 0, len(s.StatusCodes))
+	for c := range s.StatusCodes {
+		cc = append(cc, c)
+	}
+	sort.Ints(cc)
+	ss := make([]string, 0, len(cc))
+	for _, c := range cc {
+		ss = append(ss, fmt.Sprintf("%d:%d", c, s.StatusCodes[c]))
+	}
+
+	return strings.Join(ss, " ")
+}
@@ -5,9 +5,12 @@ package view
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 	"os"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/derailed/k9s/internal"
@@ -35,30 +38,60 @@ func NewBenchmark(gvr *client.GVR) ResourceViewer {
 	b.GetTable().SetSortCol(ageCol, true)
 	b.SetContextFn(b.benchContext)
 	b.GetTable().SetEnterFn(b.viewBench)
+	b.AddBindKeysFn(b.bindKeys)
 
 	return &b
 }
 
+func (b *Benchmark) bindKeys(aa *ui.KeyActions) {
+	aa.Add(ui.KeyShiftC, ui.NewKeyAction("Compare", b.compareCmd, true))
+}
+
 func (b *Benchmark) benchContext(ctx context.Context) context.Context {
 	return context.WithValue(ctx, internal.KeyDir, benchDir(b.App().Config))
 }
 
-func (b *Benchmark) viewBench(app *App, _ ui.Tabular, _ *client.GVR, path string) {
-	mdata, err := readBenchFile(app.Config, b.benchFile())
+func (*Benchmark) viewBench(app *App, _ ui.Tabular, _ *client.GVR, path string) {
+	mdata, err := readBenchFile(app.Config, filepath.Base(path))
 	if err != nil {
 		app.Flash().Errf("Unable to load bench file %s", err)
 		return
 	}
 
-	details := NewDetails(b.App(), "Results", fileToSubject(path), contentYAML, false).Update(mdata)
-	if err := app.inject(details, false); err != nil {
+	if err := app.inject(NewBenchReport(app, fileToSubject(path), mdata), false); err != nil {
 		app.Flash().Err(err)
 	}
 }
 
-func (b *Benchmark) benchFile() string {
-	r := b.GetTable().GetSelectedRowIndex()
-	return ui.TrimCell(b.GetTable().SelectTable, r, 7)
+func (b *Benchmark) compareCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sels := b.GetTable().GetSelectedItems()
+	if len(sels) == 0 {
+		return evt
+	}
+	if len(sels) != 2 {
+		b.App().Flash().Warn("Mark exactly 2 benchmark runs to compare")
+		return nil
+	}
+	sort.Strings(sels)
+
+	rr := make([]*render.BenchReport, 0, len(sels))
+	for _, sel := range sels {
+		mdata, err := readBenchFile(b.App().Config, filepath.Base(sel))
+		if err != nil {
+			b.App().Flash().Errf("Unable to load bench file %s", err)
+			return nil
+		}
+		rr = append(rr, render.ParseBenchReport(mdata))
+	}
+
+	n1, n2 := filepath.Base(sels[0]), filepath.Base(sels[1])
+	details := NewDetails(b.App(), "Compare", fileToSubject(sels[0]), contentTXT, true).
+		Update(benchCompare(n1, rr[0], n2, rr[1]))
+	if err := b.App().inject(details, false); err != nil {
+		b.App().Flash().Err(err)
+	}
+
+	return nil
 }
 
 // ----------------------------------------------------------------------------
@@ -70,6 +103,72 @@ func fileToSubject(path string) string {
 	return ee[0] + "/" + ee[1]
 }
 
+// benchCompare renders a side by side comparison of two benchmark runs.
+func benchCompare(n1 string, r1 *render.BenchReport, n2 string, r2 *render.BenchReport) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "A: %s\nB: %s\n", n1, n2)
+
+	s1, s2 := r1.Summary(), r2.Summary()
+	row := func(k, v1, v2, d string) {
+		fmt.Fprintf(&b, "%-12s %14s %14s %10s\n", k, v1, v2, d)
+	}
+	measure := func(k string, v1, v2 float64) {
+		row(k, render.AsDecimal(v1), render.AsDecimal(v2), benchDelta(v1, v2))
+	}
+	count := func(k string, v1, v2 int) {
+		row(k, strconv.Itoa(v1), strconv.Itoa(v2), benchDelta(float64(v1), float64(v2)))
+	}
+
+	b.WriteString("\n")
+	row("METRIC", "A", "B", "DELTA")
+	count("Requests", s1.Requests(), s2.Requests())
+	measure("Time", s1.Total, s2.Total)
+	measure("Req/s", s1.RPS, s2.RPS)
+	measure("Average", s1.Average, s2.Average)
+	measure("Fastest", s1.Fastest, s2.Fastest)
+	measure("Slowest", s1.Slowest, s2.Slowest)
+	for _, p := range render.BenchPercentiles {
+		measure(fmt.Sprintf("P%d", p), s1.Latencies[p], s2.Latencies[p])
+	}
+	count("2XX", s1.CodesIn(2), s2.CodesIn(2))
+	count("4XX/5XX", s1.CodesIn(4, 5), s2.CodesIn(4, 5))
+	count("Errors", s1.Errors, s2.Errors)
+
+	codes := make(map[int]struct{})
+	for c := range s1.StatusCodes {
+		codes[c] = struct{}{}
+	}
+	for c := range s2.StatusCodes {
+		codes[c] = struct{}{}
+	}
+	cc := make([]int, 0, len(codes))
+	for c := range codes {
+		cc = append(cc, c)
+	}
+	sort.Ints(cc)
+	if len(cc) > 0 {
+		b.WriteString("\n")
+		row("STATUS", "A", "B", "DELTA")
+		for _, c := range cc {
+			count(fmt.Sprintf("[%d]", c), s1.StatusCodes[c], s2.StatusCodes[c])
+		}
+	}
+
+	return b.String()
+}
+
+// benchDelta returns the relative change between two measures.
+func benchDelta(v1, v2 float64) string {
+	switch {
+	case v1 == v2:
+		return "0%"
+	case v1 == 0:
+		return render.NAValue
+	default:
+		return fmt.Sprintf("%+.1f%%", (v2-v1)*100/v1)
+	}
+}
+
 func benchDir(cfg *config.Config) string {
 	ct, err := cfg.K9s.ActiveContext()
 	if err != nil {
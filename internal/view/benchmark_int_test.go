@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	benchA = `### Step: home GET /
+Summary:
+  Total:	1.0000 secs
+  Slowest:	0.1000 secs
+  Fastest:	0.0100 secs
+  Average:	0.0300 secs
+  Requests/sec:	100.0000
+
+Latency distribution:
+  50% in 0.0200 secs
+  90% in 0.0600 secs
+  99% in 0.1000 secs
+
+Status code distribution:
+  [200]	100 responses
+`
+	benchB = `Summary:
+  Total:	0.5000 secs
+  Slowest:	0.0500 secs
+  Fastest:	0.0100 secs
+  Average:	0.0150 secs
+  Requests/sec:	200.0000
+
+Latency distribution:
+  50% in 0.0100 secs
+  90% in 0.0300 secs
+  99% in 0.0500 secs
+
+Status code distribution:
+  [200]	90 responses
+  [503]	10 responses
+`
+)
+
+func TestBenchDelta(t *testing.T) {
+	uu := map[string]struct {
+		v1, v2 float64
+		e      string
+	}{
+		"same":  {v1: 1, v2: 1, e: "0%"},
+		"zero":  {v2: 1, e: "n/a"},
+		"more":  {v1: 1, v2: 1.5, e: "+50.0%"},
+		"less":  {v1: 2, v2: 1, e: "-50.0%"},
+		"nulls": {e: "0%"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, benchDelta(u.v1, u.v2))
+		})
+	}
+}
+
+func TestBenchCompare(t *testing.T) {
+	s := benchCompare("a.txt", render.ParseBenchReport(benchA), "b.txt", render.ParseBenchReport(benchB))
+
+	assert.Contains(t, s, "A: a.txt\nB: b.txt\n")
+	assert.Contains(t, s, "Req/s              100.0000       200.0000    +100.0%\n")
+	assert.Contains(t, s, "P99                  0.1000         0.0500     -50.0%\n")
+	assert.Contains(t, s, "4XX/5XX                   0             10        n/a\n")
+	assert.Contains(t, s, "[503]                     0             10        n/a\n")
+}
+
+func TestBenchStepFields(t *testing.T) {
+	rows := benchReportRows(render.ParseBenchReport(benchA + benchA))
+
+	assert.Len(t, rows, 3)
+	assert.Equal(t,
+		[]string{"TOTAL", "200", "2.0000", "100.0000", "0.0300", "0.0100", "0.1000", "0.0200", "0.0600", "0.1000", "200", "0", "0", "200:200"},
+		benchStepFields(rows[2]),
+	)
+	assert.Equal(t, "home GET /", benchStepFields(rows[0])[0])
+	assert.Equal(t, "all", benchStepFields(render.ParseBenchReport(benchB).Steps[0])[0])
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -16,10 +17,12 @@ import (
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/config/data"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/slogs"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/ui/dialog"
@@ -39,6 +42,7 @@ type Browser struct {
 	accessor   dao.Accessor
 	contextFn  ContextFunc
 	cancelFn   context.CancelFunc
+	split      *splitPane
 	mx         sync.RWMutex
 	updating   bool
 	firstView  atomic.Int32
@@ -80,7 +84,7 @@ func (b *Browser) Init(ctx context.Context) error {
 	if r, ok := model.Registry[b.GVR()]; ok && r.Renderer != nil {
 		colorerFn = r.Renderer.ColorerFunc()
 	}
-	b.GetTable().SetColorerFn(colorerFn)
+	b.GetTable().SetColorerFn(model1.RuleColorer(colorerFn))
 
 	if e := b.Table.Init(ctx); e != nil {
 		return e
@@ -106,6 +110,10 @@ func (b *Browser) Init(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	if !dao.IsK9sMeta(b.meta) {
+		b.split = newSplitPane(b.app, b.GVR())
+		b.SetCursorFn(b.split.follow)
+	}
 
 	b.setNamespace(ns)
 	row, _ := b.GetSelection()
@@ -173,6 +181,7 @@ func (b *Browser) Start() {
 	b.GetModel().AddListener(b)
 	b.Table.Start()
 	b.CmdBuff().AddListener(b)
+	b.split.apply(b.splitSetting(), b.GetSelectedItem())
 	if err := b.GetModel().Watch(b.prepareContext()); err != nil {
 		go func() {
 			time.Sleep(500 * time.Millisecond)
@@ -194,6 +203,20 @@ func (b *Browser) Stop() {
 	b.GetModel().RemoveListener(b)
 	b.CmdBuff().RemoveListener(b)
 	b.Table.Stop()
+	b.split.stop()
+}
+
+// SetRect sets the browser area and lays out the split pane if any.
+func (b *Browser) SetRect(x, y, w, h int) {
+	b.Table.SetRect(b.split.layout(x, y, w, h))
+}
+
+// Draw draws the browser and its split pane if any.
+func (b *Browser) Draw(screen tcell.Screen) {
+	b.Table.Draw(screen)
+	if b.split.IsActive() {
+		b.split.Draw(screen)
+	}
 }
 
 func (b *Browser) SetFilter(s string) {
@@ -212,6 +235,9 @@ func (*Browser) BufferChanged(_, _ string) {}
 
 // BufferCompleted indicates input was accepted.
 func (b *Browser) BufferCompleted(text, _ string) {
+	if text != "" {
+		dao.SessRecorder().Record(render.SessionFilter, text, b.GVR().String(), nil)
+	}
 	if internal.IsLabelSelector(text) {
 		if sel, err := ui.TrimLabelSelector(text); err == nil {
 			b.GetModel().SetLabelSelector(sel)
@@ -318,6 +344,7 @@ func (b *Browser) TableNoData(mdata *model1.TableData) {
 		}
 		b.refreshActions()
 		b.UpdateUI(cdata, mdata)
+		b.split.follow(b.GetSelectedItem())
 	})
 }
 
@@ -348,6 +375,7 @@ func (b *Browser) TableDataChanged(mdata *model1.TableData) {
 		}
 		b.refreshActions()
 		b.UpdateUI(cdata, mdata)
+		b.split.follow(b.GetSelectedItem())
 	})
 }
 
@@ -376,6 +404,94 @@ func (b *Browser) viewCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (b *Browser) splitCmd(*tcell.EventKey) *tcell.EventKey {
+	if b.split == nil {
+		return nil
+	}
+	s := *b.split.setting
+	s.Pane = nextSplitPane(s.Pane, b.splitPanes())
+	b.saveSplit(&s)
+	if s.IsActive() {
+		b.app.Flash().Infof("Split pane %s", s.Pane)
+	} else {
+		b.app.Flash().Info("Split pane closed")
+	}
+
+	return nil
+}
+
+func (b *Browser) splitOrientationCmd(*tcell.EventKey) *tcell.EventKey {
+	if !b.split.IsActive() {
+		return nil
+	}
+	s := *b.split.setting
+	if s.IsVertical() {
+		s.Orientation = config.SplitHorizontal
+	} else {
+		s.Orientation = config.SplitVertical
+	}
+	b.saveSplit(&s)
+
+	return nil
+}
+
+func (b *Browser) pinCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	if err := b.app.togglePin(b.GVR(), path); err != nil {
+		b.app.Flash().Err(err)
+	}
+
+	return nil
+}
+
+// splitSetting returns the view split pane configuration. The split state
+// recorded in the context config takes precedence over the views defaults.
+func (b *Browser) splitSetting() *config.SplitSetting {
+	s := b.app.CustomView().SplitFor(b.GVR().String())
+	if ct, err := b.app.Config.CurrentContext(); err == nil {
+		if ss, ok := ct.SplitFor(b.GVR().String()); ok {
+			s.Pane, s.Orientation, s.Size = ss.Pane, ss.Orientation, ss.Size
+			s.Validate()
+		}
+	}
+	if !slices.Contains(b.splitPanes(), s.Pane) {
+		s.Pane = ""
+	}
+
+	return s
+}
+
+// splitPanes returns the panes available for this view.
+func (b *Browser) splitPanes() []string {
+	pp := config.SplitPanes()
+	if _, ok := b.accessor.(dao.Loggable); !ok {
+		pp = slices.DeleteFunc(pp, func(p string) bool {
+			return p == config.SplitPaneLogs
+		})
+	}
+
+	return pp
+}
+
+// saveSplit applies and persists the view split pane state in the context
+// config so user authored views are left untouched.
+func (b *Browser) saveSplit(s *config.SplitSetting) {
+	b.split.apply(s, b.GetSelectedItem())
+	ct, err := b.app.Config.CurrentContext()
+	if err != nil {
+		slog.Warn("Split pane save failed", slogs.Error, err)
+		return
+	}
+	ct.SetSplit(b.GVR().String(), data.Split{Pane: s.Pane, Orientation: s.Orientation, Size: s.Size})
+	if err := b.app.Config.Save(true); err != nil {
+		slog.Warn("Split pane save failed", slogs.Error, err)
+		b.app.Flash().Err(err)
+	}
+}
+
 func (b *Browser) helpCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if b.CmdBuff().InCmdMode() {
 		return nil
@@ -617,12 +733,18 @@ func (b *Browser) refreshActions() {
 	if !dao.IsK9sMeta(b.meta) {
 		aa.Add(ui.KeyY, ui.NewKeyAction(yamlAction, b.viewCmd, true))
 		aa.Add(ui.KeyD, ui.NewKeyAction("Describe", b.describeCmd, true))
+		aa.Add(ui.KeyShiftW, ui.NewKeyAction("Split Pane", b.splitCmd, false))
+		aa.Add(tcell.KeyCtrlY, ui.NewKeyAction("Split Orientation", b.splitOrientationCmd, false))
+		aa.Add(tcell.KeyCtrlN, ui.NewKeyAction("Pin", b.pinCmd, false))
 	}
 	for _, f := range b.bindKeysFn {
 		f(aa)
 	}
 	b.Actions().Merge(aa)
 
+	if err := keymapActions(b, b.Actions()); err != nil {
+		slog.Warn("Keymap remap failed", slogs.Error, err)
+	}
 	if err := pluginActions(b, b.Actions()); err != nil {
 		slog.Warn("Plugins load failed", slogs.Error, err)
 		b.app.Logo().Warn("Plugins load failed!")
@@ -682,7 +804,9 @@ func (b *Browser) simpleDelete(selections []string, msg string) {
 				b.app.Flash().Errf("Invalid nuker %T", b.accessor)
 				continue
 			}
-			if err := nuker.Delete(context.Background(), sel, nil, dao.DefaultGrace); err != nil {
+			err := nuker.Delete(context.Background(), sel, nil, dao.DefaultGrace)
+			dao.SessRecorder().Record(render.SessionDelete, "delete", sessionTarget(b.GVR(), sel), err)
+			if err != nil {
 				b.app.Flash().Errf("Delete failed with `%s", err)
 			} else {
 				b.app.factory.DeleteForwarder(sel)
@@ -706,7 +830,9 @@ func (b *Browser) resourceDelete(selections []string, msg string) {
 			if force {
 				grace = dao.ForceGrace
 			}
-			if err := b.GetModel().Delete(b.defaultContext(), sel, propagation, grace); err != nil {
+			err := b.GetModel().Delete(b.defaultContext(), sel, propagation, grace)
+			dao.SessRecorder().Record(render.SessionDelete, deleteCmdLine(propagation, force), sessionTarget(b.GVR(), sel), err)
+			if err != nil {
 				b.app.Flash().Errf("Delete failed with `%s", err)
 			} else {
 				b.app.factory.DeleteForwarder(sel)
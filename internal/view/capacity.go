@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+)
+
+// Capacity represents a node capacity view.
+type Capacity struct {
+	ResourceViewer
+}
+
+// NewCapacity returns a new node capacity view.
+func NewCapacity(gvr *client.GVR) ResourceViewer {
+	c := Capacity{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	c.GetTable().SetSortCol("%CPU/R", false)
+	c.GetTable().SetEnterFn(c.showPods)
+	c.AddBindKeysFn(c.bindKeys)
+
+	return &c
+}
+
+func (c *Capacity) bindKeys(aa *ui.KeyActions) {
+	aa.Bulk(ui.KeyMap{
+		ui.KeyShiftC: ui.NewKeyAction("Sort CPU/R", c.GetTable().SortColCmd("%CPU/R", false), false),
+		ui.KeyShiftM: ui.NewKeyAction("Sort MEM/R", c.GetTable().SortColCmd("%MEM/R", false), false),
+		ui.KeyShiftO: ui.NewKeyAction("Sort Pods", c.GetTable().SortColCmd("%PODS", false), false),
+		ui.KeyShiftX: ui.NewKeyAction("Sort OC/CPU", c.GetTable().SortColCmd("OC/CPU", false), false),
+		ui.KeyShiftZ: ui.NewKeyAction("Sort OC/MEM", c.GetTable().SortColCmd("OC/MEM", false), false),
+	})
+}
+
+func (*Capacity) showPods(app *App, _ ui.Tabular, _ *client.GVR, path string) {
+	v := NewCapacityPod(client.CpoGVR)
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, internal.KeyPath, path)
+	})
+	if err := app.inject(v, false); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// CapacityPod represents a view of pods packed on a given node.
+type CapacityPod struct {
+	ResourceViewer
+}
+
+// NewCapacityPod returns a new node pods capacity view.
+func NewCapacityPod(gvr *client.GVR) ResourceViewer {
+	c := CapacityPod{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	c.GetTable().SetSortCol("CPU/R", false)
+	c.GetTable().SetEnterFn(c.showPod)
+	c.AddBindKeysFn(c.bindKeys)
+
+	return &c
+}
+
+func (c *CapacityPod) bindKeys(aa *ui.KeyActions) {
+	aa.Bulk(ui.KeyMap{
+		ui.KeyShiftC: ui.NewKeyAction("Sort CPU/R", c.GetTable().SortColCmd("CPU/R", false), false),
+		ui.KeyShiftM: ui.NewKeyAction("Sort MEM/R", c.GetTable().SortColCmd("MEM/R", false), false),
+		ui.KeyShiftE: ui.NewKeyAction("Sort EPH/R", c.GetTable().SortColCmd("EPH/R", false), false),
+		ui.KeyShiftG: ui.NewKeyAction("Sort GPU/R", c.GetTable().SortColCmd("GPU/R", false), false),
+	})
+}
+
+func (*CapacityPod) showPod(app *App, _ ui.Tabular, _ *client.GVR, path string) {
+	app.gotoResource(client.PodGVR.String(), path, false, true)
+}
@@ -0,0 +1,184 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+const certTitle = "TLSCerts"
+
+// Cert represents a TLS certificates inspector view.
+type Cert struct {
+	ResourceViewer
+
+	expiring bool
+}
+
+// NewCert returns a new certificates view.
+func NewCert(gvr *client.GVR) ResourceViewer {
+	c := Cert{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	c.GetTable().SetSortCol("%LIFE", false)
+	c.GetTable().SetEnterFn(c.describeCert)
+	c.AddBindKeysFn(c.bindKeys)
+
+	return &c
+}
+
+// Init initializes the view.
+func (c *Cert) Init(ctx context.Context) error {
+	if err := c.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	c.GetTable().SetColorerFn(render.CertColorer(c.App().Config.K9s.Thresholds[config.CERT]))
+
+	return nil
+}
+
+// Name returns the component name.
+func (*Cert) Name() string { return certTitle }
+
+func (c *Cert) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlZ, tcell.KeyCtrlW)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyX:      ui.NewKeyAction("Toggle Expiring", c.toggleExpiringCmd, true),
+		ui.KeyShiftE: ui.NewKeyAction("Sort Expires", c.GetTable().SortColCmd("NOT AFTER", true), false),
+		ui.KeyShiftL: ui.NewKeyAction("Sort %Life", c.GetTable().SortColCmd("%LIFE", false), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Source", c.GetTable().SortColCmd("SOURCE", true), false),
+	})
+}
+
+func (c *Cert) toggleExpiringCmd(*tcell.EventKey) *tcell.EventKey {
+	c.expiring = !c.expiring
+	if !c.expiring {
+		c.SetContextFn(nil)
+		c.App().Flash().Info("Showing all certificates")
+		c.Refresh()
+		return nil
+	}
+
+	sev := c.App().Config.K9s.Thresholds[config.CERT]
+	if sev == nil {
+		sev = config.NewSeverity()
+	}
+	c.SetContextFn(func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, internal.KeyExpiring, sev)
+	})
+	c.App().Flash().Infof("Showing certificates past %d%% of their lifetime", sev.Warn)
+	c.Refresh()
+
+	return nil
+}
+
+func (c *Cert) describeCert(app *App, _ ui.Tabular, _ *client.GVR, path string) {
+	res, err := c.certFor(path)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+
+	details := NewDetails(app, "Certificate", path, contentTXT, true).Update(certDetails(res, time.Now()))
+	if err := app.inject(details, false); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func (c *Cert) certFor(path string) (*render.CertRes, error) {
+	acc, err := dao.AccessorFor(c.App().factory, client.CrtGVR)
+	if err != nil {
+		return nil, err
+	}
+	ctx := context.WithValue(context.Background(), internal.KeyFactory, c.App().factory)
+	oo, err := acc.List(ctx, c.GetTable().GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range oo {
+		if res, ok := o.(render.CertRes); ok && res.ID() == path {
+			return &res, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no certificate found for %q", path)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func certDetails(res *render.CertRes, now time.Time) string {
+	cert := res.Cert
+
+	var b strings.Builder
+	line := func(k, v string) {
+		fmt.Fprintf(&b, "%-20s %s\n", k+":", v)
+	}
+	line("Source", res.Source)
+	if res.Namespace != "" {
+		line("Namespace", res.Namespace)
+	}
+	line("Name", res.Name)
+	line("Field", res.Field)
+	b.WriteString("\n")
+	line("Subject", cert.Subject.String())
+	line("Issuer", cert.Issuer.String())
+	line("Serial", cert.SerialNumber.String())
+	line("SANs", strings.Join(render.CertSANs(cert), ", "))
+	line("Not Before", cert.NotBefore.UTC().Format(time.RFC3339))
+	line("Not After", cert.NotAfter.UTC().Format(time.RFC3339))
+	line("Expires", render.CertExpiry(cert, now))
+	line("Lifetime Used", fmt.Sprintf("%d%%", render.CertLifePerc(cert, now)))
+	line("CA", fmt.Sprintf("%t", cert.IsCA))
+	line("Key Algorithm", cert.PublicKeyAlgorithm.String())
+	line("Signature", cert.SignatureAlgorithm.String())
+	line("Key Usages", strings.Join(keyUsages(cert), ", "))
+	line("SHA256 Fingerprint", fmt.Sprintf("%X", sha256.Sum256(cert.Raw)))
+	line("Chain", res.Chain)
+
+	return b.String()
+}
+
+func keyUsages(c *x509.Certificate) []string {
+	kk := []struct {
+		u x509.KeyUsage
+		n string
+	}{
+		{x509.KeyUsageDigitalSignature, "DigitalSignature"},
+		{x509.KeyUsageKeyEncipherment, "KeyEncipherment"},
+		{x509.KeyUsageCertSign, "CertSign"},
+		{x509.KeyUsageCRLSign, "CRLSign"},
+	}
+	uu := make([]string, 0, len(kk)+len(c.ExtKeyUsage))
+	for _, k := range kk {
+		if c.KeyUsage&k.u != 0 {
+			uu = append(uu, k.n)
+		}
+	}
+	for _, u := range c.ExtKeyUsage {
+		switch u {
+		case x509.ExtKeyUsageServerAuth:
+			uu = append(uu, "ServerAuth")
+		case x509.ExtKeyUsageClientAuth:
+			uu = append(uu, "ClientAuth")
+		default:
+			uu = append(uu, fmt.Sprintf("ExtKeyUsage(%d)", u))
+		}
+	}
+
+	return uu
+}
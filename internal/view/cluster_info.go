@@ -109,6 +109,19 @@ func (*ClusterInfo) warnCell(s string, w bool) string {
 	return s
 }
 
+// userCell flags impersonated sessions.
+func (c *ClusterInfo) userCell(m *model.ClusterMeta) string {
+	if m.Impersonate == "" {
+		return m.User
+	}
+	as := "as " + m.Impersonate
+	if !c.app.Config.K9s.UI.NoIcons {
+		as = impersonateIC + " " + as
+	}
+
+	return c.warnCell(as, true)
+}
+
 // ClusterInfoChanged notifies the cluster meta was changed.
 func (c *ClusterInfo) ClusterInfoChanged(prev, curr *model.ClusterMeta) {
 	c.app.QueueUpdateDraw(func() {
@@ -121,7 +134,7 @@ func (c *ClusterInfo) ClusterInfoChanged(prev, curr *model.ClusterMeta) {
 		}
 		row := c.setCell(0, context)
 		row = c.setCell(row, curr.Cluster)
-		row = c.setCell(row, curr.User)
+		row = c.setCell(row, c.userCell(curr))
 		if curr.K9sLatest != "" {
 			row = c.setCell(row, fmt.Sprintf("%s ⚡️[cadetblue::b]%s", curr.K9sVer, curr.K9sLatest))
 		} else {
@@ -21,8 +21,10 @@ type ConfigMap struct {
 // NewConfigMap returns a new viewer.
 func NewConfigMap(gvr *client.GVR) ResourceViewer {
 	s := ConfigMap{
-		ResourceViewer: NewOwnerExtender(
-			NewBrowser(gvr),
+		ResourceViewer: NewDataKeyExtender(
+			NewOwnerExtender(
+				NewBrowser(gvr),
+			),
 		),
 	}
 	s.AddBindKeysFn(s.bindKeys)
@@ -64,7 +64,14 @@ func newArgs(p *Interpreter, aa []string) args {
 					arguments[topicKey] = a
 				}
 
-			case p.IsXrayCmd():
+			case p.IsTabCmd():
+				if _, ok := arguments[topicKey]; ok {
+					arguments[nsKey] = a
+				} else {
+					arguments[topicKey] = a
+				}
+
+			case p.IsXrayCmd(), p.IsExplainCmd():
 				if _, ok := arguments[topicKey]; ok {
 					arguments[nsKey] = strings.ToLower(a)
 				} else {
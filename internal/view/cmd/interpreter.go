@@ -124,6 +124,21 @@ func (c *Interpreter) IsXrayCmd() bool {
 	return xrayCmd.Has(c.cmd)
 }
 
+// IsExplainCmd returns true if explain cmd is detected.
+func (c *Interpreter) IsExplainCmd() bool {
+	return explainCmd.Has(c.cmd)
+}
+
+// IsTabCmd returns true if tab cmd is detected.
+func (c *Interpreter) IsTabCmd() bool {
+	return tabCmd.Has(c.cmd)
+}
+
+// IsImpersonateCmd returns true if impersonate cmd is detected.
+func (c *Interpreter) IsImpersonateCmd() bool {
+	return impersonateCmd.Has(c.cmd)
+}
+
 // IsContextCmd returns true if context cmd is detected.
 func (c *Interpreter) IsContextCmd() bool {
 	return contextCmd.Has(c.cmd)
@@ -192,6 +207,47 @@ func (c *Interpreter) RBACArgs() (subject, verb string, ok bool) {
 	return
 }
 
+// ImpersonateArgs returns the user and groups to impersonate.
+// Subjects are specified as u:user, s:namespace/serviceaccount or g:group1,group2.
+// A blank user means revert to the initial identity.
+func (c *Interpreter) ImpersonateArgs() (user string, groups []string, ok bool) {
+	if !c.IsImpersonateCmd() {
+		return
+	}
+	for _, f := range strings.Fields(c.Args()) {
+		kind, v, found := strings.Cut(f, ":")
+		if !found || v == "" {
+			if f == "-" {
+				continue
+			}
+			return "", nil, false
+		}
+		switch kind {
+		case "u":
+			user = v
+		case "s":
+			ns, n, found := strings.Cut(v, "/")
+			if !found || ns == "" || n == "" {
+				return "", nil, false
+			}
+			user = "system:serviceaccount:" + ns + ":" + n
+		case "g":
+			for g := range strings.SplitSeq(v, ",") {
+				if g != "" {
+					groups = append(groups, g)
+				}
+			}
+		default:
+			return "", nil, false
+		}
+	}
+	if user == "" && len(groups) > 0 {
+		return "", nil, false
+	}
+
+	return user, groups, true
+}
+
 // XrayArgs return the gvr and ns if any.
 func (c *Interpreter) XrayArgs() (cmd, namespace string, ok bool) {
 	if !c.IsXrayCmd() {
@@ -215,6 +271,25 @@ func (c *Interpreter) XrayArgs() (cmd, namespace string, ok bool) {
 	return
 }
 
+// ExplainArgs returns the resource and schema version if any.
+func (c *Interpreter) ExplainArgs() (res, version string, ok bool) {
+	if !c.IsExplainCmd() {
+		return
+	}
+	res, ok = c.args[topicKey]
+
+	return res, c.args[nsKey], ok && res != ""
+}
+
+// TabArgs returns the tab topic and its argument if any.
+func (c *Interpreter) TabArgs() (topic, arg string, ok bool) {
+	if !c.IsTabCmd() {
+		return
+	}
+
+	return c.args[topicKey], c.args[nsKey], true
+}
+
 // FilterArg returns the current filter if any.
 func (c *Interpreter) FilterArg() (string, bool) {
 	f, ok := c.args[filterKey]
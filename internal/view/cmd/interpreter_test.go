@@ -307,6 +307,60 @@ func TestXRayCmd(t *testing.T) {
 	}
 }
 
+func TestTabCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd             string
+		ok              bool
+		topic, arg, ctx string
+	}{
+		"empty": {},
+
+		"new": {
+			cmd: "tab",
+			ok:  true,
+		},
+
+		"alias": {
+			cmd:   "tabs 2",
+			ok:    true,
+			topic: "2",
+		},
+
+		"rename": {
+			cmd:   "tab rename Incident",
+			ok:    true,
+			topic: "rename",
+			arg:   "Incident",
+		},
+
+		"context": {
+			cmd:   "tab Logs @prod",
+			ok:    true,
+			topic: "Logs",
+			ctx:   "prod",
+		},
+
+		"toast": {
+			cmd: "tabz 1",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := cmd.NewInterpreter(u.cmd)
+			topic, arg, ok := p.TabArgs()
+			assert.Equal(t, u.ok, ok)
+			if u.ok {
+				assert.Equal(t, u.topic, topic)
+				assert.Equal(t, u.arg, arg)
+				ctx, _ := p.HasContext()
+				assert.Equal(t, u.ctx, ctx)
+			}
+		})
+	}
+}
+
 func TestDirCmd(t *testing.T) {
 	uu := map[string]struct {
 		cmd string
@@ -409,6 +463,64 @@ func TestRBACCmd(t *testing.T) {
 	}
 }
 
+func TestImpersonateCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd    string
+		ok     bool
+		user   string
+		groups []string
+	}{
+		"empty": {},
+		"toast": {
+			cmd: "ask u:bozo",
+		},
+		"toast-kind": {
+			cmd: "as x:bozo",
+		},
+		"toast-groups-only": {
+			cmd: "as g:devs",
+		},
+		"toast-sa": {
+			cmd: "as s:bozo",
+		},
+		"revert": {
+			cmd: "as",
+			ok:  true,
+		},
+		"revert-dash": {
+			cmd: "as -",
+			ok:  true,
+		},
+		"user": {
+			cmd:  "as u:bozo",
+			ok:   true,
+			user: "bozo",
+		},
+		"user-groups": {
+			cmd:    "impersonate u:bozo g:devs,ops g:system:masters",
+			ok:     true,
+			user:   "bozo",
+			groups: []string{"devs", "ops", "system:masters"},
+		},
+		"sa": {
+			cmd:  "as s:kube-system/default",
+			ok:   true,
+			user: "system:serviceaccount:kube-system:default",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := cmd.NewInterpreter(u.cmd)
+			user, groups, ok := p.ImpersonateArgs()
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.user, user)
+			assert.Equal(t, u.groups, groups)
+		})
+	}
+}
+
 func TestContextCmd(t *testing.T) {
 	uu := map[string]struct {
 		cmd string
@@ -568,6 +680,44 @@ func TestCowCmd(t *testing.T) {
 	}
 }
 
+func TestExplainCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd          string
+		ok           bool
+		res, version string
+	}{
+		"empty": {},
+		"no-res": {
+			cmd: "explain",
+		},
+		"plain": {
+			cmd: "explain dp",
+			ok:  true,
+			res: "dp",
+		},
+		"version": {
+			cmd:     "schema blees.fred.io v1beta1",
+			ok:      true,
+			res:     "blees.fred.io",
+			version: "v1beta1",
+		},
+		"toast": {
+			cmd: "explained dp",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := cmd.NewInterpreter(u.cmd)
+			res, version, ok := p.ExplainArgs()
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.res, res)
+			assert.Equal(t, u.version, version)
+		})
+	}
+}
+
 func TestArgs(t *testing.T) {
 	uu := map[string]struct {
 		cmd string
@@ -61,4 +61,16 @@ var (
 		"xr",
 		"xray",
 	)
+	tabCmd = sets.New(
+		"tab",
+		"tabs",
+	)
+	explainCmd = sets.New(
+		"explain",
+		"schema",
+	)
+	impersonateCmd = sets.New(
+		"as",
+		"impersonate",
+	)
 )
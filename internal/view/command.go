@@ -15,6 +15,7 @@ import (
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/slogs"
 	"github.com/derailed/k9s/internal/view/cmd"
 	"k8s.io/apimachinery/pkg/labels"
@@ -84,6 +85,7 @@ var allowedCmds = sets.New[*client.GVR](
 	client.DsGVR,
 	client.StsGVR,
 	client.RsGVR,
+	client.HmGVR,
 )
 
 func allowedXRay(gvr *client.GVR) bool {
@@ -157,8 +159,26 @@ func (c *Command) xrayCmd(p *cmd.Interpreter, pushCmd bool) error {
 	return c.exec(p, client.NewGVR("xrays"), NewXray(gvr), true, pushCmd)
 }
 
+func (c *Command) explainCmd(p *cmd.Interpreter) error {
+	res, version, ok := p.ExplainArgs()
+	if !ok {
+		return errors.New("invalid command. use `explain xxx [version]`")
+	}
+	gvr, _, ok := c.alias.AsGVR(res)
+	if !ok {
+		return fmt.Errorf("invalid resource name: %q", res)
+	}
+
+	return c.app.inject(NewExplain(c.app, gvr, version), false)
+}
+
 // Run execs the command by showing associated display.
-func (c *Command) run(p *cmd.Interpreter, fqn string, clearStack, pushCmd bool) error {
+func (c *Command) run(p *cmd.Interpreter, fqn string, clearStack, pushCmd bool) (err error) {
+	line := p.GetLine()
+	defer func() {
+		dao.SessRecorder().Record(render.SessionCommand, line, fqn, err)
+	}()
+
 	if c.specialCmd(p, pushCmd) {
 		return nil
 	}
@@ -267,6 +287,18 @@ func (c *Command) specialCmd(p *cmd.Interpreter, pushCmd bool) bool {
 		if err := c.xrayCmd(p, pushCmd); err != nil {
 			c.app.Flash().Err(err)
 		}
+	case p.IsExplainCmd():
+		if err := c.explainCmd(p); err != nil {
+			c.app.Flash().Err(err)
+		}
+	case p.IsTabCmd():
+		if err := c.app.tabCmd(p); err != nil {
+			c.app.Flash().Err(err)
+		}
+	case p.IsImpersonateCmd():
+		if err := c.app.impersonateCmd(p); err != nil {
+			c.app.Flash().Err(err)
+		}
 	case p.IsRBACCmd():
 		if cat, sub, ok := p.RBACArgs(); !ok {
 			c.app.Flash().Errf("Invalid command. Use `can [u|g|s]:xxx`")
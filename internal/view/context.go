@@ -39,10 +39,33 @@ func NewContext(gvr *client.GVR) ResourceViewer {
 }
 
 func (c *Context) bindKeys(aa *ui.KeyActions) {
+	if !c.App().Config.IsReadOnly() {
+		c.bindDangerousKeys(aa)
+	}
 	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlSpace, ui.KeySpace)
 	aa.Add(ui.KeyR, ui.NewKeyAction("Rename", c.renameCmd, true))
 }
 
+func (c *Context) bindDangerousKeys(aa *ui.KeyActions) {
+	aa.Bulk(ui.KeyMap{
+		ui.KeyE: ui.NewKeyActionWithOpts("Edit", c.editCmd,
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+			}),
+		ui.KeyI: ui.NewKeyActionWithOpts("Import", c.importCmd,
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+			}),
+		ui.KeyP: ui.NewKeyActionWithOpts("Prune", c.pruneCmd,
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+			}),
+	})
+}
+
 func (c *Context) renameCmd(evt *tcell.EventKey) *tcell.EventKey {
 	contextName := c.GetTable().GetSelectedItem()
 	if contextName == "" {
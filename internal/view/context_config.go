@@ -0,0 +1,186 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+const (
+	editContextPage   = "edit-context"
+	importContextPage = "import-context"
+)
+
+func (c *Context) editCmd(evt *tcell.EventKey) *tcell.EventKey {
+	n := c.GetTable().GetSelectedItem()
+	if n == "" {
+		return evt
+	}
+	cfg, err := c.App().factory.Client().Config().RawConfig()
+	if err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+	ctx, ok := cfg.Contexts[n]
+	if !ok {
+		c.App().Flash().Errf("context %q does not exist", n)
+		return nil
+	}
+
+	ns, cluster, user := ctx.Namespace, ctx.Cluster, ctx.AuthInfo
+	clusters, users := slices.Sorted(maps.Keys(cfg.Clusters)), slices.Sorted(maps.Keys(cfg.AuthInfos))
+	app, styles := c.App(), c.App().Styles.Dialog()
+	f := newContextForm(&styles)
+	f.AddInputField("Namespace:", ns, 0, nil, func(s string) {
+		ns = strings.TrimSpace(s)
+	})
+	addDropDown(f, &styles, "Cluster:", clusters, cluster, func(s string) {
+		cluster = s
+	})
+	addDropDown(f, &styles, "User:", users, user, func(s string) {
+		user = s
+	})
+	f.AddButton("OK", func() {
+		app.Content.RemovePage(editContextPage)
+		if err := app.factory.Client().Config().UpdateContext(n, ns, cluster, user); err != nil {
+			app.Flash().Err(err)
+			return
+		}
+		app.Flash().Infof("Context %q updated", n)
+		c.Refresh()
+	}).
+		AddButton("Cancel", func() {
+			app.Content.RemovePage(editContextPage)
+		})
+	showContextForm(app, f, editContextPage, "<Edit Context>", fmt.Sprintf("Edit context %q", n))
+
+	return nil
+}
+
+func (c *Context) importCmd(*tcell.EventKey) *tcell.EventKey {
+	var (
+		path      string
+		overwrite bool
+	)
+	if home, err := os.UserHomeDir(); err == nil {
+		path = filepath.Join(home, ".kube") + string(filepath.Separator)
+	}
+	app, styles := c.App(), c.App().Styles.Dialog()
+	f := newContextForm(&styles)
+	f.AddInputField("Kubeconfig:", path, 0, nil, func(s string) {
+		path = strings.TrimSpace(s)
+	})
+	f.AddCheckbox("Overwrite:", overwrite, func(_ string, checked bool) {
+		overwrite = checked
+	})
+	f.AddButton("OK", func() {
+		app.Content.RemovePage(importContextPage)
+		c.importConfig(path, overwrite)
+	}).
+		AddButton("Cancel", func() {
+			app.Content.RemovePage(importContextPage)
+		})
+	showContextForm(app, f, importContextPage, "<Import Kubeconfig>", "Merge contexts from kubeconfig file")
+
+	return nil
+}
+
+func (c *Context) importConfig(path string, overwrite bool) {
+	res, err := c.App().factory.Client().Config().ImportConfig(path, overwrite)
+	if err != nil {
+		c.App().Flash().Err(err)
+		return
+	}
+	msg := fmt.Sprintf("Imported %d context(s)", len(res.Contexts))
+	if len(res.Skipped) > 0 {
+		msg += fmt.Sprintf(", skipped existing %s", strings.Join(res.Skipped, ","))
+	}
+	if len(res.Renamed) > 0 {
+		rr := make([]string, 0, len(res.Renamed))
+		for _, k := range slices.Sorted(maps.Keys(res.Renamed)) {
+			rr = append(rr, k+"->"+res.Renamed[k])
+		}
+		msg += fmt.Sprintf(", renamed %s", strings.Join(rr, ","))
+	}
+	c.App().Flash().Info(msg)
+	c.Refresh()
+}
+
+func (c *Context) pruneCmd(*tcell.EventKey) *tcell.EventKey {
+	cfg := c.App().factory.Client().Config()
+	clusters, users, err := cfg.OrphanedEntries()
+	if err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+	if len(clusters) == 0 && len(users) == 0 {
+		c.App().Flash().Info("No orphaned clusters or users found")
+		return nil
+	}
+
+	msg := fmt.Sprintf("Prune orphaned clusters [%s] and users [%s]?", strings.Join(clusters, ","), strings.Join(users, ","))
+	d := c.App().Styles.Dialog()
+	dialog.ShowConfirm(&d, c.App().Content.Pages, "Confirm Prune", msg, func() {
+		cc, uu, err := cfg.PruneConfig()
+		if err != nil {
+			c.App().Flash().Err(err)
+			return
+		}
+		c.App().Flash().Infof("Pruned %d cluster(s) and %d user(s)", len(cc), len(uu))
+		c.Refresh()
+	}, func() {})
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func newContextForm(styles *config.Dialog) *tview.Form {
+	return tview.NewForm().
+		SetItemPadding(0).
+		SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.ButtonBgColor.Color()).
+		SetButtonTextColor(styles.ButtonFgColor.Color()).
+		SetLabelColor(styles.LabelFgColor.Color()).
+		SetFieldTextColor(styles.FieldFgColor.Color())
+}
+
+func addDropDown(f *tview.Form, styles *config.Dialog, label string, opts []string, sel string, changed func(string)) {
+	f.AddDropDown(label, opts, max(slices.Index(opts, sel), 0), func(s string, _ int) {
+		changed(s)
+	})
+	dd := f.GetFormItemByLabel(label).(*tview.DropDown)
+	dd.SetListStyles(
+		styles.FgColor.Color(), styles.BgColor.Color(),
+		styles.ButtonFocusFgColor.Color(), styles.ButtonFocusBgColor.Color(),
+	)
+}
+
+func showContextForm(app *App, f *tview.Form, page, title, msg string) {
+	styles := app.Styles.Dialog()
+	for i := range f.GetButtonCount() {
+		f.GetButton(i).
+			SetBackgroundColorActivated(styles.ButtonFocusBgColor.Color()).
+			SetLabelColorActivated(styles.ButtonFocusFgColor.Color())
+	}
+
+	m := tview.NewModalForm(title, f)
+	m.SetText(msg)
+	m.SetDoneFunc(func(int, string) {
+		app.Content.RemovePage(page)
+	})
+	app.Content.AddPage(page, m, false, false)
+	app.Content.ShowPage(page)
+}
@@ -6,6 +6,7 @@ package view
 import (
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
 )
 
 // CRD represents a crd viewer.
@@ -29,9 +30,28 @@ func (s *CRD) bindKeys(aa *ui.KeyActions) {
 		ui.KeyShiftV: ui.NewKeyAction("Sort Versions", s.GetTable().SortColCmd("VERSIONS", false), true),
 		ui.KeyShiftR: ui.NewKeyAction("Sort Group", s.GetTable().SortColCmd("GROUP", true), true),
 		ui.KeyShiftK: ui.NewKeyAction("Sort Kind", s.GetTable().SortColCmd("KIND", true), true),
+		ui.KeyX:      ui.NewKeyAction("Explain", s.explainCmd, true),
 	})
 }
 
+func (s *CRD) explainCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	_, crd := client.Namespaced(path)
+	gvr, _, ok := s.App().command.alias.AsGVR(crd)
+	if !ok {
+		s.App().Flash().Errf("No resource found for %q", crd)
+		return nil
+	}
+	if err := s.App().inject(NewExplain(s.App(), gvr, ""), false); err != nil {
+		s.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
 func (*CRD) showCRD(app *App, _ ui.Tabular, _ *client.GVR, path string) {
 	_, crd := client.Namespaced(path)
 	app.gotoResource(crd, "", false, true)
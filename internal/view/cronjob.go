@@ -37,7 +37,7 @@ type CronJob struct {
 // NewCronJob returns a new viewer.
 func NewCronJob(gvr *client.GVR) ResourceViewer {
 	c := CronJob{ResourceViewer: NewVulnerabilityExtender(
-		NewOwnerExtender(NewBrowser(gvr)),
+		NewOwnerExtender(NewTimelineExtender(NewBrowser(gvr))),
 	)}
 	c.AddBindKeysFn(c.bindKeys)
 	c.GetTable().SetEnterFn(c.showJobs)
@@ -0,0 +1,408 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/slogs"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+const (
+	dataKeysTitle = "DataKeys"
+	dataKeyPage   = "datakey"
+	dataKeyField  = "Key:"
+	dataFileField = "File:"
+)
+
+// DataKeys represents a secret or configmap key level editor.
+type DataKeys struct {
+	ResourceViewer
+
+	owner *client.GVR
+	path  string
+}
+
+// NewDataKeys returns a new data keys view.
+func NewDataKeys(gvr *client.GVR) ResourceViewer {
+	d := DataKeys{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	d.GetTable().SetSortCol("KEY", true)
+	d.GetTable().SetEnterFn(d.viewKey)
+	d.AddBindKeysFn(d.bindKeys)
+
+	return &d
+}
+
+func newDataKeysFor(gvr *client.GVR, path string) ResourceViewer {
+	v := NewDataKeys(client.DkGVR)
+	d := v.(*DataKeys)
+	d.owner, d.path = gvr, path
+	d.SetContextFn(func(ctx context.Context) context.Context {
+		ctx = context.WithValue(ctx, internal.KeyPath, path)
+		return context.WithValue(ctx, internal.KeyGVR, gvr)
+	})
+
+	return d
+}
+
+// Name returns the component name.
+func (*DataKeys) Name() string { return dataKeysTitle }
+
+func (d *DataKeys) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlZ, tcell.KeyCtrlW)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyC:      ui.NewKeyAction("Copy Value", d.cpValueCmd, true),
+		ui.KeyShiftK: ui.NewKeyAction("Sort Key", d.GetTable().SortColCmd("KEY", true), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Size", d.GetTable().SortColCmd("SIZE", false), false),
+	})
+	if d.App() == nil || d.App().Config.IsReadOnly() {
+		return
+	}
+	aa.Bulk(ui.KeyMap{
+		ui.KeyE:        ui.NewKeyActionWithOpts("Edit", d.editCmd, ui.ActionOpts{Visible: true, Dangerous: true}),
+		ui.KeyA:        ui.NewKeyActionWithOpts("Add", d.addCmd, ui.ActionOpts{Visible: true, Dangerous: true}),
+		ui.KeyR:        ui.NewKeyActionWithOpts("Rename", d.renameCmd, ui.ActionOpts{Visible: true, Dangerous: true}),
+		ui.KeyI:        ui.NewKeyActionWithOpts("Import", d.importCmd, ui.ActionOpts{Visible: true, Dangerous: true}),
+		tcell.KeyCtrlD: ui.NewKeyActionWithOpts("Delete", d.deleteCmd, ui.ActionOpts{Visible: true, Dangerous: true}),
+	})
+}
+
+func (d *DataKeys) accessor() (*dao.DataKey, error) {
+	acc, err := dao.AccessorFor(d.App().factory, client.DkGVR)
+	if err != nil {
+		return nil, err
+	}
+	dk, ok := acc.(*dao.DataKey)
+	if !ok {
+		return nil, fmt.Errorf("expecting a datakey accessor but got %T", acc)
+	}
+
+	return dk, nil
+}
+
+func (d *DataKeys) load() (*dao.DataKey, *dao.DataKeys, error) {
+	if d.owner == nil {
+		return nil, nil, fmt.Errorf("no secret or configmap specified")
+	}
+	dk, err := d.accessor()
+	if err != nil {
+		return nil, nil, err
+	}
+	kk, err := dk.Load(context.Background(), d.owner, d.path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return dk, kk, nil
+}
+
+func (d *DataKeys) viewKey(app *App, _ ui.Tabular, _ *client.GVR, key string) {
+	_, kk, err := d.load()
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	val, ok := kk.Data[key]
+	if !ok {
+		app.Flash().Errf("no key %q found in %s", key, d.path)
+		return
+	}
+	txt := string(val)
+	if dao.IsBinary(val) {
+		txt = hex.Dump(val)
+	}
+
+	details := NewDetails(app, "DataKey", d.path+"::"+key, contentTXT, true).Update(txt)
+	if err := app.inject(details, false); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func (d *DataKeys) cpValueCmd(evt *tcell.EventKey) *tcell.EventKey {
+	key := d.GetTable().GetSelectedItem()
+	if key == "" {
+		return evt
+	}
+	_, kk, err := d.load()
+	if err != nil {
+		d.App().Flash().Err(err)
+		return nil
+	}
+	val := kk.Data[key]
+	if dao.IsBinary(val) {
+		d.App().Flash().Warnf("Key %q holds binary data. Copy skipped!", key)
+		return nil
+	}
+	if err := clipboardWrite(string(val)); err != nil {
+		d.App().Flash().Err(err)
+		return nil
+	}
+	d.App().Flash().Infof("Value of key %q copied to clipboard...", key)
+
+	return nil
+}
+
+func (d *DataKeys) editCmd(evt *tcell.EventKey) *tcell.EventKey {
+	key := d.GetTable().GetSelectedItem()
+	if key == "" {
+		return evt
+	}
+	d.editKey(key, false)
+
+	return nil
+}
+
+func (d *DataKeys) addCmd(*tcell.EventKey) *tcell.EventKey {
+	d.showKeyForm("Add", "Add a new key to "+d.path, []string{dataKeyField}, []string{""}, func(vv []string) error {
+		d.editKey(vv[0], true)
+		return nil
+	})
+
+	return nil
+}
+
+func (d *DataKeys) editKey(key string, isNew bool) {
+	dk, kk, err := d.load()
+	if err != nil {
+		d.App().Flash().Err(err)
+		return
+	}
+	if err := dao.ValidateDataKey(key); err != nil {
+		d.App().Flash().Err(err)
+		return
+	}
+	val, ok := kk.Data[key]
+	if isNew && ok {
+		d.App().Flash().Errf("Key %q already exists in %s", key, d.path)
+		return
+	}
+	if dao.IsBinary(val) {
+		d.App().Flash().Warnf("Key %q holds binary data. Use import instead!", key)
+		return
+	}
+
+	fpath, err := editTempFile(d.App(), key, val)
+	if err != nil {
+		d.App().Flash().Err(err)
+		return
+	}
+	defer removeFile(fpath)
+	updated, err := os.ReadFile(fpath)
+	if err != nil {
+		d.App().Flash().Err(err)
+		return
+	}
+	if !isNew && bytes.Equal(updated, val) {
+		d.App().Flash().Info("No changes detected. Skipping update")
+		return
+	}
+	if err := dk.SetKey(context.Background(), d.owner, d.path, kk.Version, key, updated); err != nil {
+		d.App().Flash().Err(err)
+		return
+	}
+	d.App().Flash().Infof("Key %q updated in %s", key, d.path)
+	d.Refresh()
+}
+
+func (d *DataKeys) renameCmd(evt *tcell.EventKey) *tcell.EventKey {
+	key := d.GetTable().GetSelectedItem()
+	if key == "" {
+		return evt
+	}
+	dk, kk, err := d.load()
+	if err != nil {
+		d.App().Flash().Err(err)
+		return nil
+	}
+	d.showKeyForm("Rename", fmt.Sprintf("Rename key %q?", key), []string{dataKeyField}, []string{key}, func(vv []string) error {
+		if vv[0] == key {
+			return nil
+		}
+		if err := dk.RenameKey(context.Background(), d.owner, d.path, kk.Version, key, vv[0]); err != nil {
+			return err
+		}
+		d.App().Flash().Infof("Key %q renamed to %q", key, vv[0])
+		d.Refresh()
+		return nil
+	})
+
+	return nil
+}
+
+func (d *DataKeys) importCmd(*tcell.EventKey) *tcell.EventKey {
+	dk, kk, err := d.load()
+	if err != nil {
+		d.App().Flash().Err(err)
+		return nil
+	}
+	d.showKeyForm("Import", "Import a key from a local file", []string{dataFileField, dataKeyField}, []string{"", ""}, func(vv []string) error {
+		file, key := vv[0], vv[1]
+		if file == "" {
+			return fmt.Errorf("a file path must be specified")
+		}
+		if key == "" {
+			key = filepath.Base(file)
+		}
+		bb, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		if err := dk.SetKey(context.Background(), d.owner, d.path, kk.Version, key, bb); err != nil {
+			return err
+		}
+		d.App().Flash().Infof("Key %q imported from %s", key, file)
+		d.Refresh()
+		return nil
+	})
+
+	return nil
+}
+
+func (d *DataKeys) deleteCmd(evt *tcell.EventKey) *tcell.EventKey {
+	key := d.GetTable().GetSelectedItem()
+	if key == "" {
+		return evt
+	}
+
+	dk, kk, err := d.load()
+	if err != nil {
+		d.App().Flash().Err(err)
+		return nil
+	}
+	msg := fmt.Sprintf("Delete key %q from %s?", key, d.path)
+	styles := d.App().Styles.Dialog()
+	dialog.ShowConfirm(&styles, d.App().Content.Pages, "Confirm Delete", msg, func() {
+		if err := dk.DeleteKey(context.Background(), d.owner, d.path, kk.Version, key); err != nil {
+			d.App().Flash().Err(err)
+			return
+		}
+		d.App().Flash().Infof("Key %q deleted from %s", key, d.path)
+		d.Refresh()
+	}, func() {})
+
+	return nil
+}
+
+func (d *DataKeys) showKeyForm(title, msg string, labels, values []string, ok func([]string) error) {
+	app := d.App()
+	styles := app.Styles.Dialog()
+
+	f := tview.NewForm().
+		SetItemPadding(0).
+		SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.ButtonBgColor.Color()).
+		SetButtonTextColor(styles.ButtonFgColor.Color()).
+		SetLabelColor(styles.LabelFgColor.Color()).
+		SetFieldTextColor(styles.FieldFgColor.Color())
+	for i, l := range labels {
+		f.AddInputField(l, values[i], 0, nil, nil)
+	}
+	f.AddButton("OK", func() {
+		vv := make([]string, 0, len(labels))
+		for _, l := range labels {
+			vv = append(vv, strings.TrimSpace(f.GetFormItemByLabel(l).(*tview.InputField).GetText()))
+		}
+		app.Content.Pages.RemovePage(dataKeyPage)
+		if err := ok(vv); err != nil {
+			app.Flash().Err(err)
+		}
+	}).
+		AddButton("Cancel", func() {
+			app.Content.RemovePage(dataKeyPage)
+		})
+
+	m := tview.NewModalForm("<"+title+">", f)
+	m.SetText(msg)
+	m.SetDoneFunc(func(int, string) {
+		app.Content.RemovePage(dataKeyPage)
+	})
+	app.Content.AddPage(dataKeyPage, m, false, false)
+	app.Content.ShowPage(dataKeyPage)
+
+	for i := range f.GetButtonCount() {
+		f.GetButton(i).
+			SetBackgroundColorActivated(styles.ButtonFocusBgColor.Color()).
+			SetLabelColorActivated(styles.ButtonFocusFgColor.Color())
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func editTempFile(app *App, name string, val []byte) (string, error) {
+	f, err := os.CreateTemp("", "k9s-"+filepath.Base(name)+"-*")
+	if err != nil {
+		return "", err
+	}
+	fpath := f.Name()
+	if _, err := f.Write(val); err != nil {
+		_ = f.Close()
+		removeFile(fpath)
+		return "", err
+	}
+	if err := f.Close(); err != nil {
+		removeFile(fpath)
+		return "", err
+	}
+	if !edit(app, &shellOpts{clear: true, args: []string{fpath}}) {
+		removeFile(fpath)
+		return "", fmt.Errorf("edit of key %q failed", name)
+	}
+
+	return fpath, nil
+}
+
+func removeFile(fpath string) {
+	if err := os.Remove(fpath); err != nil {
+		slog.Warn("Unable to remove temp file",
+			slogs.Path, fpath,
+			slogs.Error, err,
+		)
+	}
+}
+
+// DataKeyExtender adds key level editing to secrets and configmaps.
+type DataKeyExtender struct {
+	ResourceViewer
+}
+
+// NewDataKeyExtender returns a new extender.
+func NewDataKeyExtender(r ResourceViewer) ResourceViewer {
+	v := &DataKeyExtender{ResourceViewer: r}
+	v.AddBindKeysFn(v.bindKeys)
+
+	return v
+}
+
+func (v *DataKeyExtender) bindKeys(aa *ui.KeyActions) {
+	aa.Add(ui.KeyShiftK, ui.NewKeyAction("Keys", v.keysCmd, true))
+}
+
+func (v *DataKeyExtender) keysCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := v.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	if err := v.App().inject(newDataKeysFor(v.GVR(), path), false); err != nil {
+		v.App().Flash().Err(err)
+	}
+
+	return nil
+}
@@ -23,6 +23,7 @@ const (
 	detailsTitleFmt = "[fg:bg:b] %s([hilite:bg:b]%s[fg:bg:-])[fg:bg:-] "
 	contentTXT      = "text"
 	contentYAML     = "yaml"
+	contentDiff     = "diff"
 )
 
 // Details represents a generic text viewer.
@@ -101,6 +102,8 @@ func (d *Details) TextChanged(lines []string) {
 	switch d.contentType {
 	case contentYAML:
 		d.text.SetText(colorizeYAML(d.app.Styles.Views().Yaml, strings.Join(lines, "\n")))
+	case contentDiff:
+		d.text.SetText(colorizeDiff(d.app.Styles.Frame().Status, lines))
 	default:
 		d.text.SetText(strings.Join(lines, "\n"))
 	}
@@ -336,3 +339,31 @@ func (d *Details) updateTitle() {
 	fmat += fmt.Sprintf(ui.SearchFmt, buff)
 	d.SetTitle(ui.SkinTitle(fmat, &styles))
 }
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// colorizeDiff colors unified diff lines.
+func colorizeDiff(style config.Status, lines []string) string {
+	buff := make([]string, 0, len(lines))
+	for _, l := range lines {
+		color := ""
+		switch {
+		case strings.HasPrefix(l, "+++"), strings.HasPrefix(l, "---"):
+			color = style.HighlightColor.String()
+		case strings.HasPrefix(l, "@@"):
+			color = style.ModifyColor.String()
+		case strings.HasPrefix(l, "+"):
+			color = style.AddColor.String()
+		case strings.HasPrefix(l, "-"):
+			color = style.KillColor.String()
+		}
+		if color == "" {
+			buff = append(buff, tview.Escape(l))
+			continue
+		}
+		buff = append(buff, fmt.Sprintf("[%s::]%s[-::]", color, tview.Escape(l)))
+	}
+
+	return strings.Join(buff, "\n")
+}
@@ -0,0 +1,33 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestColorizeDiff(t *testing.T) {
+	var s config.Status
+	s.AddColor, s.KillColor, s.ModifyColor, s.HighlightColor = "green", "red", "blue", "white"
+
+	ll := []string{
+		"--- a (revision 1)",
+		"+++ a (revision 2)",
+		"@@ -1,2 +1,2 @@",
+		" kind: Service",
+		"-  port: 80",
+		"+  port: [8080]",
+	}
+	e := `[#ffffff::]--- a (revision 1)[-::]
+[#ffffff::]+++ a (revision 2)[-::]
+[#0000ff::]@@ -1,2 +1,2 @@[-::]
+ kind: Service
+[#ff0000::]-  port: 80[-::]
+[#008000::]+  port: [8080[][-::]`
+
+	assert.Equal(t, e, colorizeDiff(s, ll))
+}
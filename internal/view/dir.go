@@ -74,6 +74,10 @@ func (d *Dir) bindDangerousKeys(aa *ui.KeyActions) {
 			Visible:   true,
 			Dangerous: true,
 		}),
+		ui.KeyI: ui.NewKeyActionWithOpts("Helm Install", d.helmInstallCmd, ui.ActionOpts{
+			Visible:   true,
+			Dangerous: true,
+		}),
 	})
 }
 
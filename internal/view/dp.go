@@ -30,7 +30,9 @@ func NewDeploy(gvr *client.GVR) ResourceViewer {
 				NewScaleExtender(
 					NewImageExtender(
 						NewOwnerExtender(
-							NewLogsExtender(NewBrowser(gvr), d.logOptions),
+							NewTimelineExtender(
+								NewLogsExtender(NewBrowser(gvr), d.logOptions),
+							),
 						),
 					),
 				),
@@ -25,7 +25,9 @@ func NewDaemonSet(gvr *client.GVR) ResourceViewer {
 			NewRestartExtender(
 				NewImageExtender(
 					NewOwnerExtender(
-						NewLogsExtender(NewBrowser(gvr), d.logOptions),
+						NewTimelineExtender(
+							NewLogsExtender(NewBrowser(gvr), d.logOptions),
+						),
 					),
 				),
 			),
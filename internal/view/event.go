@@ -5,6 +5,7 @@ package view
 
 import (
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tcell/v2"
 )
@@ -34,5 +35,51 @@ func (e *Event) bindKeys(aa *ui.KeyActions) {
 		ui.KeyShiftR: ui.NewKeyAction("Sort Reason", e.GetTable().SortColCmd("REASON", true), false),
 		ui.KeyShiftS: ui.NewKeyAction("Sort Source", e.GetTable().SortColCmd("SOURCE", true), false),
 		ui.KeyShiftC: ui.NewKeyAction("Sort Count", e.GetTable().SortColCmd("COUNT", true), false),
+		ui.KeyShiftH: ui.NewKeyAction("Archived", e.archivedCmd, true),
 	})
 }
+
+func (e *Event) archivedCmd(*tcell.EventKey) *tcell.EventKey {
+	if dao.EvArchiver() == nil {
+		e.App().Flash().Warn("Event archive is not enabled")
+		return nil
+	}
+	e.App().gotoResource(client.EvaGVR.String(), "", false, true)
+
+	return nil
+}
+
+// EventArchive represents an archived events view.
+type EventArchive struct {
+	ResourceViewer
+}
+
+// NewEventArchive returns a new archived events view.
+func NewEventArchive(gvr *client.GVR) ResourceViewer {
+	e := EventArchive{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	e.AddBindKeysFn(e.bindKeys)
+	e.GetTable().SetSortCol("LAST SEEN", false)
+
+	return &e
+}
+
+func (e *EventArchive) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(tcell.KeyCtrlD, ui.KeyE, ui.KeyA, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyShiftL: ui.NewKeyAction("Sort LastSeen", e.GetTable().SortColCmd("LAST SEEN", false), false),
+		ui.KeyShiftF: ui.NewKeyAction("Sort FirstSeen", e.GetTable().SortColCmd("FIRST SEEN", false), false),
+		ui.KeyShiftT: ui.NewKeyAction("Sort Type", e.GetTable().SortColCmd("TYPE", true), false),
+		ui.KeyShiftR: ui.NewKeyAction("Sort Reason", e.GetTable().SortColCmd("REASON", true), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Source", e.GetTable().SortColCmd("SOURCE", true), false),
+		ui.KeyShiftC: ui.NewKeyAction("Sort Count", e.GetTable().SortColCmd("COUNT", true), false),
+		ui.KeyShiftH: ui.NewKeyAction("Live", e.liveCmd, true),
+	})
+}
+
+func (e *EventArchive) liveCmd(*tcell.EventKey) *tcell.EventKey {
+	e.App().gotoResource(client.EvGVR.String(), "", false, true)
+
+	return nil
+}
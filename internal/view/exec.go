@@ -19,6 +19,7 @@ import (
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/slogs"
@@ -368,6 +369,7 @@ func sshIn(a *App, fqn, co string) error {
 		banner: c.Sprintf(bannerFmt, fqn, co),
 		args:   args},
 	)
+	dao.SessRecorder().Record(render.SessionExec, "exec -c "+co, sessionTarget(client.PodGVR, fqn), err)
 	if err != nil {
 		return fmt.Errorf("shell exec failed: %w", err)
 	}
@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"slices"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+const explainTitle = "Explain"
+
+// Explain represents a resource schema explorer.
+type Explain struct {
+	*Details
+
+	gvr          *client.GVR
+	version      string
+	schema       *dao.Schema
+	descriptions bool
+}
+
+// NewExplain returns a new schema explorer for a given resource. A blank
+// version yields the CRD storage version or the resource version.
+func NewExplain(app *App, gvr *client.GVR, version string) *Explain {
+	return &Explain{
+		Details:      NewDetails(app, explainTitle, gvr.String(), contentTXT, true),
+		gvr:          gvr,
+		version:      version,
+		descriptions: true,
+	}
+}
+
+// Init initializes the view.
+func (e *Explain) Init(ctx context.Context) error {
+	if err := e.Details.Init(ctx); err != nil {
+		return err
+	}
+	if err := e.load(e.version); err != nil {
+		return err
+	}
+	e.bindKeys()
+
+	return nil
+}
+
+func (e *Explain) bindKeys() {
+	e.Actions().Bulk(ui.KeyMap{
+		ui.KeyD: ui.NewKeyAction("Toggle Descriptions", e.toggleDescriptionsCmd, true),
+		ui.KeyX: ui.NewKeyAction("Example", e.exampleCmd, true),
+	})
+	if len(e.schema.Versions) > 1 {
+		e.Actions().Add(ui.KeyV, ui.NewKeyAction("Next Version", e.nextVersionCmd, true))
+	}
+}
+
+func (e *Explain) load(version string) error {
+	s, err := dao.ResourceSchema(e.app.factory, e.gvr, version)
+	if err != nil {
+		return err
+	}
+	e.schema, e.version = s, s.GVK.Version
+	e.SetSubject(s.GVK.GroupVersion().String() + "/" + s.GVK.Kind)
+	e.updateTitle()
+	e.refresh()
+
+	return nil
+}
+
+func (e *Explain) refresh() {
+	e.Update(e.schema.Explain(e.descriptions))
+}
+
+func (e *Explain) toggleDescriptionsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if e.app.InCmdMode() {
+		return evt
+	}
+	e.descriptions = !e.descriptions
+	e.refresh()
+
+	return nil
+}
+
+func (e *Explain) nextVersionCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if e.app.InCmdMode() {
+		return evt
+	}
+	vv := e.schema.Versions
+	idx := (slices.Index(vv, e.version) + 1) % len(vv)
+	if err := e.load(vv[idx]); err != nil {
+		e.app.Flash().Err(err)
+		return nil
+	}
+	e.app.Flash().Infof("Viewing %s schema version %s", e.schema.GVK.Kind, e.version)
+
+	return nil
+}
+
+func (e *Explain) exampleCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if e.app.InCmdMode() {
+		return evt
+	}
+	ex, err := e.schema.Example()
+	if err != nil {
+		e.app.Flash().Err(err)
+		return nil
+	}
+	details := NewDetails(e.app, "Example", e.subject, contentYAML, true).Update(ex)
+	if err := e.app.inject(details, false); err != nil {
+		e.app.Flash().Err(err)
+	}
+
+	return nil
+}
@@ -4,11 +4,16 @@
 package view
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"os"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/derailed/tcell/v2"
 )
 
@@ -38,13 +43,110 @@ func (*HelmChart) chartContext(ctx context.Context) context.Context {
 }
 
 func (c *HelmChart) bindKeys(aa *ui.KeyActions) {
+	if !c.App().Config.IsReadOnly() {
+		c.bindDangerousKeys(aa)
+	}
 	aa.Delete(tcell.KeyCtrlS)
 	aa.Bulk(ui.KeyMap{
 		ui.KeyR:      ui.NewKeyAction("Releases", c.historyCmd, true),
+		ui.KeyO:      ui.NewKeyAction("Resources", c.resourcesCmd, true),
+		ui.KeyShiftX: ui.NewKeyAction("Xray", c.xrayCmd, true),
+		ui.KeyX:      ui.NewKeyAction("Diff Upgrade", c.diffUpgradeCmd, true),
 		ui.KeyShiftS: ui.NewKeyAction("Sort Status", c.GetTable().SortColCmd(statusCol, true), false),
 	})
 }
 
+func (c *HelmChart) bindDangerousKeys(aa *ui.KeyActions) {
+	aa.Add(ui.KeyU, ui.NewKeyActionWithOpts("Upgrade", c.upgradeCmd,
+		ui.ActionOpts{
+			Visible:   true,
+			Dangerous: true,
+		},
+	))
+}
+
+func (c *HelmChart) upgradeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := c.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	c.Stop()
+	defer c.Start()
+	vals, ok := c.editValues(path)
+	if !ok {
+		return nil
+	}
+	_, n := client.Namespaced(path)
+	msg := fmt.Sprintf("Upgrade release [yellow::b]%s[-::-] with the edited values?", n)
+	d := c.App().Styles.Dialog()
+	dialog.ShowConfirm(&d, c.App().Content.Pages, "Confirm Upgrade", msg, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), c.App().Conn().Config().CallTimeout())
+		defer cancel()
+		rel, err := c.helm().Upgrade(ctx, path, vals, false)
+		if err != nil {
+			c.App().Flash().Err(err)
+			return
+		}
+		c.App().Flash().Infof("Release %q upgraded to revision %d", n, rel.Version)
+		c.Refresh()
+	}, func() {})
+
+	return nil
+}
+
+func (c *HelmChart) diffUpgradeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := c.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	c.Stop()
+	defer c.Start()
+	vals, ok := c.editValues(path)
+	if !ok {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), c.App().Conn().Config().CallTimeout())
+	defer cancel()
+	diff, err := c.helm().DiffUpgrade(ctx, path, vals)
+	if err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+	showHelmDiff(c.App(), path, diff)
+
+	return nil
+}
+
+// editValues edits the release user supplied values. Returns false when the values are unchanged.
+func (c *HelmChart) editValues(path string) ([]byte, bool) {
+	raw, err := c.helm().GetValues(path, false)
+	if err != nil {
+		c.App().Flash().Err(err)
+		return nil, false
+	}
+	_, n := client.Namespaced(path)
+	vals, err := editHelmValues(c.App(), n, raw)
+	if err != nil {
+		c.App().Flash().Err(err)
+		return nil, false
+	}
+	if bytes.Equal(raw, vals) {
+		c.App().Flash().Info("No values changes detected")
+		return nil, false
+	}
+
+	return vals, true
+}
+
+func (c *HelmChart) helm() *dao.HelmChart {
+	var h dao.HelmChart
+	h.Init(c.App().factory, c.GVR())
+
+	return &h
+}
+
 func (c *HelmChart) viewReleases(app *App, _ ui.Tabular, _ *client.GVR, _ string) {
 	v := NewHistory(client.HmhGVR)
 	v.SetContextFn(c.helmContext)
@@ -63,6 +165,34 @@ func (c *HelmChart) historyCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (c *HelmChart) resourcesCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := c.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	v := NewHelmResource(client.HmrGVR)
+	v.SetContextFn(c.helmContext)
+	if err := c.App().inject(v, false); err != nil {
+		c.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (c *HelmChart) xrayCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := c.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	v := NewXray(c.GVR())
+	v.SetInstance(path)
+	if err := c.App().inject(v, false); err != nil {
+		c.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
 func (c *HelmChart) helmContext(ctx context.Context) context.Context {
 	path := c.GetTable().GetSelectedItem()
 	if path == "" {
@@ -72,3 +202,27 @@ func (c *HelmChart) helmContext(ctx context.Context) context.Context {
 
 	return context.WithValue(ctx, internal.KeyPath, path)
 }
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func editHelmValues(app *App, name string, raw []byte) ([]byte, error) {
+	fpath, err := editTempFile(app, name+"-values.yaml", raw)
+	if err != nil {
+		return nil, err
+	}
+	defer removeFile(fpath)
+
+	return os.ReadFile(fpath)
+}
+
+func showHelmDiff(app *App, subject, diff string) {
+	if diff == "" {
+		app.Flash().Info("No manifest differences found")
+		return
+	}
+	details := NewDetails(app, "Diff", subject, contentDiff, true).Update(diff)
+	if err := app.inject(details, false); err != nil {
+		app.Flash().Err(err)
+	}
+}
@@ -6,6 +6,7 @@ package view
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/derailed/k9s/internal/client"
@@ -58,14 +59,106 @@ func (h *History) bindKeys(aa *ui.KeyActions) {
 		h.bindDangerousKeys(aa)
 	}
 
-	aa.Delete(ui.KeyShiftA, ui.KeyShiftN, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace, tcell.KeyCtrlD)
+	aa.Delete(ui.KeyShiftA, ui.KeyShiftN, tcell.KeyCtrlS, tcell.KeyCtrlSpace, tcell.KeyCtrlD)
 	aa.Bulk(ui.KeyMap{
 		ui.KeyShiftN: ui.NewKeyAction("Sort Revision", h.GetTable().SortColCmd("REVISION", true), false),
 		ui.KeyShiftS: ui.NewKeyAction("Sort Status", h.GetTable().SortColCmd("STATUS", true), false),
 		ui.KeyShiftA: ui.NewKeyAction("Sort Age", h.GetTable().SortColCmd("AGE", true), false),
+		ui.KeyX:      ui.NewKeyAction("Diff Revisions", h.diffCmd, true),
 	})
 }
 
+// diffCmd diffs the selected revision against a marked one or, absent marks,
+// against the closest prior revision.
+func (h *History) diffCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := h.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	ns, nrev := client.Namespaced(path)
+	n, rev, _ := strings.Cut(nrev, ":")
+	sel, err := strconv.Atoi(rev)
+	if err != nil {
+		h.App().Flash().Errf("unable to parse version in %q", path)
+		return nil
+	}
+	var marks []int
+	if items := h.GetTable().GetSelectedItems(); len(items) != 1 || items[0] != path {
+		marks = h.revisions(items)
+	}
+	from, to, err := diffRevisions(sel, marks, h.revisions(h.rowIDs()))
+	if err != nil {
+		h.App().Flash().Warn(err.Error())
+		return nil
+	}
+
+	var hm dao.HelmHistory
+	hm.Init(h.App().factory, h.GVR())
+	diff, err := hm.Diff(client.FQN(ns, n), strconv.Itoa(from), strconv.Itoa(to))
+	if err != nil {
+		h.App().Flash().Err(err)
+		return nil
+	}
+	showHelmDiff(h.App(), path, diff)
+
+	return nil
+}
+
+func (h *History) rowIDs() []string {
+	ids := make([]string, 0, h.GetTable().GetRowCount())
+	for r := 1; r < h.GetTable().GetRowCount(); r++ {
+		if id, ok := h.GetTable().GetRowID(r); ok {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+func (*History) revisions(paths []string) []int {
+	revs := make([]int, 0, len(paths))
+	for _, p := range paths {
+		_, nrev := client.Namespaced(p)
+		_, rev, _ := strings.Cut(nrev, ":")
+		if v, err := strconv.Atoi(rev); err == nil {
+			revs = append(revs, v)
+		}
+	}
+
+	return revs
+}
+
+// diffRevisions picks the revisions to diff. Two marks are diffed against
+// each other, a single mark against the selection. Otherwise the selection is
+// diffed against the closest existing prior revision.
+func diffRevisions(sel int, marks, revs []int) (from, to int, err error) {
+	switch len(marks) {
+	case 0:
+	case 1:
+		return min(sel, marks[0]), max(sel, marks[0]), nil
+	case 2:
+		if marks[0] == marks[1] {
+			return 0, 0, fmt.Errorf("marked revisions must differ")
+		}
+		return min(marks[0], marks[1]), max(marks[0], marks[1]), nil
+	default:
+		return 0, 0, fmt.Errorf("mark at most 2 revisions to diff")
+	}
+
+	from = -1
+	for _, r := range revs {
+		if r < sel && r > from {
+			from = r
+		}
+	}
+	if from == -1 {
+		return 0, 0, fmt.Errorf("no revision prior to %d", sel)
+	}
+
+	return from, sel, nil
+}
+
 func (h *History) getValsCmd(app *App, _ ui.Tabular, _ *client.GVR, path string) {
 	ns, n := client.Namespaced(path)
 	tt := strings.Split(n, ":")
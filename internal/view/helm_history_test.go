@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_diffRevisions(t *testing.T) {
+	uu := map[string]struct {
+		sel         int
+		marks, revs []int
+		from, to    int
+		err         string
+	}{
+		"previous": {
+			sel:  3,
+			revs: []int{1, 2, 3, 4},
+			from: 2,
+			to:   3,
+		},
+		"closest": {
+			sel:  7,
+			revs: []int{2, 9, 5, 7},
+			from: 5,
+			to:   7,
+		},
+		"first": {
+			sel:  2,
+			revs: []int{2, 3},
+			err:  "no revision prior to 2",
+		},
+		"one-mark": {
+			sel:   2,
+			marks: []int{5},
+			from:  2,
+			to:    5,
+		},
+		"two-marks": {
+			sel:   1,
+			marks: []int{6, 3},
+			from:  3,
+			to:    6,
+		},
+		"too-many": {
+			sel:   1,
+			marks: []int{1, 2, 3},
+			err:   "mark at most 2 revisions to diff",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			from, to, err := diffRevisions(u.sel, u.marks, u.revs)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, u.from, from)
+			assert.Equal(t, u.to, to)
+		})
+	}
+}
@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+const helmInstallDialogKey = "helm-install"
+
+// helmInstallOpts tracks a local chart install options.
+type helmInstallOpts struct {
+	name, namespace string
+	editValues      bool
+}
+
+func (d *Dir) helmInstallCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel := d.GetTable().GetSelectedItem()
+	if sel == "" {
+		return evt
+	}
+	if !dao.IsHelmChart(sel) {
+		d.App().Flash().Errf("you must select a chart directory or a packaged chart")
+		return nil
+	}
+
+	ns := d.App().Config.ActiveNamespace()
+	if client.IsAllNamespaces(ns) {
+		ns = client.DefaultNamespace
+	}
+	opts := helmInstallOpts{
+		name:      dao.HelmChartName(sel),
+		namespace: ns,
+	}
+	d.showHelmInstallDialog(sel, &opts)
+
+	return nil
+}
+
+func (d *Dir) showHelmInstallDialog(chart string, opts *helmInstallOpts) {
+	app := d.App()
+	styles := app.Styles.Dialog()
+
+	f := tview.NewForm().
+		SetItemPadding(0).
+		SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.ButtonBgColor.Color()).
+		SetButtonTextColor(styles.ButtonFgColor.Color()).
+		SetLabelColor(styles.LabelFgColor.Color()).
+		SetFieldTextColor(styles.FieldFgColor.Color())
+	f.AddInputField("Release:", opts.name, 0, nil, func(s string) {
+		opts.name = strings.TrimSpace(s)
+	})
+	f.AddInputField("Namespace:", opts.namespace, 0, nil, func(s string) {
+		opts.namespace = strings.TrimSpace(s)
+	})
+	f.AddCheckbox("Edit Values:", opts.editValues, func(_ string, checked bool) {
+		opts.editValues = checked
+	})
+	f.AddButton("OK", func() {
+		app.Content.RemovePage(helmInstallDialogKey)
+		if err := d.helmInstall(chart, opts); err != nil {
+			app.Flash().Err(err)
+		}
+	}).
+		AddButton("Cancel", func() {
+			app.Content.RemovePage(helmInstallDialogKey)
+		})
+	for i := range f.GetButtonCount() {
+		f.GetButton(i).
+			SetBackgroundColorActivated(styles.ButtonFocusBgColor.Color()).
+			SetLabelColorActivated(styles.ButtonFocusFgColor.Color())
+	}
+
+	m := tview.NewModalForm("<Helm Install>", f)
+	m.SetText(fmt.Sprintf("Install chart %s?", filepath.Base(chart)))
+	m.SetDoneFunc(func(int, string) {
+		app.Content.RemovePage(helmInstallDialogKey)
+	})
+	app.Content.AddPage(helmInstallDialogKey, m, false, false)
+	app.Content.ShowPage(helmInstallDialogKey)
+}
+
+func (d *Dir) helmInstall(chart string, opts *helmInstallOpts) error {
+	if opts.name == "" || opts.namespace == "" {
+		return fmt.Errorf("a release name and namespace are required")
+	}
+
+	var vals []byte
+	if opts.editValues {
+		raw, err := dao.HelmChartValues(chart)
+		if err != nil {
+			return err
+		}
+		if vals, err = editHelmValues(d.App(), opts.name, raw); err != nil {
+			return err
+		}
+	}
+
+	var h dao.HelmChart
+	h.Init(d.App().factory, client.HmGVR)
+	ctx, cancel := context.WithTimeout(context.Background(), d.App().Conn().Config().CallTimeout())
+	defer cancel()
+	rel, err := h.Install(ctx, client.FQN(opts.namespace, opts.name), chart, vals)
+	if err != nil {
+		return err
+	}
+	d.App().Flash().Infof("Release %q installed in namespace %q (%s)", rel.Name, rel.Namespace, rel.Info.Status)
+
+	return nil
+}
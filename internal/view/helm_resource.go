@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render/helm"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// HelmResource presents the resources managed by a helm release.
+type HelmResource struct {
+	ResourceViewer
+}
+
+// NewHelmResource returns a new viewer.
+func NewHelmResource(gvr *client.GVR) ResourceViewer {
+	r := HelmResource{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	r.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	r.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	r.GetTable().SetEnterFn(r.gotoResource)
+	r.AddBindKeysFn(r.bindKeys)
+
+	return &r
+}
+
+// Init initializes the view.
+func (r *HelmResource) Init(ctx context.Context) error {
+	if err := r.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	r.GetTable().GetModel().SetNamespace(client.BlankNamespace)
+	r.GetTable().SetSortCol("KIND", true)
+
+	return nil
+}
+
+func (r *HelmResource) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, ui.KeyShiftN, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace, tcell.KeyCtrlD, ui.KeyE)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyD:      ui.NewKeyAction("Describe", r.describeCmd, true),
+		ui.KeyShiftK: ui.NewKeyAction("Sort Kind", r.GetTable().SortColCmd("KIND", true), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", r.GetTable().SortColCmd(statusCol, true), false),
+	})
+}
+
+func (*HelmResource) gotoResource(app *App, _ ui.Tabular, _ *client.GVR, id string) {
+	gvr, path := helm.ResourceFromID(id)
+	if gvr == "" {
+		app.Flash().Errf("unknown resource %q", path)
+		return
+	}
+	app.gotoResource(gvr, path, false, true)
+}
+
+func (r *HelmResource) describeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	id := r.GetTable().GetSelectedItem()
+	if id == "" {
+		return evt
+	}
+	gvr, path := helm.ResourceFromID(id)
+	if gvr == "" {
+		r.App().Flash().Errf("unknown resource %q", path)
+		return nil
+	}
+	describeResource(r.App(), nil, client.NewGVR(gvr), path)
+
+	return nil
+}
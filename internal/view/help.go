@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
@@ -18,6 +19,7 @@ import (
 	"github.com/derailed/tcell/v2"
 	"github.com/derailed/tview"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
 )
 
 const (
@@ -28,20 +30,31 @@ const (
 // HelpFunc processes menu hints.
 type HelpFunc func() model.MenuHints
 
+type actioner interface {
+	Actions() *ui.KeyActions
+}
+
+type aliaser interface {
+	Aliases() sets.Set[string]
+}
+
 // Help presents a help viewer.
 type Help struct {
 	*Table
 
 	styles                   *config.Styles
 	hints                    HelpFunc
+	top                      model.Component
 	maxKey, maxDesc, maxRows int
 }
 
 // NewHelp returns a new help viewer.
 func NewHelp(app *App) *Help {
+	top := app.Content.Top()
 	return &Help{
 		Table: NewTable(client.HlpGVR),
-		hints: app.Content.Top().Hints,
+		hints: top.Hints,
+		top:   top,
 	}
 }
 
@@ -138,6 +151,11 @@ func (h *Help) build() {
 	if hh, err := h.showHotKeys(); err == nil {
 		h.computeMaxes(hh)
 		h.addSection(col, "HOTKEYS", hh)
+		col += 2
+	}
+	if hh := h.showKeymap(); len(hh) > 0 {
+		h.computeMaxes(hh)
+		h.addSection(col, "KEYMAP", hh)
 	}
 }
 
@@ -201,6 +219,18 @@ func (*Help) showNav() model.MenuHints {
 			Mnemonic:    "-",
 			Description: "Last Used Command",
 		},
+		{
+			Mnemonic:    "Ctrl-t",
+			Description: "Tab New",
+		},
+		{
+			Mnemonic:    "{",
+			Description: "Tab Previous",
+		},
+		{
+			Mnemonic:    "}",
+			Description: "Tab Next",
+		},
 	}
 }
 
@@ -225,6 +255,40 @@ func (h *Help) showHotKeys() (model.MenuHints, error) {
 	return mm, nil
 }
 
+// showKeymap lists the custom bindings in effect for the current view.
+func (h *Help) showKeymap() model.MenuHints {
+	km := h.App().keymap
+	var aliases sets.Set[string]
+	if a, ok := h.top.(aliaser); ok {
+		aliases = a.Aliases()
+	}
+	var actions []*ui.KeyActions
+	if a, ok := h.top.(actioner); ok {
+		actions = append(actions, a.Actions())
+	}
+	actions = append(actions, h.App().GetActions())
+
+	bb := km.BindingsFor(aliases)
+	mm := make(model.MenuHints, 0, len(bb))
+	for _, b := range bb {
+		key, err := asKey(b.Key)
+		if err != nil {
+			continue
+		}
+		for _, aa := range actions {
+			if a, ok := aa.Get(key); ok && strings.EqualFold(a.Description, b.Action) {
+				mm = append(mm, model.MenuHint{
+					Mnemonic:    b.Key,
+					Description: b.Action,
+				})
+				break
+			}
+		}
+	}
+
+	return mm
+}
+
 func (*Help) showGeneral() model.MenuHints {
 	return model.MenuHints{
 		{
@@ -6,7 +6,6 @@ package view
 import (
 	"context"
 	"errors"
-	"fmt"
 	"log/slog"
 	"os"
 	"regexp"
@@ -188,13 +187,7 @@ func extractApp(ctx context.Context) (*App, error) {
 
 // AsKey maps a string representation of a key to a tcell key.
 func asKey(key string) (tcell.Key, error) {
-	for k, v := range tcell.KeyNames {
-		if key == v {
-			return k, nil
-		}
-	}
-
-	return 0, fmt.Errorf("invalid key specified: %q", key)
+	return config.ParseKey(key)
 }
 
 // FwFQN returns a fully qualified ns/name:container id.
@@ -15,6 +15,7 @@ import (
 	"github.com/derailed/k9s/internal/config/mock"
 	"github.com/derailed/k9s/internal/model1"
 	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tcell/v2"
 	"github.com/sahilm/fuzzy"
 	"github.com/stretchr/testify/assert"
@@ -108,8 +109,11 @@ func TestAsKey(t *testing.T) {
 		err error
 		e   tcell.Key
 	}{
-		"cool": {k: "Ctrl-A", e: tcell.KeyCtrlA},
-		"miss": {k: "fred", e: 0, err: errors.New(`invalid key specified: "fred"`)},
+		"cool":       {k: "Ctrl-A", e: tcell.KeyCtrlA},
+		"lower-ctrl": {k: "ctrl-a", e: tcell.KeyCtrlA},
+		"upper-sh":   {k: "SHIFT-A", e: ui.KeyShiftA},
+		"single":     {k: "A", e: 0, err: errors.New(`invalid key specified: "A"`)},
+		"miss":       {k: "fred", e: 0, err: errors.New(`invalid key specified: "fred"`)},
 	}
 
 	for k := range uu {
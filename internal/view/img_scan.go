@@ -4,22 +4,31 @@
 package view
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
 	"runtime"
 	"strings"
+	"time"
 
+	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config/data"
 	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/slogs"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/vul"
 	"github.com/derailed/tcell/v2"
 )
 
 const (
 	imgScanTitle = "Scans"
+	sbomTitle    = "SBOM"
 	browseOSX    = "open"
 	browseLinux  = "sensible-browser"
-	cveGovURL    = "https://nvd.nist.gov/vuln/detail/"
-	ghsaURL      = "https://github.com/advisories/"
 )
 
 // ImageScan represents an image vulnerability scan view.
@@ -48,9 +57,91 @@ func (i *ImageScan) bindKeys(aa *ui.KeyActions) {
 		ui.KeyShiftS: ui.NewKeyAction("Sort Severity", i.GetTable().SortColCmd("SEVERITY", false), true),
 		ui.KeyShiftF: ui.NewKeyAction("Sort Fixed-in", i.GetTable().SortColCmd("FIXED-IN", false), true),
 		ui.KeyShiftV: ui.NewKeyAction("Sort Vulnerability", i.GetTable().SortColCmd("VULNERABILITY", false), true),
+		ui.KeyD:      ui.NewKeyAction("Describe", i.describeCmd, true),
+		ui.KeyB:      ui.NewKeyAction("SBOM", i.sbomCmd, true),
+		ui.KeyX:      ui.NewKeyAction("Export CycloneDX", i.exportCmd(vul.CycloneDXFormat), true),
+		ui.KeyShiftX: ui.NewKeyAction("Export SARIF", i.exportCmd(vul.SARIFFormat), true),
 	})
 }
 
+func (i *ImageScan) describeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := i.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	tt := strings.Split(path, "|")
+	if len(tt) < 7 {
+		i.App().Flash().Errf("parse path failed: %s", path)
+		return nil
+	}
+	img, cve := tt[0], tt[render.CVEParseIdx]
+	var desc string
+	if sc, ok := scanFor(img); ok {
+		desc = sc.Description(cve)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%-15s %s\n", "Vulnerability:", cve)
+	fmt.Fprintf(&b, "%-15s %s\n", "Severity:", tt[6])
+	fmt.Fprintf(&b, "%-15s %s\n", "Image:", img)
+	fmt.Fprintf(&b, "%-15s %s\n", "Package:", tt[1])
+	fmt.Fprintf(&b, "%-15s %s\n", "Installed:", tt[2])
+	fmt.Fprintf(&b, "%-15s %s\n", "Fixed-In:", tt[3])
+	fmt.Fprintf(&b, "%-15s %s\n", "Type:", tt[4])
+	fmt.Fprintf(&b, "%-15s %s\n", "URL:", vul.CVEURL(cve))
+	if desc != "" {
+		fmt.Fprintf(&b, "\n%s\n", desc)
+	}
+
+	details := NewDetails(i.App(), "CVE", cve, contentTXT, true).Update(b.String())
+	if err := i.App().inject(details, false); err != nil {
+		i.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (i *ImageScan) sbomCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := i.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	img := strings.Split(path, "|")[0]
+
+	v := NewSBOM(client.BomGVR)
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, internal.KeyPath, img)
+	})
+	if err := i.App().inject(v, false); err != nil {
+		i.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (i *ImageScan) exportCmd(format string) ui.ActionHandler {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		path := i.GetTable().GetSelectedItem()
+		if path == "" {
+			return evt
+		}
+		img := strings.Split(path, "|")[0]
+		sc, ok := scanFor(img)
+		if !ok {
+			i.App().Flash().Errf("no scan found for image %q", img)
+			return nil
+		}
+		fpath, err := saveScanReport(i.App().Config.K9s.ContextScreenDumpDir(), sc, format)
+		if err != nil {
+			i.App().Flash().Err(err)
+			return nil
+		}
+		i.App().Flash().Infof("Scan report exported to %s", fpath)
+
+		return nil
+	}
+}
+
 func (*ImageScan) viewCVE(app *App, _ ui.Tabular, _ *client.GVR, path string) {
 	bin := browseLinux
 	if runtime.GOOS == "darwin" {
@@ -60,13 +151,9 @@ func (*ImageScan) viewCVE(app *App, _ ui.Tabular, _ *client.GVR, path string) {
 	tt := strings.Split(path, "|")
 	if len(tt) < 7 {
 		app.Flash().Errf("parse path failed: %s", path)
+		return
 	}
-	cve := tt[render.CVEParseIdx]
-	site := cveGovURL
-	if strings.Index(cve, "GHSA") == 0 {
-		site = ghsaURL
-	}
-	site += cve
+	site := vul.CVEURL(tt[render.CVEParseIdx])
 
 	ok, errChan, _ := run(app, &shellOpts{
 		background: true,
@@ -85,3 +172,69 @@ func (*ImageScan) viewCVE(app *App, _ ui.Tabular, _ *client.GVR, path string) {
 		app.Flash().Err(errs)
 	}
 }
+
+// SBOM represents an image packages inventory view.
+type SBOM struct {
+	ResourceViewer
+}
+
+// NewSBOM returns a new image packages view.
+func NewSBOM(gvr *client.GVR) ResourceViewer {
+	v := SBOM{}
+	v.ResourceViewer = NewBrowser(gvr)
+	v.AddBindKeysFn(v.bindKeys)
+	v.GetTable().SetSortCol("VULNS", false)
+
+	return &v
+}
+
+// Name returns the component name.
+func (*SBOM) Name() string { return sbomTitle }
+
+func (s *SBOM) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, ui.KeyShiftN, tcell.KeyCtrlZ, tcell.KeyCtrlW)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyShiftN: ui.NewKeyAction("Sort Name", s.GetTable().SortColCmd(nameCol, true), false),
+		ui.KeyShiftT: ui.NewKeyAction("Sort Type", s.GetTable().SortColCmd("TYPE", true), false),
+		ui.KeyShiftV: ui.NewKeyAction("Sort Vulns", s.GetTable().SortColCmd("VULNS", false), false),
+	})
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func scanFor(img string) (*vul.Scan, bool) {
+	if vul.ImgScanner == nil {
+		return nil, false
+	}
+
+	return vul.ImgScanner.GetScan(img)
+}
+
+func saveScanReport(dir string, sc *vul.Scan, format string) (string, error) {
+	if err := ensureDir(dir); err != nil {
+		return "", err
+	}
+	ext := ".cdx.json"
+	if format == vul.SARIFFormat {
+		ext = ".sarif.json"
+	}
+	fpath := filepath.Join(dir, fmt.Sprintf("%s-%d%s", data.SanitizeFileName(sc.ID), time.Now().UnixNano(), ext))
+	out, err := os.OpenFile(fpath, os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		if err := out.Close(); err != nil {
+			slog.Error("Closing scan report failed",
+				slogs.Path, fpath,
+				slogs.Error, err,
+			)
+		}
+	}()
+	if err := sc.Export(out, format); err != nil {
+		return "", err
+	}
+
+	return fpath, nil
+}
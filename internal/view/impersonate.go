@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"errors"
+	"log/slog"
+
+	"github.com/derailed/k9s/internal/slogs"
+	"github.com/derailed/k9s/internal/view/cmd"
+)
+
+const impersonateIC = "🎭"
+
+// impersonateCmd switches the session identity and reloads the current view.
+func (a *App) impersonateCmd(p *cmd.Interpreter) error {
+	user, groups, ok := p.ImpersonateArgs()
+	if !ok {
+		return errors.New("invalid command. Use `as u:user|s:namespace/sa [g:group1,group2]` or `as` to revert")
+	}
+
+	a.Halt()
+	defer a.Resume()
+	{
+		if err := a.Conn().Impersonate(user, groups); err != nil {
+			return err
+		}
+		ns := a.Config.ActiveNamespace()
+		a.initFactory(ns)
+		a.initAlerts()
+		slog.Debug("Switching identity", slogs.Subject, user, slogs.Namespace, ns)
+		a.gotoResource(a.Config.ActiveView(), "", true, true)
+		a.clusterModel.Reset(a.factory)
+	}
+	if as := a.Conn().Config().ImpersonationInfo(); as != "" {
+		a.Flash().Infof("Impersonating %s", as)
+	} else {
+		a.Flash().Info("Impersonation reverted")
+	}
+
+	return nil
+}
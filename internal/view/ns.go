@@ -36,6 +36,7 @@ func NewNamespace(gvr *client.GVR) ResourceViewer {
 func (n *Namespace) bindKeys(aa *ui.KeyActions) {
 	aa.Bulk(ui.KeyMap{
 		ui.KeyU:      ui.NewKeyAction("Use", n.useNsCmd, true),
+		ui.KeyQ:      ui.NewKeyAction("Quotas", n.quotasCmd, true),
 		ui.KeyShiftS: ui.NewKeyAction("Sort Status", n.GetTable().SortColCmd(statusCol, true), false),
 	})
 }
@@ -56,6 +57,19 @@ func (n *Namespace) useNsCmd(*tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (n *Namespace) quotasCmd(*tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" || path == client.NamespaceAll {
+		return nil
+	}
+	_, ns := client.Namespaced(path)
+	if err := n.App().inject(NewQuotaDashboard(ns), false); err != nil {
+		n.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
 func (n *Namespace) useNamespace(fqn string) {
 	_, ns := client.Namespaced(fqn)
 	if client.CleanseNamespace(n.App().Config.ActiveNamespace()) == ns {
@@ -0,0 +1,276 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/slogs"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+const (
+	pinTitle      = "Pins"
+	pinPanelWidth = 48
+	pinRefresh    = 5 * time.Second
+)
+
+// Pin presents the pinned resources watch list.
+type Pin struct {
+	ResourceViewer
+}
+
+// NewPin returns a new viewer.
+func NewPin(gvr *client.GVR) ResourceViewer {
+	p := Pin{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	p.GetTable().SetBorderFocusColor(tcell.ColorSteelBlue)
+	p.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorRoyalBlue).Attributes(tcell.AttrNone))
+	p.GetTable().SetEnterFn(p.gotoPin)
+	p.SetContextFn(p.pinsContext)
+	p.AddBindKeysFn(p.bindKeys)
+
+	return &p
+}
+
+// Init initializes the view.
+func (p *Pin) Init(ctx context.Context) error {
+	if err := p.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	p.GetTable().GetModel().SetNamespace(client.BlankNamespace)
+
+	return nil
+}
+
+// Name returns the component name.
+func (*Pin) Name() string { return pinTitle }
+
+func (p *Pin) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace, tcell.KeyCtrlD, ui.KeyE)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyD:        ui.NewKeyAction("Describe", p.describeCmd, true),
+		tcell.KeyCtrlN: ui.NewKeyAction("Unpin", p.unpinCmd, true),
+		ui.KeyShiftS:   ui.NewKeyAction("Sort Status", p.GetTable().SortColCmd("STATUS", true), false),
+		ui.KeyShiftE:   ui.NewKeyAction("Sort Events", p.GetTable().SortColCmd("EVENTS", false), false),
+	})
+}
+
+func (p *Pin) pinsContext(ctx context.Context) context.Context {
+	ct, err := p.App().Config.CurrentContext()
+	if err != nil {
+		p.App().Flash().Err(err)
+		return ctx
+	}
+
+	return context.WithValue(ctx, internal.KeyPins, ct.GetPins())
+}
+
+func (*Pin) gotoPin(app *App, _ ui.Tabular, _ *client.GVR, id string) {
+	gvr, path := render.PinFromID(id)
+	app.gotoResource(gvr, path, false, true)
+}
+
+func (p *Pin) describeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	id := p.GetTable().GetSelectedItem()
+	if id == "" {
+		return evt
+	}
+	gvr, path := render.PinFromID(id)
+	describeResource(p.App(), nil, client.NewGVR(gvr), path)
+
+	return nil
+}
+
+func (p *Pin) unpinCmd(evt *tcell.EventKey) *tcell.EventKey {
+	id := p.GetTable().GetSelectedItem()
+	if id == "" {
+		return evt
+	}
+	gvr, path := render.PinFromID(id)
+	if err := p.App().togglePin(client.NewGVR(gvr), path); err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+	p.Refresh()
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Pins panel...
+
+// PinPanel displays a compact pinned resources status list.
+type PinPanel struct {
+	*tview.TextView
+
+	app      *App
+	context  string
+	statuses map[string]render.PinRes
+	mx       sync.Mutex
+}
+
+// NewPinPanel returns a new pins panel.
+func NewPinPanel(app *App) *PinPanel {
+	p := PinPanel{
+		TextView: tview.NewTextView(),
+		app:      app,
+		statuses: make(map[string]render.PinRes),
+	}
+	p.SetBorder(true)
+	p.SetBorderPadding(0, 0, 1, 1)
+	p.SetDynamicColors(true)
+	p.SetWrap(false)
+	p.StylesChanged(app.Styles)
+	app.Styles.AddListener(&p)
+
+	return &p
+}
+
+// StylesChanged notifies skin changed.
+func (p *PinPanel) StylesChanged(s *config.Styles) {
+	p.SetBackgroundColor(s.BgColor())
+	p.SetTextColor(s.FgColor())
+	p.SetBorderColor(s.Frame().Border.FgColor.Color())
+}
+
+func (p *PinPanel) watch(ctx context.Context) {
+	for {
+		p.refresh()
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(pinRefresh):
+		}
+	}
+}
+
+func (p *PinPanel) refresh() {
+	if p.app.factory == nil || !p.app.ConOK() {
+		return
+	}
+	ct, err := p.app.Config.CurrentContext()
+	if err != nil {
+		slog.Warn("Unable to refresh pins", slogs.Error, err)
+		return
+	}
+
+	pins, now := ct.GetPins(), time.Now()
+	rr := make([]render.PinRes, 0, len(pins))
+	for _, pin := range pins {
+		rr = append(rr, dao.PinStatus(p.app.factory, pin, now))
+	}
+	changes := p.track(p.app.Config.ActiveContextName(), rr)
+
+	p.app.QueueUpdateDraw(func() {
+		p.update(rr)
+		for _, c := range changes {
+			if c.healthy {
+				p.app.Flash().Info(c.msg)
+			} else {
+				p.app.Flash().Warn(c.msg)
+			}
+		}
+	})
+}
+
+type pinChange struct {
+	msg     string
+	healthy bool
+}
+
+// track records the pins statuses and returns the ones that changed since last seen.
+func (p *PinPanel) track(ctx string, rr []render.PinRes) []pinChange {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	if p.context != ctx {
+		p.context, p.statuses = ctx, make(map[string]render.PinRes, len(rr))
+	}
+	var cc []pinChange
+	statuses := make(map[string]render.PinRes, len(rr))
+	for _, r := range rr {
+		id := render.PinID(r.GVR, r.Path)
+		statuses[id] = r
+		prev, ok := p.statuses[id]
+		if !ok || (prev.Status == r.Status && prev.IsHealthy() == r.IsHealthy()) {
+			continue
+		}
+		cc = append(cc, pinChange{
+			msg:     fmt.Sprintf("📌 %s %s is now %s (was %s)", client.NewGVR(r.GVR).R(), r.Path, r.Status, prev.Status),
+			healthy: r.IsHealthy(),
+		})
+	}
+	p.statuses = statuses
+
+	return cc
+}
+
+func (p *PinPanel) update(rr []render.PinRes) {
+	styles := p.app.Styles.Frame()
+	p.SetTitle(ui.SkinTitle(fmt.Sprintf(" %s(%d) ", pinTitle, len(rr)), &styles))
+	if len(rr) == 0 {
+		p.SetText("[::d]No pins yet. Use ctrl-n to pin a resource.")
+		return
+	}
+
+	var b strings.Builder
+	for _, r := range rr {
+		color := styles.Status.NewColor
+		if !r.IsHealthy() {
+			color = styles.Status.ErrorColor
+		}
+		fmt.Fprintf(&b, "[%s::b]●[-::-] %s [::b]%s[::-] %s %s",
+			color,
+			client.NewGVR(r.GVR).R(),
+			tview.Escape(r.Path),
+			r.Status,
+			r.Ready,
+		)
+		if r.Events > 0 {
+			fmt.Fprintf(&b, " [%s::]⚡%d[-::]", styles.Status.PendingColor, r.Events)
+		}
+		b.WriteString("\n")
+	}
+	p.SetText(b.String())
+}
+
+// ----------------------------------------------------------------------------
+// App pins management...
+
+func (a *App) pinPanel() *PinPanel {
+	return a.Views()["pins"].(*PinPanel)
+}
+
+func (a *App) togglePin(gvr *client.GVR, path string) error {
+	ct, err := a.Config.CurrentContext()
+	if err != nil {
+		return err
+	}
+	pinned := ct.TogglePin(gvr.String(), path)
+	if err := a.Config.Save(true); err != nil {
+		return err
+	}
+	if pinned {
+		a.Flash().Infof("Pinned %s %s", gvr.R(), path)
+	} else {
+		a.Flash().Infof("Unpinned %s %s", gvr.R(), path)
+	}
+	go a.pinPanel().refresh()
+
+	return nil
+}
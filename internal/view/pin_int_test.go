@@ -0,0 +1,32 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPinPanelTrack(t *testing.T) {
+	p := PinPanel{statuses: make(map[string]render.PinRes)}
+	ok := render.PinRes{GVR: "apps/v1/deployments", Path: "default/nginx", Status: "Available"}
+	ko := ok
+	ko.Status, ko.Issue = "Unavailable", "status is Unavailable"
+
+	assert.Empty(t, p.track("ct-1", []render.PinRes{ok}))
+	assert.Empty(t, p.track("ct-1", []render.PinRes{ok}))
+
+	cc := p.track("ct-1", []render.PinRes{ko})
+	assert.Len(t, cc, 1)
+	assert.False(t, cc[0].healthy)
+	assert.Equal(t, "📌 deployments default/nginx is now Unavailable (was Available)", cc[0].msg)
+
+	cc = p.track("ct-1", []render.PinRes{ok})
+	assert.Len(t, cc, 1)
+	assert.True(t, cc[0].healthy)
+
+	assert.Empty(t, p.track("ct-2", []render.PinRes{ko}))
+}
@@ -53,9 +53,11 @@ func NewPod(gvr *client.GVR) ResourceViewer {
 	var p Pod
 	p.ResourceViewer = NewPortForwardExtender(
 		NewOwnerExtender(
-			NewVulnerabilityExtender(
-				NewImageExtender(
-					NewLogsExtender(NewBrowser(gvr), p.logOptions),
+			NewTimelineExtender(
+				NewVulnerabilityExtender(
+					NewImageExtender(
+						NewLogsExtender(NewBrowser(gvr), p.logOptions),
+					),
 				),
 			),
 		),
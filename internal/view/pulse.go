@@ -295,7 +295,9 @@ func (p *Pulse) keyboard(evt *tcell.EventKey) *tcell.EventKey {
 }
 
 func (p *Pulse) defaultContext() context.Context {
-	return context.WithValue(context.Background(), internal.KeyFactory, p.app.factory)
+	ctx := context.WithValue(context.Background(), internal.KeyFactory, p.app.factory)
+
+	return context.WithValue(ctx, internal.KeyViewConfig, p.app.CustomView())
 }
 
 func (*Pulse) Restart() {}
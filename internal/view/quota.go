@@ -0,0 +1,341 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/slogs"
+	"github.com/derailed/k9s/internal/tchart"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/view/cmd"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	quotaTitle     = "Quotas"
+	quotaGaugeCols = 4
+	quotaGaugeRows = 6
+)
+
+// ResourceQuota represents a resource quota view.
+type ResourceQuota struct {
+	ResourceViewer
+}
+
+// NewResourceQuota returns a new resource quota view.
+func NewResourceQuota(gvr *client.GVR) ResourceViewer {
+	r := ResourceQuota{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	r.GetTable().SetEnterFn(r.showDashboard)
+	r.AddBindKeysFn(r.bindKeys)
+
+	return &r
+}
+
+// Init initializes the view.
+func (r *ResourceQuota) Init(ctx context.Context) error {
+	if err := r.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	r.GetTable().SetColorerFn(render.QuotaColorer(r.App().Config.K9s.Thresholds[config.QUOTA]))
+
+	return nil
+}
+
+func (r *ResourceQuota) bindKeys(aa *ui.KeyActions) {
+	aa.Bulk(ui.KeyMap{
+		ui.KeyShiftM: ui.NewKeyAction("Sort %Max", r.GetTable().SortColCmd("%MAX", false), false),
+	})
+}
+
+func (*ResourceQuota) showDashboard(app *App, _ ui.Tabular, _ *client.GVR, path string) {
+	ns, _ := client.Namespaced(path)
+	if err := app.inject(NewQuotaDashboard(ns), false); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// QuotaDashboard represents a namespace quotas and limit ranges dashboard.
+type QuotaDashboard struct {
+	*tview.Flex
+
+	app        *App
+	ns         string
+	grid       *tview.Grid
+	violations *tview.Table
+	gauges     map[string]*tchart.Gauge
+	hot        string
+	actions    *ui.KeyActions
+	cancelFn   context.CancelFunc
+}
+
+// NewQuotaDashboard returns a new quota dashboard for a given namespace.
+func NewQuotaDashboard(ns string) *QuotaDashboard {
+	return &QuotaDashboard{
+		Flex:       tview.NewFlex(),
+		ns:         ns,
+		grid:       tview.NewGrid(),
+		violations: tview.NewTable(),
+		gauges:     make(map[string]*tchart.Gauge),
+		actions:    ui.NewKeyActions(),
+	}
+}
+
+// Init initializes the view.
+func (q *QuotaDashboard) Init(ctx context.Context) error {
+	var err error
+	if q.app, err = extractApp(ctx); err != nil {
+		return err
+	}
+
+	q.SetDirection(tview.FlexRow)
+	q.SetBorder(true)
+	q.SetBorderPadding(0, 0, 1, 1)
+	frame := q.app.Styles.Frame()
+	q.SetTitle(ui.SkinTitle(fmt.Sprintf(NSTitleFmt, quotaTitle, q.ns), &frame))
+
+	q.violations.SetBorder(true)
+	q.violations.SetTitle(" LimitRange Violations ")
+	q.violations.SetSelectable(true, false)
+	q.violations.SetFixed(1, 0)
+	q.violations.SetInputCapture(q.keyboard)
+
+	q.AddItem(q.grid, 0, 1, false)
+	q.AddItem(q.violations, 0, 1, true)
+
+	q.bindKeys()
+	q.app.Styles.AddListener(q)
+	q.StylesChanged(q.app.Styles)
+
+	return nil
+}
+
+// StylesChanged notifies the skin changed.
+func (q *QuotaDashboard) StylesChanged(s *config.Styles) {
+	q.SetBackgroundColor(s.Charts().BgColor.Color())
+	q.grid.SetBackgroundColor(s.Charts().BgColor.Color())
+	q.violations.SetBackgroundColor(s.Table().BgColor.Color())
+	for _, g := range q.gauges {
+		g.SetBackgroundColor(s.Charts().DialBgColor.Color())
+		g.SetSeriesColors(s.Charts().DefaultDialColors.Colors()...)
+		g.SetFocusColorNames(s.Charts().FocusFgColor.String(), s.Charts().FocusBgColor.String())
+	}
+}
+
+func (q *QuotaDashboard) bindKeys() {
+	q.actions.Merge(ui.NewKeyActionsFromMap(ui.KeyMap{
+		tcell.KeyEscape: ui.NewKeyAction("Back", q.app.PrevCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("Goto Pod", q.gotoPodCmd, true),
+	}))
+}
+
+func (q *QuotaDashboard) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	if a, ok := q.actions.Get(ui.AsKey(evt)); ok {
+		return a.Action(evt)
+	}
+
+	return evt
+}
+
+func (q *QuotaDashboard) gotoPodCmd(evt *tcell.EventKey) *tcell.EventKey {
+	row, _ := q.violations.GetSelection()
+	if row < 1 {
+		return evt
+	}
+	ref := q.violations.GetCell(row, 0).GetReference()
+	path, ok := ref.(string)
+	if !ok || path == "" {
+		return evt
+	}
+	q.app.gotoResource(client.PodGVR.String(), path, false, true)
+
+	return nil
+}
+
+// Start initializes the refresh loop.
+func (q *QuotaDashboard) Start() {
+	q.Stop()
+
+	var ctx context.Context
+	ctx, q.cancelFn = context.WithCancel(context.Background())
+	rate := time.Duration(q.app.Config.K9s.GetRefreshRate()) * time.Second
+	go func() {
+		q.refresh()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(rate):
+				q.refresh()
+			}
+		}
+	}()
+}
+
+// Stop terminates the refresh loop.
+func (q *QuotaDashboard) Stop() {
+	if q.cancelFn == nil {
+		return
+	}
+	q.cancelFn()
+	q.cancelFn = nil
+}
+
+func (q *QuotaDashboard) refresh() {
+	var rq dao.ResourceQuota
+	rq.Init(q.app.factory, client.RqGVR)
+	uu, err := rq.Usages(q.ns)
+	if err != nil {
+		slog.Error("Quota usages fetch failed", slogs.Error, err)
+		q.app.QueueUpdateDraw(func() {
+			q.app.Flash().Err(err)
+		})
+		return
+	}
+	vv, err := rq.Violations(q.ns)
+	if err != nil {
+		slog.Error("LimitRange violations fetch failed", slogs.Error, err)
+	}
+
+	q.app.QueueUpdateDraw(func() {
+		q.updateGauges(uu)
+		q.updateViolations(vv)
+	})
+}
+
+func (q *QuotaDashboard) updateGauges(uu []render.QuotaUsage) {
+	keys := make([]string, 0, len(uu))
+	for _, u := range uu {
+		keys = append(keys, quotaKey(u))
+	}
+	if !sameKeys(q.gauges, keys) {
+		q.grid.Clear()
+		q.gauges = make(map[string]*tchart.Gauge, len(uu))
+		rows := make([]int, (len(uu)+quotaGaugeCols-1)/quotaGaugeCols)
+		for i := range rows {
+			rows[i] = quotaGaugeRows
+		}
+		q.grid.SetRows(rows...)
+		for i, k := range keys {
+			g := tchart.NewGauge(k)
+			g.SetBorder(true)
+			q.gauges[k] = g
+			q.grid.AddItem(g, i/quotaGaugeCols, i%quotaGaugeCols, 1, 1, 0, 0, false)
+		}
+		q.StylesChanged(q.app.Styles)
+	}
+
+	sev, st := q.app.Config.K9s.Thresholds, q.app.Styles.Frame().Status
+	hot := make([]string, 0, len(uu))
+	for _, u := range uu {
+		g := q.gauges[quotaKey(u)]
+		perc := min(u.Percent(), 100)
+		used, hard, unit := quotaValues(u)
+		g.SetLegend(fmt.Sprintf(" %s [%s::b]%d%%[-::-] %s ", u.Resource, sev.SeverityColor(config.QUOTA, perc), perc, unit))
+		//nolint:exhaustive
+		switch sev.LevelFor(config.QUOTA, perc) {
+		case config.SeverityHigh:
+			g.SetBorderColor(st.ErrorColor.Color())
+			hot = append(hot, string(u.Resource))
+		case config.SeverityMedium:
+			g.SetBorderColor(st.HighlightColor.Color())
+			hot = append(hot, string(u.Resource))
+		default:
+			g.SetBorderColor(st.NewColor.Color())
+		}
+		g.Add(used, hard)
+	}
+	if h := strings.Join(hot, ", "); h != q.hot {
+		q.hot = h
+		if h != "" {
+			q.app.Flash().Warnf("Namespace %s is nearing its quota for %s", q.ns, h)
+		}
+	}
+}
+
+func (q *QuotaDashboard) updateViolations(vv []dao.LimitRangeViolation) {
+	q.violations.Clear()
+	fg := q.app.Styles.Table().Header.FgColor.Color()
+	for i, h := range []string{"POD", "REASON"} {
+		q.violations.SetCell(0, i, tview.NewTableCell(h).SetTextColor(fg).SetSelectable(false).SetExpansion(i))
+	}
+	row := 1
+	for _, v := range vv {
+		for _, r := range v.Reasons {
+			q.violations.SetCell(row, 0, tview.NewTableCell(v.Path).SetReference(v.Path).SetTextColor(model1.ErrColor))
+			q.violations.SetCell(row, 1, tview.NewTableCell(r).SetExpansion(1))
+			row++
+		}
+	}
+}
+
+// InCmdMode checks if prompt is active.
+func (*QuotaDashboard) InCmdMode() bool {
+	return false
+}
+
+func (*QuotaDashboard) SetCommand(*cmd.Interpreter)      {}
+func (*QuotaDashboard) SetFilter(string)                 {}
+func (*QuotaDashboard) SetLabelSelector(labels.Selector) {}
+
+// Name returns the component name.
+func (*QuotaDashboard) Name() string {
+	return quotaTitle
+}
+
+// Hints returns the view hints.
+func (q *QuotaDashboard) Hints() model.MenuHints {
+	return q.actions.Hints()
+}
+
+// ExtraHints returns additional hints.
+func (*QuotaDashboard) ExtraHints() map[string]string {
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func quotaKey(u render.QuotaUsage) string {
+	return u.Quota + ":" + string(u.Resource)
+}
+
+func sameKeys(gg map[string]*tchart.Gauge, kk []string) bool {
+	if len(gg) != len(kk) {
+		return false
+	}
+
+	return !slices.ContainsFunc(kk, func(k string) bool {
+		_, ok := gg[k]
+		return !ok
+	})
+}
+
+// quotaValues returns quota used and hard values scaled to a displayable unit.
+func quotaValues(u render.QuotaUsage) (used, hard int, unit string) {
+	res := string(u.Resource)
+	switch {
+	case strings.Contains(res, "cpu"):
+		return int(u.Used.MilliValue()), int(u.Hard.MilliValue()), "m"
+	case strings.Contains(res, "memory"), strings.Contains(res, "storage"):
+		return int(client.ToMB(u.Used.Value())), int(client.ToMB(u.Hard.Value())), "Mi"
+	default:
+		return int(u.Used.Value()), int(u.Hard.Value()), ""
+	}
+}
@@ -57,6 +57,9 @@ func coreViewers(vv MetaViewers) {
 	vv[client.PvcGVR] = MetaViewer{
 		viewerFn: NewPersistentVolumeClaim,
 	}
+	vv[client.RqGVR] = MetaViewer{
+		viewerFn: NewResourceQuota,
+	}
 }
 
 func miscViewers(vv MetaViewers) {
@@ -72,12 +75,18 @@ func miscViewers(vv MetaViewers) {
 	vv[client.ScnGVR] = MetaViewer{
 		viewerFn: NewImageScan,
 	}
+	vv[client.BomGVR] = MetaViewer{
+		viewerFn: NewSBOM,
+	}
 	vv[client.PfGVR] = MetaViewer{
 		viewerFn: NewPortForward,
 	}
 	vv[client.SdGVR] = MetaViewer{
 		viewerFn: NewScreenDump,
 	}
+	vv[client.SdpGVR] = MetaViewer{
+		viewerFn: NewDumpPreview,
+	}
 	vv[client.BeGVR] = MetaViewer{
 		viewerFn: NewBenchmark,
 	}
@@ -90,6 +99,30 @@ func miscViewers(vv MetaViewers) {
 	vv[client.PuGVR] = MetaViewer{
 		viewerFn: NewPulse,
 	}
+	vv[client.CapGVR] = MetaViewer{
+		viewerFn: NewCapacity,
+	}
+	vv[client.EvaGVR] = MetaViewer{
+		viewerFn: NewEventArchive,
+	}
+	vv[client.SesGVR] = MetaViewer{
+		viewerFn: NewSession,
+	}
+	vv[client.SseGVR] = MetaViewer{
+		viewerFn: NewSessionEntry,
+	}
+	vv[client.PinGVR] = MetaViewer{
+		viewerFn: NewPin,
+	}
+	vv[client.AlGVR] = MetaViewer{
+		viewerFn: NewAlert,
+	}
+	vv[client.CrtGVR] = MetaViewer{
+		viewerFn: NewCert,
+	}
+	vv[client.DkGVR] = MetaViewer{
+		viewerFn: NewDataKeys,
+	}
 }
 
 func appsViewers(vv MetaViewers) {
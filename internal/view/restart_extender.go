@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/derailed/tcell/v2"
@@ -64,7 +65,9 @@ func (r *RestartExtender) restartCmd(*tcell.EventKey) *tcell.EventKey {
 			ctx, cancel := context.WithTimeout(context.Background(), r.App().Conn().Config().CallTimeout())
 			defer cancel()
 			for _, path := range paths {
-				if err := r.restartRollout(ctx, path, opts); err != nil {
+				err := r.restartRollout(ctx, path, opts)
+				dao.SessRecorder().Record(render.SessionRestart, "rollout restart", sessionTarget(r.GVR(), path), err)
+				if err != nil {
 					r.App().Flash().Err(err)
 				} else {
 					r.App().Flash().Infof("Restart in progress for `%s...", path)
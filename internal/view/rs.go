@@ -22,8 +22,10 @@ type ReplicaSet struct {
 func NewReplicaSet(gvr *client.GVR) ResourceViewer {
 	r := ReplicaSet{
 		ResourceViewer: NewOwnerExtender(
-			NewVulnerabilityExtender(
-				NewBrowser(gvr),
+			NewTimelineExtender(
+				NewVulnerabilityExtender(
+					NewBrowser(gvr),
+				),
 			),
 		),
 	}
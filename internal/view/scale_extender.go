@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/slogs"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tcell/v2"
@@ -180,7 +181,9 @@ func (s *ScaleExtender) makeScaleForm(fqns []string) (*tview.Form, error) {
 		ctx, cancel := context.WithTimeout(context.Background(), s.App().Conn().Config().CallTimeout())
 		defer cancel()
 		for _, fqn := range fqns {
-			if err := s.scale(ctx, fqn, int32(count)); err != nil {
+			err := s.scale(ctx, fqn, int32(count))
+			dao.SessRecorder().Record(render.SessionScale, fmt.Sprintf("scale --replicas=%d", count), sessionTarget(s.GVR(), fqn), err)
+			if err != nil {
 				slog.Error("Unable to scale resource", slogs.FQN, fqn)
 				s.App().Flash().Err(err)
 				return
@@ -5,16 +5,24 @@ package view
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
+	"path/filepath"
+	"strconv"
+	"strings"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model1"
 	"github.com/derailed/k9s/internal/slogs"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tcell/v2"
 )
 
+const dumpPreviewTitle = "DumpPreview"
+
 // ScreenDump presents a directory listing viewer.
 type ScreenDump struct {
 	ResourceViewer
@@ -31,10 +39,27 @@ func NewScreenDump(gvr *client.GVR) ResourceViewer {
 	s.GetTable().SelectRow(1, 0, true)
 	s.GetTable().SetEnterFn(s.edit)
 	s.SetContextFn(s.dirContext)
+	s.AddBindKeysFn(s.bindKeys)
 
 	return &s
 }
 
+func (s *ScreenDump) bindKeys(aa *ui.KeyActions) {
+	aa.Add(ui.KeyP, ui.NewKeyAction("Preview", s.previewCmd, true))
+}
+
+func (s *ScreenDump) previewCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	if err := s.App().inject(newDumpPreviewFor(path), false); err != nil {
+		s.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
 func (s *ScreenDump) dirContext(ctx context.Context) context.Context {
 	dir := s.App().Config.K9s.ContextScreenDumpDir()
 	if err := data.EnsureFullPath(dir, data.DefaultDirMod); err != nil {
@@ -54,3 +79,92 @@ func (s *ScreenDump) edit(app *App, _ ui.Tabular, _ *client.GVR, path string) {
 		app.Flash().Errf("Failed to launch editor")
 	}
 }
+
+// DumpPreview presents a read-only screen dump content viewer.
+type DumpPreview struct {
+	ResourceViewer
+
+	path string
+}
+
+// NewDumpPreview returns a new viewer.
+func NewDumpPreview(gvr *client.GVR) ResourceViewer {
+	d := DumpPreview{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	d.GetTable().SetReadOnly(true)
+	d.GetTable().SetBorderFocusColor(tcell.ColorSteelBlue)
+	d.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorRoyalBlue).Attributes(tcell.AttrNone))
+	d.GetTable().SetEnterFn(d.describeRow)
+	d.AddBindKeysFn(d.bindKeys)
+
+	return &d
+}
+
+func newDumpPreviewFor(path string) ResourceViewer {
+	v := NewDumpPreview(client.SdpGVR)
+	d := v.(*DumpPreview)
+	d.path = path
+	d.GetTable().Extras = filepath.Base(path)
+	d.SetContextFn(func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, internal.KeyPath, path)
+	})
+
+	return d
+}
+
+// Name returns the component name.
+func (*DumpPreview) Name() string { return dumpPreviewTitle }
+
+func (d *DumpPreview) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, ui.KeyShiftN, tcell.KeyCtrlZ, tcell.KeyCtrlW, tcell.KeyCtrlS, tcell.KeyCtrlO)
+	aa.Add(ui.KeyI, ui.NewKeyAction("Info", d.infoCmd, true))
+}
+
+func (d *DumpPreview) infoCmd(*tcell.EventKey) *tcell.EventKey {
+	sd, err := dao.LoadScreenDump(d.path)
+	if err != nil {
+		d.App().Flash().Err(err)
+		return nil
+	}
+
+	var b strings.Builder
+	line := func(k, v string) {
+		fmt.Fprintf(&b, "%-12s %s\n", k+":", v)
+	}
+	line("File", d.path)
+	line("Format", model1.DumpFormatFor(d.path))
+	line("Resource", sd.Meta.Resource)
+	line("Context", sd.Meta.Context)
+	line("Namespace", sd.Meta.Namespace)
+	line("Filter", sd.Meta.Filter)
+	line("Timestamp", sd.Meta.Timestamp)
+	line("Rows", strconv.Itoa(len(sd.Rows)))
+
+	details := NewDetails(d.App(), "Info", filepath.Base(d.path), contentTXT, true).Update(b.String())
+	if err := d.App().inject(details, false); err != nil {
+		d.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (d *DumpPreview) describeRow(app *App, _ ui.Tabular, _ *client.GVR, path string) {
+	row := d.GetTable().GetSelectedRow(path)
+	if row == nil {
+		return
+	}
+
+	h := d.GetTable().GetModel().Peek().Header()
+	var b strings.Builder
+	for i, f := range row.Fields {
+		if i < len(h) {
+			fmt.Fprintf(&b, "%-20s %s\n", h[i].Name+":", f)
+		}
+	}
+
+	details := NewDetails(app, "Row", filepath.Base(d.path), contentTXT, true).Update(b.String())
+	if err := app.inject(details, false); err != nil {
+		app.Flash().Err(err)
+	}
+}
@@ -17,5 +17,13 @@ func TestScreenDumpNew(t *testing.T) {
 
 	require.NoError(t, po.Init(makeCtx(t)))
 	assert.Equal(t, "ScreenDumps", po.Name())
-	assert.Len(t, po.Hints(), 5)
+	assert.Len(t, po.Hints(), 6)
+}
+
+func TestDumpPreviewNew(t *testing.T) {
+	v := view.NewDumpPreview(client.SdpGVR)
+
+	require.NoError(t, v.Init(makeCtx(t)))
+	assert.Equal(t, "DumpPreview", v.Name())
+	assert.Len(t, v.Hints(), 2)
 }
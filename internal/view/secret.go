@@ -20,7 +20,7 @@ type Secret struct {
 // NewSecret returns a new viewer.
 func NewSecret(gvr *client.GVR) ResourceViewer {
 	s := Secret{
-		ResourceViewer: NewOwnerExtender(NewBrowser(gvr)),
+		ResourceViewer: NewDataKeyExtender(NewOwnerExtender(NewBrowser(gvr))),
 	}
 	s.AddBindKeysFn(s.bindKeys)
 
@@ -0,0 +1,310 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/view/cmd"
+	"github.com/derailed/tcell/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	sessionTitle       = "History"
+	sessionEntryTitle  = "Session"
+	sessionReplayDelay = 2 * time.Second
+)
+
+// Session presents recorded operator sessions.
+type Session struct {
+	ResourceViewer
+}
+
+// NewSession returns a new viewer.
+func NewSession(gvr *client.GVR) ResourceViewer {
+	s := Session{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	s.GetTable().SetBorderFocusColor(tcell.ColorSteelBlue)
+	s.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorRoyalBlue).Attributes(tcell.AttrNone))
+	s.GetTable().SetSortCol(ageCol, true)
+	s.GetTable().SetEnterFn(s.showEntries)
+	s.SetContextFn(s.dirContext)
+	s.AddBindKeysFn(s.bindKeys)
+
+	return &s
+}
+
+// Name returns the component name.
+func (*Session) Name() string { return sessionTitle }
+
+func (s *Session) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlZ, tcell.KeyCtrlW)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyR:      ui.NewKeyAction("Replay", s.replayCmd, true),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Started", s.GetTable().SortColCmd("STARTED", true), false),
+		ui.KeyShiftF: ui.NewKeyAction("Sort Failed", s.GetTable().SortColCmd("FAILED", false), false),
+	})
+}
+
+func (s *Session) dirContext(ctx context.Context) context.Context {
+	dir, err := s.App().Config.ContextSessionsDir()
+	if err != nil {
+		s.App().Flash().Err(err)
+		return ctx
+	}
+	if err := data.EnsureFullPath(dir, data.DefaultDirMod); err != nil {
+		s.App().Flash().Err(err)
+		return ctx
+	}
+
+	return context.WithValue(ctx, internal.KeyDir, dir)
+}
+
+func (*Session) showEntries(app *App, _ ui.Tabular, _ *client.GVR, path string) {
+	if err := app.inject(newSessionEntryFor(path), false); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func (s *Session) replayCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	ee, err := dao.LoadSession(path)
+	if err != nil {
+		s.App().Flash().Err(err)
+		return nil
+	}
+	replaySession(s.App(), sessionReplayCmds(ee))
+
+	return nil
+}
+
+// SessionEntry presents a recorded session interactions.
+type SessionEntry struct {
+	ResourceViewer
+
+	path string
+}
+
+// NewSessionEntry returns a new viewer.
+func NewSessionEntry(gvr *client.GVR) ResourceViewer {
+	s := SessionEntry{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	s.GetTable().SetReadOnly(true)
+	s.GetTable().SetBorderFocusColor(tcell.ColorSteelBlue)
+	s.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorRoyalBlue).Attributes(tcell.AttrNone))
+	s.GetTable().SetSortCol("SEQ", true)
+	s.GetTable().SetEnterFn(s.gotoEntry)
+	s.AddBindKeysFn(s.bindKeys)
+
+	return &s
+}
+
+func newSessionEntryFor(path string) ResourceViewer {
+	v := NewSessionEntry(client.SseGVR)
+	s := v.(*SessionEntry)
+	s.path = path
+	s.GetTable().Extras = filepath.Base(path)
+	s.SetContextFn(func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, internal.KeyPath, path)
+	})
+
+	return s
+}
+
+// Name returns the component name.
+func (*SessionEntry) Name() string { return sessionEntryTitle }
+
+func (s *SessionEntry) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, ui.KeyShiftN, tcell.KeyCtrlZ, tcell.KeyCtrlW, tcell.KeyCtrlD)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyR:      ui.NewKeyAction("Replay From", s.replayCmd, true),
+		ui.KeyShiftK: ui.NewKeyAction("Sort Kind", s.GetTable().SortColCmd("KIND", true), false),
+		ui.KeyShiftR: ui.NewKeyAction("Sort Result", s.GetTable().SortColCmd("RESULT", true), false),
+	})
+}
+
+func (s *SessionEntry) gotoEntry(app *App, _ ui.Tabular, _ *client.GVR, id string) {
+	e, err := s.entryFor(id)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	cmds := sessionReplayCmds([]render.SessionEntryRes{e})
+	if len(cmds) == 0 {
+		app.Flash().Warnf("%s entries can not be replayed", e.Kind)
+		return
+	}
+	app.gotoResource(cmds[0], "", false, true)
+}
+
+func (s *SessionEntry) replayCmd(evt *tcell.EventKey) *tcell.EventKey {
+	id := s.GetTable().GetSelectedItem()
+	if id == "" {
+		return evt
+	}
+	ee, err := dao.LoadSession(s.path)
+	if err != nil {
+		s.App().Flash().Err(err)
+		return nil
+	}
+	for i, e := range ee {
+		if e.ID() == id {
+			replaySession(s.App(), sessionReplayCmds(ee[i:]))
+			break
+		}
+	}
+
+	return nil
+}
+
+func (s *SessionEntry) entryFor(id string) (render.SessionEntryRes, error) {
+	ee, err := dao.LoadSession(s.path)
+	if err != nil {
+		return render.SessionEntryRes{}, err
+	}
+	for _, e := range ee {
+		if e.ID() == id {
+			return e, nil
+		}
+	}
+
+	return render.SessionEntryRes{}, fmt.Errorf("no session entry found for %q", id)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// replaySession re-runs navigation commands one at a time. The replay stops as soon as
+// the operator navigates away from the replayed view.
+func replaySession(app *App, cmds []string) {
+	app.stopReplay()
+	if len(cmds) == 0 {
+		app.Flash().Warn("No navigation commands to replay")
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	app.replayCancelFn = cancel
+	go func() {
+		var top model.Component
+		for i, c := range cmds {
+			if i > 0 {
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(sessionReplayDelay):
+				}
+			}
+			ok := make(chan bool, 1)
+			app.QueueUpdateDraw(func() {
+				if i > 0 && app.Content.Top() != top {
+					app.Flash().Warn("Session replay interrupted")
+					ok <- false
+					return
+				}
+				app.Flash().Infof("Replaying [%d/%d] %s", i+1, len(cmds), c)
+				dao.SessRecorder().Suspend()
+				app.gotoResource(c, "", true, true)
+				dao.SessRecorder().Resume()
+				top = app.Content.Top()
+				ok <- true
+			})
+			select {
+			case <-ctx.Done():
+				return
+			case cont := <-ok:
+				if !cont {
+					return
+				}
+			}
+		}
+	}()
+}
+
+// sessionReplayCmds converts recorded navigation entries to commands. Resource actions
+// are never replayed.
+func sessionReplayCmds(ee []render.SessionEntryRes) []string {
+	cc := make([]string, 0, len(ee))
+	for _, e := range ee {
+		switch e.Kind {
+		case render.SessionCommand:
+			if e.Error == "" && isReplayable(e.Command) {
+				cc = append(cc, e.Command)
+			}
+		case render.SessionFilter:
+			if c, ok := filterCmd(e.Target, e.Command); ok {
+				cc = append(cc, c)
+			}
+		}
+	}
+
+	return cc
+}
+
+// isReplayable checks if a command only navigates. Commands switching context or
+// identity or exiting the app are never replayed.
+func isReplayable(c string) bool {
+	p := cmd.NewInterpreter(c)
+	if p.IsBlank() || p.IsContextCmd() || p.IsImpersonateCmd() || p.IsBailCmd() {
+		return false
+	}
+	_, ok := p.HasContext()
+
+	return !ok
+}
+
+// sessionTarget returns a recorded action target.
+func sessionTarget(gvr *client.GVR, path string) string {
+	return gvr.String() + ":" + path
+}
+
+func deleteCmdLine(propagation *metav1.DeletionPropagation, force bool) string {
+	c := "delete"
+	if propagation != nil {
+		c += " --cascade=" + strings.ToLower(string(*propagation))
+	}
+	if force {
+		c += " --force"
+	}
+
+	return c
+}
+
+func filterCmd(gvr, filter string) (string, bool) {
+	if gvr == "" || filter == "" {
+		return "", false
+	}
+	if f, ok := internal.IsFuzzySelector(filter); ok {
+		return gvr + " -f " + f, true
+	}
+	if internal.IsLabelSelector(filter) {
+		sel := strings.TrimSpace(strings.TrimPrefix(filter, "-l"))
+		if strings.Contains(sel, " ") {
+			return "", false
+		}
+		return gvr + " " + sel, true
+	}
+	if strings.Contains(filter, " ") {
+		return "", false
+	}
+
+	return gvr + " /" + filter, true
+}
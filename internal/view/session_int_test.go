@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSessionReplayCmds(t *testing.T) {
+	ee := []render.SessionEntryRes{
+		{Kind: render.SessionCommand, Command: "pods kube-system"},
+		{Kind: render.SessionFilter, Command: "fred", Target: "v1/pods"},
+		{Kind: render.SessionDelete, Command: "delete", Target: "v1/pods:kube-system/fred"},
+		{Kind: render.SessionCommand, Command: "bozo", Error: errors.New("`bozo` command not found").Error()},
+		{Kind: render.SessionFilter, Command: "-l app=blee", Target: "v1/pods"},
+		{Kind: render.SessionFilter, Command: "-f zorg", Target: "apps/v1/deployments"},
+		{Kind: render.SessionFilter, Command: "a b", Target: "v1/pods"},
+		{Kind: render.SessionExec, Command: "exec -c nginx", Target: "v1/pods:default/p1"},
+		{Kind: render.SessionCommand, Command: "ctx"},
+		{Kind: render.SessionCommand, Command: "ctx fred"},
+		{Kind: render.SessionCommand, Command: "pods @fred"},
+		{Kind: render.SessionCommand, Command: "as fred"},
+		{Kind: render.SessionCommand, Command: "q!"},
+		{Kind: render.SessionCommand, Command: "svc"},
+	}
+
+	assert.Equal(t, []string{
+		"pods kube-system",
+		"v1/pods /fred",
+		"v1/pods app=blee",
+		"apps/v1/deployments -f zorg",
+		"svc",
+	}, sessionReplayCmds(ee))
+}
+
+func TestDeleteCmdLine(t *testing.T) {
+	fg := metav1.DeletePropagationForeground
+	uu := map[string]struct {
+		p     *metav1.DeletionPropagation
+		force bool
+		e     string
+	}{
+		"plain": {
+			e: "delete",
+		},
+		"cascade": {
+			p: &fg,
+			e: "delete --cascade=foreground",
+		},
+		"force": {
+			p:     &fg,
+			force: true,
+			e:     "delete --cascade=foreground --force",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, deleteCmdLine(u.p, u.force))
+		})
+	}
+}
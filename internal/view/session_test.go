@@ -0,0 +1,29 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/view"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionNew(t *testing.T) {
+	v := view.NewSession(client.SesGVR)
+
+	require.NoError(t, v.Init(makeCtx(t)))
+	assert.Equal(t, "History", v.Name())
+	assert.Len(t, v.Hints(), 5)
+}
+
+func TestSessionEntryNew(t *testing.T) {
+	v := view.NewSessionEntry(client.SseGVR)
+
+	require.NoError(t, v.Init(makeCtx(t)))
+	assert.Equal(t, "Session", v.Name())
+	assert.Len(t, v.Hints(), 4)
+}
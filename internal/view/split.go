@@ -0,0 +1,283 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/slogs"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/sahilm/fuzzy"
+)
+
+// splitFollowDelay debounces pane updates while the cursor moves.
+const splitFollowDelay = 300 * time.Millisecond
+
+var splitTitles = map[string]string{
+	config.SplitPaneDescribe: "Describe",
+	config.SplitPaneYAML:     yamlAction,
+	config.SplitPaneEvents:   "Events",
+	config.SplitPaneLogs:     "Logs",
+}
+
+// splitPane shows the selected table row details side by side with the table.
+type splitPane struct {
+	*tview.TextView
+
+	app        *App
+	gvr        *client.GVR
+	setting    *config.SplitSetting
+	path       string
+	ansiWriter io.Writer
+	viewer     model.ResourceViewer
+	logs       *model.Log
+	cancelFn   context.CancelFunc
+	mx         sync.Mutex
+}
+
+func newSplitPane(app *App, gvr *client.GVR) *splitPane {
+	p := splitPane{
+		TextView: tview.NewTextView(),
+		app:      app,
+		gvr:      gvr,
+		setting:  config.NewSplitSetting(),
+	}
+	p.SetBorder(true)
+	p.SetBorderPadding(0, 0, 1, 1)
+	p.SetScrollable(true)
+	p.SetDynamicColors(true)
+	p.SetWrap(false)
+	p.SetMaxLines(app.Config.K9s.Logger.BufferSize)
+	p.SetBackgroundColor(app.Styles.BgColor())
+	p.SetTextColor(app.Styles.FgColor())
+	p.SetBorderColor(app.Styles.Frame().Border.FgColor.Color())
+	p.ansiWriter = tview.ANSIWriter(p.TextView, app.Styles.Views().Log.FgColor.String(), app.Styles.Views().Log.BgColor.String())
+
+	return &p
+}
+
+// IsActive checks if the pane is shown.
+func (p *splitPane) IsActive() bool {
+	return p != nil && p.setting.IsActive()
+}
+
+// layout carves out the pane area and returns the remaining table area.
+func (p *splitPane) layout(x, y, w, h int) (int, int, int, int) {
+	if !p.IsActive() {
+		return x, y, w, h
+	}
+	if p.setting.IsVertical() {
+		ph := h * p.setting.Size / 100
+		p.SetRect(x, y+h-ph, w, ph)
+		return x, y, w, h - ph
+	}
+	pw := w * p.setting.Size / 100
+	p.SetRect(x+w-pw, y, pw, h)
+
+	return x, y, w - pw, h
+}
+
+// apply updates the pane configuration and shows the given resource.
+func (p *splitPane) apply(s *config.SplitSetting, path string) {
+	if p == nil {
+		return
+	}
+	p.setting, p.path = s, path
+	p.restart()
+}
+
+// follow shows the given resource if it differs from the current one.
+func (p *splitPane) follow(path string) {
+	if p == nil || path == p.path {
+		return
+	}
+	p.path = path
+	p.restart()
+}
+
+// stop terminates the pane updates.
+func (p *splitPane) stop() {
+	if p == nil {
+		return
+	}
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	if p.cancelFn != nil {
+		p.cancelFn()
+		p.cancelFn = nil
+	}
+	if p.viewer != nil {
+		p.viewer.RemoveListener(p)
+		p.viewer = nil
+	}
+	if p.logs != nil {
+		p.logs.Stop()
+		p.logs.RemoveListener(p)
+		p.logs = nil
+	}
+}
+
+func (p *splitPane) restart() {
+	p.stop()
+	p.Clear()
+	p.updateTitle()
+	if !p.IsActive() || p.path == "" {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), internal.KeyFactory, p.app.factory))
+	p.mx.Lock()
+	p.cancelFn = cancel
+	p.mx.Unlock()
+	go p.watch(ctx, p.setting.Pane, p.path)
+}
+
+func (p *splitPane) watch(ctx context.Context, pane, path string) {
+	select {
+	case <-ctx.Done():
+		return
+	case <-time.After(splitFollowDelay):
+	}
+
+	if pane == config.SplitPaneLogs {
+		p.watchLogs(ctx, path)
+		return
+	}
+
+	var m model.ResourceViewer
+	switch pane {
+	case config.SplitPaneYAML:
+		m = model.NewYAML(p.gvr, path)
+	case config.SplitPaneEvents:
+		m = model.NewResourceEvents(p.gvr, path)
+	default:
+		m = model.NewDescribe(p.gvr, path)
+	}
+	m.AddListener(p)
+	p.mx.Lock()
+	if ctx.Err() != nil {
+		p.mx.Unlock()
+		return
+	}
+	p.viewer = m
+	p.mx.Unlock()
+
+	if err := m.Watch(ctx); err != nil {
+		slog.Warn("Split pane watch failed", slogs.GVR, p.gvr, slogs.Error, err)
+	}
+}
+
+func (p *splitPane) watchLogs(ctx context.Context, path string) {
+	if !isResourcePath(path) {
+		p.ResourceFailed(fmt.Errorf("no logs available for %q", path))
+		return
+	}
+	cfg := p.app.Config.K9s.Logger
+	l := model.NewLog(p.gvr, &dao.LogOptions{
+		Path:          path,
+		Lines:         cfg.TailCount,
+		SinceSeconds:  cfg.SinceSeconds,
+		AllContainers: true,
+		ShowTimestamp: cfg.ShowTime,
+	}, defaultFlushTimeout)
+	l.Init(p.app.factory)
+	l.AddListener(p)
+	p.mx.Lock()
+	if ctx.Err() != nil {
+		p.mx.Unlock()
+		return
+	}
+	p.logs = l
+	p.mx.Unlock()
+
+	l.Start(ctx)
+}
+
+func (p *splitPane) updateTitle() {
+	styles := p.app.Styles.Frame()
+	p.SetTitle(ui.SkinTitle(fmt.Sprintf(liveViewTitleFmt, splitTitles[p.setting.Pane], p.path), &styles))
+}
+
+// ResourceChanged notifies the pane resource changed.
+func (p *splitPane) ResourceChanged(lines []string, _ fuzzy.Matches) {
+	p.app.QueueUpdateDraw(func() {
+		p.SetText(colorizeYAML(p.app.Styles.Views().Yaml, strings.Join(lines, "\n")))
+	})
+}
+
+// ResourceFailed notifies the pane resource failed to load.
+func (p *splitPane) ResourceFailed(err error) {
+	p.app.QueueUpdateDraw(func() {
+		p.SetText("[red::]" + tview.Escape(err.Error()))
+	})
+}
+
+// LogChanged notifies new log lines are available.
+func (p *splitPane) LogChanged(lines [][]byte) {
+	p.app.QueueUpdateDraw(func() {
+		for _, l := range lines {
+			if _, err := p.ansiWriter.Write(l); err != nil {
+				slog.Error("Split pane log write failed", slogs.Error, err)
+				return
+			}
+		}
+		p.ScrollToEnd()
+	})
+}
+
+// LogCleared indicates logs are cleared.
+func (p *splitPane) LogCleared() {
+	p.app.QueueUpdateDraw(func() {
+		p.Clear()
+	})
+}
+
+// LogFailed indicates a log failure.
+func (p *splitPane) LogFailed(err error) {
+	p.ResourceFailed(err)
+}
+
+// LogStop indicates logging was canceled.
+func (*splitPane) LogStop() {}
+
+// LogResume indicates logging has resumed.
+func (*splitPane) LogResume() {}
+
+// LogCanceled indicates no more logs will come.
+func (p *splitPane) LogCanceled() {
+	p.LogChanged([][]byte{[]byte("\n🏁 [red::b]Stream exited! No more logs...")})
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// nextSplitPane cycles thru the available panes and eventually closes the split.
+func nextSplitPane(pane string, pp []string) string {
+	if pane == "" {
+		if len(pp) == 0 {
+			return ""
+		}
+		return pp[0]
+	}
+	idx := slices.Index(pp, pane)
+	if idx < 0 || idx+1 >= len(pp) {
+		return ""
+	}
+
+	return pp[idx+1]
+}
@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/config/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNextSplitPane(t *testing.T) {
+	pp := []string{config.SplitPaneDescribe, config.SplitPaneYAML, config.SplitPaneEvents}
+	uu := map[string]struct {
+		pane string
+		pp   []string
+		e    string
+	}{
+		"open": {
+			pp: pp,
+			e:  config.SplitPaneDescribe,
+		},
+		"next": {
+			pane: config.SplitPaneYAML,
+			pp:   pp,
+			e:    config.SplitPaneEvents,
+		},
+		"close": {
+			pane: config.SplitPaneEvents,
+			pp:   pp,
+		},
+		"unavailable": {
+			pane: config.SplitPaneLogs,
+			pp:   pp,
+		},
+		"none": {},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, nextSplitPane(u.pane, u.pp))
+		})
+	}
+}
+
+func TestSplitPaneLayout(t *testing.T) {
+	uu := map[string]struct {
+		s      config.SplitSetting
+		table  [4]int
+		pane   [4]int
+		active bool
+	}{
+		"inactive": {
+			s:     config.SplitSetting{Orientation: config.SplitHorizontal, Size: 50},
+			table: [4]int{0, 1, 100, 40},
+		},
+		"horizontal": {
+			s:      config.SplitSetting{Pane: config.SplitPaneYAML, Orientation: config.SplitHorizontal, Size: 40},
+			table:  [4]int{0, 1, 60, 40},
+			pane:   [4]int{60, 1, 40, 40},
+			active: true,
+		},
+		"vertical": {
+			s:      config.SplitSetting{Pane: config.SplitPaneEvents, Orientation: config.SplitVertical, Size: 25},
+			table:  [4]int{0, 1, 100, 30},
+			pane:   [4]int{0, 31, 100, 10},
+			active: true,
+		},
+	}
+
+	p := newSplitPane(NewApp(mock.NewMockConfig(t)), client.PodGVR)
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			p.setting = &u.s
+			x, y, w, h := p.layout(0, 1, 100, 40)
+			assert.Equal(t, u.table, [4]int{x, y, w, h})
+			assert.Equal(t, u.active, p.IsActive())
+			if u.active {
+				x, y, w, h = p.GetRect()
+				assert.Equal(t, u.pane, [4]int{x, y, w, h})
+			}
+		})
+	}
+}
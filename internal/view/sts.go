@@ -26,7 +26,9 @@ func NewStatefulSet(gvr *client.GVR) ResourceViewer {
 				NewScaleExtender(
 					NewImageExtender(
 						NewOwnerExtender(
-							NewLogsExtender(NewBrowser(gvr), s.logOptions),
+							NewTimelineExtender(
+								NewLogsExtender(NewBrowser(gvr), s.logOptions),
+							),
 						),
 					),
 				),
@@ -135,6 +135,41 @@ func init() {
 		Verbs:        []string{"get", "list", "watch", "delete"},
 		Categories:   []string{"k9s"},
 	})
+	dao.MetaAccess.RegisterMeta(client.SdpGVR.String(), &metav1.APIResource{
+		Name:         "dumppreviews",
+		SingularName: "dumppreview",
+		Kind:         "DumpPreviews",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	})
+	dao.MetaAccess.RegisterMeta(client.SesGVR.String(), &metav1.APIResource{
+		Name:         "sessions",
+		SingularName: "session",
+		Kind:         "Session",
+		Verbs:        []string{"delete"},
+		Categories:   []string{"k9s"},
+	})
+	dao.MetaAccess.RegisterMeta(client.SseGVR.String(), &metav1.APIResource{
+		Name:         "session-entries",
+		SingularName: "session-entry",
+		Kind:         "SessionEntry",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	})
+	dao.MetaAccess.RegisterMeta(client.PinGVR.String(), &metav1.APIResource{
+		Name:         "pins",
+		SingularName: "pin",
+		Kind:         "Pin",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	})
+	dao.MetaAccess.RegisterMeta(client.AlGVR.String(), &metav1.APIResource{
+		Name:         "alerts",
+		SingularName: "alert",
+		Kind:         "Alert",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	})
 	dao.MetaAccess.RegisterMeta(client.StsGVR.String(), &metav1.APIResource{
 		Name:         "statefulsets",
 		SingularName: "statefulset",
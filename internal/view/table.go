@@ -12,10 +12,13 @@ import (
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/model1"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/slogs"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/derailed/k9s/internal/view/cmd"
 	"github.com/derailed/tcell/v2"
 )
@@ -189,13 +192,43 @@ func (t *Table) BufferActive(state bool, k model.BufferKind) {
 }
 
 func (t *Table) saveCmd(*tcell.EventKey) *tcell.EventKey {
-	if path, err := saveTable(t.app.Config.K9s.ContextScreenDumpDir(), t.GVR().R(), t.Path, t.GetFilteredData()); err != nil {
+	t.save(t.app.Config.K9s.DumpFormat())
+
+	return nil
+}
+
+func (t *Table) saveAsCmd(*tcell.EventKey) *tcell.EventKey {
+	styles := t.app.Styles.Dialog()
+	dialog.ShowSelection(&styles, t.app.Content.Pages, "Save As", config.DumpFormats, func(index int) {
+		if index >= 0 && index < len(config.DumpFormats) {
+			t.save(config.DumpFormats[index])
+		}
+	})
+
+	return nil
+}
+
+func (t *Table) save(format string) {
+	if path, err := saveTable(t.app.Config.K9s.ContextScreenDumpDir(), t.GVR().R(), t.Path, format, t.dumpMeta(), t.GetFilteredData()); err != nil {
 		t.app.Flash().Err(err)
 	} else {
 		t.app.Flash().Infof("File saved successfully: %q", render.Truncate(filepath.Base(path), 50))
 	}
+}
 
-	return nil
+func (t *Table) dumpMeta() model1.DumpMeta {
+	filter := t.CmdBuff().GetText()
+	if sel := t.GetModel().GetLabelSelector(); filter == "" && sel != nil && !sel.Empty() {
+		filter = "-l " + sel.String()
+	}
+
+	return model1.DumpMeta{
+		Resource:  t.GVR().String(),
+		Context:   t.app.Config.K9s.ActiveContextName(),
+		Namespace: t.GetModel().GetNamespace(),
+		Filter:    filter,
+		Timestamp: time.Now().UTC().Format(time.RFC3339),
+	}
 }
 
 func (t *Table) bindKeys() {
@@ -205,6 +238,7 @@ func (t *Table) bindKeys() {
 		tcell.KeyCtrlSpace:     ui.NewSharedKeyAction("Mark Range", t.markSpanCmd, false),
 		tcell.KeyCtrlBackslash: ui.NewSharedKeyAction("Marks Clear", t.clearMarksCmd, false),
 		tcell.KeyCtrlS:         ui.NewSharedKeyAction("Save", t.saveCmd, false),
+		tcell.KeyCtrlO:         ui.NewSharedKeyAction("Save As", t.saveAsCmd, false),
 		ui.KeySlash:            ui.NewSharedKeyAction("Filter Mode", t.activateCmd, false),
 		tcell.KeyCtrlZ:         ui.NewKeyAction("Toggle Faults", t.toggleFaultCmd, false),
 		tcell.KeyCtrlW:         ui.NewKeyAction("Toggle Wide", t.toggleWideCmd, false),
@@ -4,7 +4,6 @@
 package view
 
 import (
-	"encoding/csv"
 	"fmt"
 	"log/slog"
 	"os"
@@ -19,7 +18,7 @@ import (
 	"github.com/derailed/k9s/internal/ui"
 )
 
-func computeFilename(dumpPath, ns, title, path string) (string, error) {
+func computeFilename(dumpPath, ns, title, path, ext string) (string, error) {
 	now := time.Now().UnixNano()
 
 	dir := dumpPath
@@ -34,21 +33,21 @@ func computeFilename(dumpPath, ns, title, path string) (string, error) {
 
 	var fName string
 	if ns == client.ClusterScope {
-		fName = fmt.Sprintf(ui.NoNSFmat, name, now)
+		fName = fmt.Sprintf(ui.NoNSFmat, name, now, ext)
 	} else {
-		fName = fmt.Sprintf(ui.FullFmat, name, ns, now)
+		fName = fmt.Sprintf(ui.FullFmat, name, ns, now, ext)
 	}
 
 	return strings.ToLower(filepath.Join(dir, fName)), nil
 }
 
-func saveTable(dir, title, path string, mdata *model1.TableData) (string, error) {
+func saveTable(dir, title, path, format string, meta model1.DumpMeta, mdata *model1.TableData) (string, error) {
 	ns := mdata.GetNamespace()
 	if client.IsClusterWide(ns) {
 		ns = client.NamespaceAll
 	}
 
-	fPath, err := computeFilename(dir, ns, title, path)
+	fPath, err := computeFilename(dir, ns, title, path, format)
 	if err != nil {
 		return "", err
 	}
@@ -68,17 +67,32 @@ func saveTable(dir, title, path string, mdata *model1.TableData) (string, error)
 		}
 	}()
 
-	w := csv.NewWriter(out)
-	_ = w.Write(mdata.ColumnNames(true))
-
-	mdata.RowsRange(func(_ int, re model1.RowEvent) bool {
-		_ = w.Write(re.Row.Fields)
-		return true
-	})
-	w.Flush()
-	if err := w.Error(); err != nil {
+	d := model1.NewScreenDump(meta, mdata)
+	if err := d.Write(out, format); err != nil {
 		return "", err
 	}
+	if !model1.HasInlineMeta(format) {
+		if err := saveDumpMeta(model1.DumpMetaPath(fPath), d); err != nil {
+			return "", err
+		}
+	}
 
 	return fPath, nil
 }
+
+func saveDumpMeta(path string, d *model1.ScreenDump) error {
+	out, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := out.Close(); err != nil {
+			slog.Error("Closing file failed",
+				slogs.Path, path,
+				slogs.Error, err,
+			)
+		}
+	}()
+
+	return d.WriteMeta(out)
+}
@@ -38,8 +38,9 @@ func TestTableSave(t *testing.T) {
 	c1, _ := os.ReadDir(dir)
 	v.saveCmd(nil)
 
+	// CSV dumps come with a metadata sidecar.
 	c2, _ := os.ReadDir(dir)
-	assert.Len(t, c2, len(c1)+1)
+	assert.Len(t, c2, len(c1)+2)
 }
 
 func TestTableNew(t *testing.T) {
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// Timeline represents an object lifecycle timeline view.
+type Timeline struct {
+	ResourceViewer
+}
+
+// NewTimeline returns a new timeline view.
+func NewTimeline(gvr *client.GVR) ResourceViewer {
+	t := Timeline{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	t.GetTable().SetSortCol("TIME", true)
+	t.GetTable().SetEnterFn(t.gotoResource)
+	t.AddBindKeysFn(t.bindKeys)
+
+	return &t
+}
+
+// Init initializes the view.
+func (t *Timeline) Init(ctx context.Context) error {
+	if err := t.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	t.GetTable().GetModel().SetNamespace(client.BlankNamespace)
+
+	return nil
+}
+
+func (t *Timeline) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace, tcell.KeyCtrlD, ui.KeyE)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyShiftT: ui.NewKeyAction("Sort Type", t.GetTable().SortColCmd("TYPE", true), false),
+		ui.KeyShiftK: ui.NewKeyAction("Sort Kind", t.GetTable().SortColCmd("KIND", true), false),
+		ui.KeyShiftR: ui.NewKeyAction("Sort Reason", t.GetTable().SortColCmd("REASON", true), false),
+	})
+}
+
+func (*Timeline) gotoResource(app *App, _ ui.Tabular, _ *client.GVR, id string) {
+	gvr, path := render.TimelineResource(id)
+	if gvr == "" {
+		return
+	}
+	app.gotoResource(gvr, path, false, true)
+}
+
+// TimelineExtender adds timeline actions to a given viewer.
+type TimelineExtender struct {
+	ResourceViewer
+}
+
+// NewTimelineExtender returns a new extender.
+func NewTimelineExtender(r ResourceViewer) ResourceViewer {
+	v := &TimelineExtender{ResourceViewer: r}
+	v.AddBindKeysFn(v.bindKeys)
+
+	return v
+}
+
+func (v *TimelineExtender) bindKeys(aa *ui.KeyActions) {
+	aa.Add(ui.KeyShiftE, ui.NewKeyAction("Timeline", v.timelineCmd, true))
+}
+
+func (v *TimelineExtender) timelineCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := v.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	gvr := v.GVR()
+	t := NewTimeline(client.TlGVR)
+	t.SetContextFn(func(ctx context.Context) context.Context {
+		ctx = context.WithValue(ctx, internal.KeyPath, path)
+		return context.WithValue(ctx, internal.KeyGVR, gvr)
+	})
+	if err := v.App().inject(t, false); err != nil {
+		v.App().Flash().Err(err)
+	}
+
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/view/cmd"
+	"github.com/derailed/k9s/internal/watch"
 )
 
 const (
@@ -44,6 +45,29 @@ type (
 	BindKeysFunc func(*ui.KeyActions)
 )
 
+// Factory represents the app resource cache, either live informers or a snapshot.
+type Factory interface {
+	dao.Factory
+
+	// Start initializes the cache for a given namespace.
+	Start(ns string)
+
+	// Terminate stops the cache and all port forwards.
+	Terminate()
+
+	// SetActiveNS sets the active namespace.
+	SetActiveNS(ns string) error
+
+	// AddForwarder registers a new port forward.
+	AddForwarder(watch.Forwarder)
+
+	// ForwarderFor returns a port forward for a given container if any.
+	ForwarderFor(path string) (watch.Forwarder, bool)
+
+	// ValidatePortForwards checks if port forwarded pods are still around.
+	ValidatePortForwards()
+}
+
 // ActionExtender enhances a given viewer by adding new menu actions.
 type ActionExtender interface {
 	// BindKeys injects new menu actions.
@@ -0,0 +1,314 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/view/cmd"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+const (
+	tabCloseCmd  = "close"
+	tabRenameCmd = "rename"
+)
+
+// Workspace represents a tab with its own navigation state.
+type Workspace struct {
+	name       string
+	context    string
+	namespace  string
+	content    *PageStack
+	cmdHistory *model.History
+}
+
+// NewWorkspace returns a new workspace.
+func NewWorkspace(name string) *Workspace {
+	return &Workspace{
+		name:       name,
+		content:    NewPageStack(),
+		cmdHistory: model.NewHistory(model.MaxHistory),
+	}
+}
+
+// Workspaces tracks the session tabs.
+type Workspaces struct {
+	*tview.Pages
+
+	tabs   []*Workspace
+	active int
+}
+
+// NewWorkspaces returns a new tabs tracker.
+func NewWorkspaces() *Workspaces {
+	return &Workspaces{
+		Pages: tview.NewPages(),
+	}
+}
+
+// Add appends a new workspace and returns its index.
+func (w *Workspaces) Add(ws *Workspace) int {
+	w.tabs = append(w.tabs, ws)
+	w.AddPage(w.pageID(ws), ws.content, true, len(w.tabs) == 1)
+
+	return len(w.tabs) - 1
+}
+
+// Remove deletes the workspace at the given index.
+func (w *Workspaces) Remove(i int) {
+	if i < 0 || i >= len(w.tabs) {
+		return
+	}
+	w.RemovePage(w.pageID(w.tabs[i]))
+	w.tabs = append(w.tabs[:i], w.tabs[i+1:]...)
+	if i < w.active || w.active >= len(w.tabs) {
+		w.active--
+	}
+}
+
+// ResetInactive drops the page stacks of all but the active workspace so
+// they get rebuilt once reactivated.
+func (w *Workspaces) ResetInactive(ctx context.Context) error {
+	for i, ws := range w.tabs {
+		if i == w.active {
+			continue
+		}
+		content := NewPageStack()
+		if err := content.Init(ctx); err != nil {
+			return err
+		}
+		w.RemovePage(w.pageID(ws))
+		ws.content = content
+		w.AddPage(w.pageID(ws), ws.content, true, false)
+	}
+
+	return nil
+}
+
+// Activate brings the workspace at the given index to front.
+func (w *Workspaces) Activate(i int) {
+	if i < 0 || i >= len(w.tabs) {
+		return
+	}
+	w.active = i
+	w.SwitchToPage(w.pageID(w.tabs[i]))
+}
+
+// Active returns the current workspace.
+func (w *Workspaces) Active() *Workspace {
+	return w.tabs[w.active]
+}
+
+// ActiveIndex returns the current workspace index.
+func (w *Workspaces) ActiveIndex() int {
+	return w.active
+}
+
+// Len returns the number of workspaces.
+func (w *Workspaces) Len() int {
+	return len(w.tabs)
+}
+
+// Names returns the workspaces titles.
+func (w *Workspaces) Names() []string {
+	nn := make([]string, 0, len(w.tabs))
+	for _, ws := range w.tabs {
+		nn = append(nn, ws.name)
+	}
+
+	return nn
+}
+
+// Find locates a workspace by its 1-based position or its name.
+func (w *Workspaces) Find(s string) (int, bool) {
+	if n, err := strconv.Atoi(s); err == nil {
+		return n - 1, n > 0 && n <= len(w.tabs)
+	}
+	for i, ws := range w.tabs {
+		if ws.name != "" && ws.name == s {
+			return i, true
+		}
+	}
+
+	return -1, false
+}
+
+// Next returns the index of the workspace after the current one.
+func (w *Workspaces) Next() int {
+	return (w.active + 1) % len(w.tabs)
+}
+
+// Prev returns the index of the workspace before the current one.
+func (w *Workspaces) Prev() int {
+	return (w.active - 1 + len(w.tabs)) % len(w.tabs)
+}
+
+func (*Workspaces) pageID(ws *Workspace) string {
+	return fmt.Sprintf("tab-%p", ws)
+}
+
+// ----------------------------------------------------------------------------
+// App tabs management...
+
+func (a *App) tabCmd(p *cmd.Interpreter) error {
+	topic, arg, _ := p.TabArgs()
+	ctx, _ := p.HasContext()
+	switch topic {
+	case "":
+		return a.newTab("", ctx)
+	case tabCloseCmd:
+		return a.closeTab()
+	case tabRenameCmd:
+		if arg == "" {
+			return fmt.Errorf("invalid command. Use `tab rename xxx`")
+		}
+		a.tabs.Active().name = arg
+		a.refreshTabs()
+		return nil
+	}
+
+	if i, ok := a.tabs.Find(topic); ok {
+		return a.switchTab(i)
+	}
+	if _, err := strconv.Atoi(topic); err == nil {
+		return fmt.Errorf("no tab #%s", topic)
+	}
+
+	return a.newTab(topic, ctx)
+}
+
+func (a *App) newTab(name, ctx string) error {
+	ws := NewWorkspace(name)
+	if err := ws.content.Init(context.WithValue(context.Background(), internal.KeyApp, a)); err != nil {
+		return err
+	}
+	ws.context, ws.namespace = a.Config.ActiveContextName(), a.Config.ActiveNamespace()
+	if ctx != "" && ctx != ws.context {
+		ws.context, ws.namespace = ctx, ""
+	}
+
+	return a.switchTab(a.tabs.Add(ws))
+}
+
+func (a *App) closeTab() error {
+	if a.tabs.Len() < 2 {
+		return fmt.Errorf("unable to close the last tab")
+	}
+	i := a.tabs.ActiveIndex()
+	next := a.tabs.Prev()
+	if i == 0 {
+		next = a.tabs.Next()
+	}
+	if err := a.switchTab(next); err != nil {
+		return err
+	}
+	a.tabs.Remove(i)
+	a.refreshTabs()
+
+	return nil
+}
+
+// switchTab suspends the current workspace and resumes the given one. Tabs on
+// another context switch the session context and rebuild their view.
+func (a *App) switchTab(i int) error {
+	if i == a.tabs.ActiveIndex() {
+		return nil
+	}
+
+	cur := a.tabs.Active()
+	cur.context, cur.namespace = a.Config.ActiveContextName(), a.Config.ActiveNamespace()
+	if top := a.Content.Top(); top != nil {
+		top.Stop()
+	}
+	a.Content.RemoveListener(a.Crumbs())
+	a.Content.RemoveListener(a.Menu())
+
+	a.tabs.Activate(i)
+	ws := a.tabs.Active()
+	a.Content, a.cmdHistory = ws.content, ws.cmdHistory
+	a.Content.AddListener(a.Crumbs())
+	a.Content.AddListener(a.Menu())
+	a.Crumbs().Reset(a.Content.Peek())
+	a.refreshTabs()
+
+	if ws.context != "" && ws.context != a.Config.ActiveContextName() {
+		return a.useTabContext(ws)
+	}
+	if err := a.switchNS(ws.namespace); err != nil {
+		return err
+	}
+	top := a.Content.Top()
+	if top == nil {
+		a.gotoResource(a.Config.ActiveView(), "", true, true)
+		return nil
+	}
+	a.Menu().StackTop(top)
+	a.Content.StackTop(top)
+
+	return nil
+}
+
+func (a *App) useTabContext(ws *Workspace) error {
+	if err := useContext(a, ws.context); err != nil {
+		return err
+	}
+	if ws.namespace == "" || ws.namespace == a.Config.ActiveNamespace() {
+		return nil
+	}
+	if err := a.switchNS(ws.namespace); err != nil {
+		return err
+	}
+	a.gotoResource(a.Config.ActiveView(), "", true, true)
+
+	return nil
+}
+
+func (a *App) refreshTabs() {
+	a.Crumbs().SetTabs(a.tabs.Names(), a.tabs.ActiveIndex())
+}
+
+func (a *App) newTabCmd(*tcell.EventKey) *tcell.EventKey {
+	if err := a.newTab("", ""); err != nil {
+		a.Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (a *App) prevTabCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if evt != nil && evt.Rune() == rune(ui.KeyLeftBrace) && a.Prompt().InCmdMode() {
+		return evt
+	}
+	if a.tabs.Len() < 2 {
+		a.Flash().Warn("No other tabs to switch to")
+		return evt
+	}
+	if err := a.switchTab(a.tabs.Prev()); err != nil {
+		a.Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (a *App) nextTabCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if evt != nil && evt.Rune() == rune(ui.KeyRightBrace) && a.Prompt().InCmdMode() {
+		return evt
+	}
+	if a.tabs.Len() < 2 {
+		a.Flash().Warn("No other tabs to switch to")
+		return evt
+	}
+	if err := a.switchTab(a.tabs.Next()); err != nil {
+		a.Flash().Err(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/view"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkspaces(t *testing.T) {
+	w := view.NewWorkspaces()
+	assert.Equal(t, 0, w.Add(view.NewWorkspace("")))
+	assert.Equal(t, 1, w.Add(view.NewWorkspace("logs")))
+	assert.Equal(t, 2, w.Add(view.NewWorkspace("events")))
+	assert.Equal(t, []string{"", "logs", "events"}, w.Names())
+	assert.Equal(t, 0, w.ActiveIndex())
+	assert.Equal(t, 2, w.Prev())
+	assert.Equal(t, 1, w.Next())
+
+	w.Activate(2)
+	assert.Equal(t, 2, w.ActiveIndex())
+	assert.Equal(t, 0, w.Next())
+
+	w.Remove(1)
+	assert.Equal(t, 2, w.Len())
+	assert.Equal(t, 1, w.ActiveIndex())
+	assert.Equal(t, []string{"", "events"}, w.Names())
+}
+
+func TestWorkspacesFind(t *testing.T) {
+	w := view.NewWorkspaces()
+	w.Add(view.NewWorkspace(""))
+	w.Add(view.NewWorkspace("logs"))
+
+	uu := map[string]struct {
+		s   string
+		idx int
+		ok  bool
+	}{
+		"index": {
+			s:   "2",
+			idx: 1,
+			ok:  true,
+		},
+		"name": {
+			s:   "logs",
+			idx: 1,
+			ok:  true,
+		},
+		"out-of-range": {
+			s: "3",
+		},
+		"zero": {
+			s: "0",
+		},
+		"unnamed": {
+			s: "",
+		},
+		"toast": {
+			s: "pods",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			idx, ok := w.Find(u.s)
+			assert.Equal(t, u.ok, ok)
+			if u.ok {
+				assert.Equal(t, u.idx, idx)
+			}
+		})
+	}
+}
@@ -119,7 +119,9 @@ func (x *Xray) ExtraHints() map[string]string {
 }
 
 // SetInstance sets specific resource instance.
-func (*Xray) SetInstance(string) {}
+func (x *Xray) SetInstance(path string) {
+	x.model.SetInstance(path)
+}
 
 func (x *Xray) bindKeys() {
 	x.Actions().Bulk(ui.KeyMap{
@@ -0,0 +1,166 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package vul
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	cdx "github.com/CycloneDX/cyclonedx-go"
+	"github.com/owenrumney/go-sarif/sarif"
+)
+
+const (
+	// CycloneDXFormat tracks CycloneDX reports.
+	CycloneDXFormat = "cyclonedx"
+
+	// SARIFFormat tracks SARIF reports.
+	SARIFFormat = "sarif"
+
+	reportToolName = "k9s"
+	reportToolURI  = "https://k9scli.io"
+	cveGovURL      = "https://nvd.nist.gov/vuln/detail/"
+	ghsaURL        = "https://github.com/advisories/"
+)
+
+// Export writes out the scan report in the given format.
+func (s *Scan) Export(w io.Writer, format string) error {
+	switch format {
+	case CycloneDXFormat:
+		return s.CycloneDX(w)
+	case SARIFFormat:
+		return s.SARIF(w)
+	default:
+		return fmt.Errorf("unsupported report format %q", format)
+	}
+}
+
+// CycloneDX writes out the image SBOM along with its vulnerabilities as a CycloneDX document.
+func (s *Scan) CycloneDX(w io.Writer) error {
+	bom := cdx.NewBOM()
+	bom.Metadata = &cdx.Metadata{
+		Component: &cdx.Component{
+			BOMRef: s.ID,
+			Type:   cdx.ComponentTypeContainer,
+			Name:   s.ID,
+		},
+	}
+
+	refs := make(map[string]string, len(s.Packages))
+	cc := make([]cdx.Component, 0, len(s.Packages))
+	for _, p := range s.Packages {
+		key := pkgRef(p.Name, p.Version, "")
+		if _, ok := refs[key]; ok {
+			continue
+		}
+		refs[key] = pkgRef(p.Name, p.Version, p.PURL)
+		cc = append(cc, cdx.Component{
+			BOMRef:     refs[key],
+			Type:       cdx.ComponentTypeLibrary,
+			Name:       p.Name,
+			Version:    p.Version,
+			PackageURL: p.PURL,
+		})
+	}
+	bom.Components = &cc
+
+	vv := make([]cdx.Vulnerability, 0, len(s.Table.Rows))
+	for _, r := range s.Table.Rows {
+		key := pkgRef(r.Name(), r.Version(), "")
+		ref, ok := refs[key]
+		if !ok {
+			ref = key
+		}
+		v := cdx.Vulnerability{
+			ID:          r.Vulnerability(),
+			Description: s.Description(r.Vulnerability()),
+			Ratings:     &[]cdx.VulnerabilityRating{{Severity: toCDXSeverity(r.Severity())}},
+			Affects:     &[]cdx.Affects{{Ref: ref}},
+		}
+		if fix := r.Fix(); fix != naValue && fix != wontFix {
+			v.Recommendation = "Upgrade " + r.Name() + " to " + fix
+		}
+		vv = append(vv, v)
+	}
+	bom.Vulnerabilities = &vv
+
+	return cdx.NewBOMEncoder(w, cdx.BOMFileFormatJSON).SetPretty(true).Encode(bom)
+}
+
+// SARIF writes out the image vulnerabilities as a SARIF report.
+func (s *Scan) SARIF(w io.Writer) error {
+	report, err := sarif.New(sarif.Version210)
+	if err != nil {
+		return err
+	}
+	run := sarif.NewRun(reportToolName, reportToolURI)
+	run.AddDistinctArtifact(s.ID)
+	for _, r := range s.Table.Rows {
+		rule := run.AddRule(r.Vulnerability()).WithHelpURI(CVEURL(r.Vulnerability()))
+		if desc := s.Description(r.Vulnerability()); desc != "" {
+			rule.WithDescription(desc)
+		}
+		msg := fmt.Sprintf("%s %s %s is vulnerable to %s", r.Type(), r.Name(), r.Version(), r.Vulnerability())
+		if fix := r.Fix(); fix != naValue {
+			msg += fmt.Sprintf(" (fixed-in: %s)", fix)
+		}
+		loc := sarif.NewPhysicalLocation().WithArtifactLocation(sarif.NewSimpleArtifactLocation(s.ID))
+		run.AddResult(r.Vulnerability()).
+			WithLevel(toSARIFLevel(r.Severity())).
+			WithMessage(sarif.NewTextMessage(msg)).
+			WithLocation(sarif.NewLocationWithPhysicalLocation(loc))
+	}
+	report.AddRun(run)
+
+	return report.PrettyWrite(w)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func pkgRef(name, version, purl string) string {
+	if purl != "" {
+		return purl
+	}
+
+	return name + "@" + version
+}
+
+// CVEURL returns the advisory url for a given vulnerability id.
+func CVEURL(id string) string {
+	if strings.HasPrefix(id, "GHSA") {
+		return ghsaURL + id
+	}
+
+	return cveGovURL + id
+}
+
+func toCDXSeverity(sev string) cdx.Severity {
+	switch sev {
+	case Sev1:
+		return cdx.SeverityCritical
+	case Sev2:
+		return cdx.SeverityHigh
+	case Sev3:
+		return cdx.SeverityMedium
+	case Sev4:
+		return cdx.SeverityLow
+	case Sev5:
+		return cdx.SeverityInfo
+	default:
+		return cdx.SeverityUnknown
+	}
+}
+
+func toSARIFLevel(sev string) string {
+	switch sev {
+	case Sev1, Sev2:
+		return "error"
+	case Sev3:
+		return "warning"
+	default:
+		return "note"
+	}
+}
@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package vul
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScanExport(t *testing.T) {
+	uu := map[string]struct {
+		format string
+		ee     []string
+		err    string
+	}{
+		"cyclonedx": {
+			format: CycloneDXFormat,
+			ee: []string{
+				`"bomFormat": "CycloneDX"`,
+				`"ref": "pkg:golang/golang.org/x/net@v0.1.0"`,
+				`"severity": "critical"`,
+				`"recommendation": "Upgrade golang.org/x/net to 0.17.0"`,
+				`"description": "HTTP/2 rapid reset"`,
+			},
+		},
+		"sarif": {
+			format: SARIFFormat,
+			ee: []string{
+				`"name": "k9s"`,
+				`"ruleId": "CVE-2023-44487"`,
+				`"level": "error"`,
+				`"helpUri": "https://github.com/advisories/GHSA-1234"`,
+				`"level": "note"`,
+			},
+		},
+		"unknown": {
+			format: "blee",
+			err:    `unsupported report format "blee"`,
+		},
+	}
+
+	sc := makeScan()
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var buff bytes.Buffer
+			err := sc.Export(&buff, u.format)
+			if u.err != "" {
+				require.EqualError(t, err, u.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, json.Valid(buff.Bytes()))
+			for _, e := range u.ee {
+				assert.Contains(t, buff.String(), e)
+			}
+		})
+	}
+}
+
+func TestCVEURL(t *testing.T) {
+	assert.Equal(t, "https://nvd.nist.gov/vuln/detail/CVE-1", CVEURL("CVE-1"))
+	assert.Equal(t, "https://github.com/advisories/GHSA-1", CVEURL("GHSA-1"))
+}
+
+// Helpers...
+
+func makeScan() *Scan {
+	sc := newScan("fred:1.0")
+	sc.Packages = []Package{
+		{Name: "golang.org/x/net", Version: "v0.1.0", Type: "go-module", PURL: "pkg:golang/golang.org/x/net@v0.1.0"},
+		{Name: "zlib", Version: "1.2.13", Type: "apk"},
+	}
+	sc.Table.addRow(newRow("golang.org/x/net", "v0.1.0", "0.17.0", "go-module", "CVE-2023-44487", "Critical"))
+	sc.Table.addRow(newRow("zlib", "1.2.13", "", "apk", "GHSA-1234", "Low"))
+	sc.Descriptions["CVE-2023-44487"] = "HTTP/2 rapid reset"
+
+	return sc
+}
@@ -6,9 +6,11 @@ package vul
 import (
 	"fmt"
 	"io"
+	"sort"
 	"strings"
 
 	"github.com/anchore/grype/grype/match"
+	"github.com/anchore/grype/grype/pkg"
 	"github.com/anchore/grype/grype/vulnerability"
 )
 
@@ -34,15 +36,52 @@ func (s Scans) Dump(w io.Writer) error {
 	return nil
 }
 
+// Package tracks an image cataloged package.
+type Package struct {
+	Name    string
+	Version string
+	Type    string
+	PURL    string
+}
+
 // Scan tracks image vulnerability scan.
 type Scan struct {
-	ID    string
-	Table *table
-	Tally tally
+	ID           string
+	Table        *table
+	Tally        tally
+	Packages     []Package
+	Descriptions map[string]string
 }
 
 func newScan(img string) *Scan {
-	return &Scan{ID: img, Table: newTable()}
+	return &Scan{
+		ID:           img,
+		Table:        newTable(),
+		Descriptions: make(map[string]string),
+	}
+}
+
+// Description returns a vulnerability description if known.
+func (s *Scan) Description(vul string) string {
+	return s.Descriptions[vul]
+}
+
+func (s *Scan) catalog(pp []pkg.Package) {
+	s.Packages = make([]Package, 0, len(pp))
+	for _, p := range pp {
+		s.Packages = append(s.Packages, Package{
+			Name:    p.Name,
+			Version: p.Version,
+			Type:    string(p.Type),
+			PURL:    p.PURL,
+		})
+	}
+	sort.Slice(s.Packages, func(i, j int) bool {
+		if s.Packages[i].Name != s.Packages[j].Name {
+			return s.Packages[i].Name < s.Packages[j].Name
+		}
+		return s.Packages[i].Version < s.Packages[j].Version
+	})
 }
 
 // Dump dump report to stdout.
@@ -59,6 +98,7 @@ func (s *Scan) run(mm *match.Matches, store vulnerability.MetadataProvider) erro
 		var severity string
 		if meta != nil {
 			severity = meta.Severity
+			s.Descriptions[m.Vulnerability.ID] = meta.Description
 		}
 		fixVersion := strings.Join(m.Vulnerability.Fix.Versions, ", ")
 		switch m.Vulnerability.Fix.State {
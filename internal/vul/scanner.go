@@ -88,6 +88,12 @@ func (s *imageScanner) Init(name, version string) {
 	id := clio.Identification{Name: name, Version: version}
 	s.opts = options.DefaultGrype(id)
 	s.opts.GenerateMissingCPEs = true
+	if s.config.DBDir != "" {
+		s.opts.DB.Dir = s.config.DBDir
+		s.opts.DB.AutoUpdate = false
+		s.opts.DB.ValidateAge = false
+		s.opts.DB.RequireUpdateCheck = false
+	}
 
 	var err error
 	s.provider, s.status, err = grype.LoadVulnerabilityDB(
@@ -179,6 +185,7 @@ func (s *imageScanner) scan(_ context.Context, img string, sc *Scan) error {
 	if err != nil {
 		errs = errors.Join(errs, fmt.Errorf("failed to catalog %s: %w", img, err))
 	}
+	sc.catalog(packages)
 
 	v := grype.VulnerabilityMatcher{
 		VulnerabilityProvider: s.provider,
@@ -0,0 +1,133 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render/helm"
+	"helm.sh/helm/v3/pkg/release"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// HelmRelease represents an xray renderer.
+type HelmRelease struct{}
+
+// Render renders an xray node.
+func (h *HelmRelease) Render(ctx context.Context, ns string, o any) error {
+	res, ok := o.(helm.ReleaseRes)
+	if !ok {
+		return fmt.Errorf("expected ReleaseRes, but got %T", o)
+	}
+	rel := res.Release
+
+	parent, ok := ctx.Value(KeyParent).(*TreeNode)
+	if !ok {
+		return fmt.Errorf("expecting a TreeNode but got %T", ctx.Value(KeyParent))
+	}
+	f, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
+	if !ok {
+		return fmt.Errorf("expecting a factory but got %T", ctx.Value(internal.KeyFactory))
+	}
+
+	root := NewTreeNode(client.HmGVR, client.FQN(rel.Namespace, rel.Name))
+	oo, err := dao.ReleaseObjects(rel)
+	if err != nil {
+		return err
+	}
+	for _, obj := range oo {
+		if err := h.renderObject(ctx, f, ns, root, obj); err != nil {
+			return err
+		}
+	}
+
+	gvr, nsID := client.NsGVR, client.FQN(client.ClusterScope, rel.Namespace)
+	nsn := parent.Find(gvr, nsID)
+	if nsn == nil {
+		nsn = NewTreeNode(gvr, nsID)
+		parent.Add(nsn)
+	}
+	nsn.Add(root)
+
+	return h.validate(root, rel)
+}
+
+// renderObject adds a release resource node. Workloads reuse their own renderers
+// so their pods and containers show up in the tree.
+func (*HelmRelease) renderObject(ctx context.Context, f dao.Factory, ns string, root *TreeNode, o dao.HelmObject) error {
+	if o.Issue != "" {
+		n := NewTreeNode(o.GVR, o.Path)
+		n.Extras[StatusKey], n.Extras[InfoKey] = MissingRefStatus, o.Issue
+		root.Add(n)
+		return nil
+	}
+
+	n := NewTreeNode(o.GVR, o.Path)
+	obj, err := f.Get(o.GVR, o.Path, true, labels.Everything())
+	if err != nil || obj == nil {
+		n.Extras[StatusKey], n.Extras[InfoKey] = MissingRefStatus, helm.ResourceMissing
+		root.Add(n)
+		return nil
+	}
+	if re := workloadRenderer(o.GVR); re != nil {
+		tmp := NewTreeNode(client.NoGVR, "")
+		if err := re.Render(context.WithValue(ctx, KeyParent, tmp), ns, obj); err != nil {
+			return err
+		}
+		if wn := tmp.Find(o.GVR, o.Path); wn != nil {
+			root.Add(wn)
+			return nil
+		}
+	}
+	if u, ok := obj.(*unstructured.Unstructured); ok {
+		if _, _, issue := dao.ObjectHealth(u); issue != "" {
+			n.Extras[StatusKey], n.Extras[InfoKey] = ToastStatus, issue
+		}
+	}
+	root.Add(n)
+
+	return nil
+}
+
+func (*HelmRelease) validate(root *TreeNode, rel *release.Release) error {
+	var ready int
+	for _, c := range root.Children {
+		if c.Extras[StatusKey] == OkStatus {
+			ready++
+		}
+	}
+	root.Extras[InfoKey] = fmt.Sprintf("%d/%d", ready, len(root.Children))
+	if rel.Info.Status != release.StatusDeployed || ready < len(root.Children) {
+		root.Extras[StatusKey] = ToastStatus
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type renderer interface {
+	Render(context.Context, string, any) error
+}
+
+func workloadRenderer(gvr *client.GVR) renderer {
+	switch gvr {
+	case client.DpGVR:
+		return new(Deployment)
+	case client.StsGVR:
+		return new(StatefulSet)
+	case client.DsGVR:
+		return new(DaemonSet)
+	case client.SvcGVR:
+		return new(Service)
+	default:
+		return nil
+	}
+}
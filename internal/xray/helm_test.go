@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render/helm"
+	"github.com/derailed/k9s/internal/xray"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/release"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const helmManifest = `---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: nginx
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: busy
+`
+
+func TestHelmReleaseRender(t *testing.T) {
+	dao.MetaAccess.RegisterMeta(client.DpGVR.String(), &metav1.APIResource{
+		Name:       "deployments",
+		Group:      "apps",
+		Version:    "v1",
+		Kind:       "Deployment",
+		Namespaced: true,
+	})
+	dao.MetaAccess.RegisterMeta(client.CmGVR.String(), &metav1.APIResource{
+		Name:       "configmaps",
+		Version:    "v1",
+		Kind:       "ConfigMap",
+		Namespaced: true,
+	})
+
+	f := makeFactory()
+	f.rows = map[*client.GVR][]runtime.Object{
+		client.DpGVR:  {load(t, "dp")},
+		client.PodGVR: {load(t, "po")},
+	}
+	rel := release.Release{
+		Name:      "fred",
+		Namespace: "default",
+		Manifest:  helmManifest,
+		Info:      &release.Info{Status: release.StatusDeployed},
+		Chart:     &chart.Chart{},
+	}
+
+	root := xray.NewTreeNode(client.HmGVR, "helm")
+	ctx := context.WithValue(context.Background(), xray.KeyParent, root)
+	ctx = context.WithValue(ctx, internal.KeyFactory, f)
+
+	var re xray.HelmRelease
+	require.NoError(t, re.Render(ctx, "", helm.ReleaseRes{Release: &rel}))
+
+	assert.Equal(t, 1, root.CountChildren())
+	n := root.Find(client.HmGVR, "default/fred")
+	require.NotNil(t, n)
+	assert.Equal(t, 2, n.CountChildren())
+	assert.Equal(t, xray.ToastStatus, n.Extras[xray.StatusKey])
+	assert.Equal(t, "1/2", n.Extras[xray.InfoKey])
+
+	dp := n.Find(client.DpGVR, "default/nginx")
+	require.NotNil(t, dp)
+	assert.Equal(t, 1, dp.CountChildren())
+	cm := n.Find(client.CmGVR, "default/busy")
+	require.NotNil(t, cm)
+	assert.Equal(t, xray.MissingRefStatus, cm.Extras[xray.StatusKey])
+}
@@ -504,6 +504,8 @@ func toEmoji(gvr *client.GVR) string {
 		return "👮‍♂️"
 	case client.CoGVR:
 		return "🐳"
+	case client.HmGVR:
+		return "⎈ "
 	case client.NewGVR("report"):
 		return "🧼"
 	default: